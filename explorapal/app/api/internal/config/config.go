@@ -0,0 +1,89 @@
+package config
+
+import (
+	"explorapal/pkg/embedding"
+	"explorapal/pkg/idgen"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	rest.RestConf
+
+	// JWT配置
+	JwtAuth struct {
+		AccessSecret  string
+		AccessExpire  int64
+		RefreshExpire int64 `json:",default=2592000"` // 刷新令牌有效期(秒)
+	}
+
+	// 家长同意配置
+	Consent struct {
+		TermsVersion string `json:",default=1.0"` // 当前条款版本，孩子账号需要家长同意该版本
+	}
+
+	// 数据库配置
+	DBConfig struct {
+		DataSource string
+	}
+
+	// 缓存配置
+	Cache cache.CacheConf
+
+	// ID生成配置，同时运行的实例机器号不能重复
+	IDGen idgen.Config
+
+	// 项目管理服务，项目的创建、查询和状态变更由它处理
+	ProjectManagementRpc zrpc.RpcClientConf
+
+	// 阿里云DashScope配置
+	DashScope struct {
+		APIKey      string
+		BaseURL     string
+		Timeout     int
+		MaxTokens   int
+		Temperature float32
+	}
+
+	// 内容向量配置，用于相似观察推荐
+	Embedding embedding.Config `json:",optional"`
+
+	// 阿里云语音服务配置
+	SpeechService struct {
+		AccessKeyId     string
+		AccessKeySecret string
+		AppKey          string
+		Region          string
+	}
+
+	// 文件存储配置
+	Storage struct {
+		Root             string
+		BaseURL          string
+		QuarantinePrefix string `json:",default=quarantine"` // 隔离区前缀，网关不得对外提供该前缀下的文件
+	}
+
+	// 观察图片安全筛查配置
+	ImageScreening struct {
+		MaxSize      int64   `json:",default=10485760"` // 上传图片大小上限(字节)
+		MaxFaces     int     `json:",default=0"`        // 允许的疑似人脸数量
+		MaxTextRatio float64 `json:",default=0.3"`      // 允许的文字区域占比
+		MaxPixels    int     `json:",default=40000000"` // 图片像素数上限(宽×高)
+	}
+
+	// 集团安全中心配置
+	SecurityConfig struct {
+		BaseURL      string
+		APIKey       string
+		Timeout      int
+		BatchWorkers int `json:",default=8"`  // 批量检查并发数
+		BatchSize    int `json:",default=50"` // 原生批量接口单次最多条数
+
+		// 本地规则与安全中心不可用时的处理策略
+		LocalRules      security.LocalRulesConfig `json:",optional"`
+		FailurePolicies []security.FailurePolicy  `json:",optional"`
+	}
+}
@@ -0,0 +1,30 @@
+package achievement
+
+import (
+	"net/http"
+
+	"explorapal/app/api/internal/logic/achievement"
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 生成纪录片脚本
+func GenerateDocumentaryHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GenerateDocumentaryReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := achievement.NewGenerateDocumentaryLogic(r.Context(), svcCtx)
+		resp, err := l.GenerateDocumentary(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
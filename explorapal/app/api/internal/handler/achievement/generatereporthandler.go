@@ -0,0 +1,30 @@
+package achievement
+
+import (
+	"net/http"
+
+	"explorapal/app/api/internal/logic/achievement"
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 生成研究简报
+func GenerateReportHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GenerateReportReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := achievement.NewGenerateReportLogic(r.Context(), svcCtx)
+		resp, err := l.GenerateReport(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
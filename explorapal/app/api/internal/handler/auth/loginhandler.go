@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"net/http"
+
+	"explorapal/app/api/internal/logic/auth"
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 账号密码登录
+func LoginHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.LoginReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := auth.NewLoginLogic(r.Context(), svcCtx)
+		resp, err := l.Login(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package notification
+
+import (
+	"net/http"
+
+	"explorapal/app/api/internal/logic/notification"
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 标记通知已读
+func MarkNotificationsReadHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.MarkNotificationsReadReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := notification.NewMarkNotificationsReadLogic(r.Context(), svcCtx)
+		resp, err := l.MarkNotificationsRead(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package questioning
+
+import (
+	"net/http"
+
+	"explorapal/app/api/internal/logic/questioning"
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 选择问题并获取AI回答
+func SelectQuestionHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SelectQuestionReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := questioning.NewSelectQuestionLogic(r.Context(), svcCtx)
+		resp, err := l.SelectQuestion(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
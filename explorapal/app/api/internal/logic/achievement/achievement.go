@@ -0,0 +1,220 @@
+package achievement
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/idgen"
+	"explorapal/pkg/moderation"
+	"explorapal/pkg/progress"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/mr"
+)
+
+// 生成成果时每类探索记录最多使用的条数
+const materialLimit = 20
+
+var errNoMaterial = errors.New("项目里还没有探索记录，先去观察和提问吧！")
+
+// material 生成成果的素材，只包含孩子可以看到的探索记录
+type material struct {
+	Title        string            `json:"title"`
+	Description  string            `json:"description,omitempty"`
+	Category     string            `json:"category"`
+	Observations []observationItem `json:"observations,omitempty"`
+	Questions    []questionItem    `json:"questions,omitempty"`
+	Expressions  []string          `json:"expressions,omitempty"`
+}
+
+type observationItem struct {
+	ObjectName  string `json:"object_name"`
+	Description string `json:"description,omitempty"`
+}
+
+type questionItem struct {
+	Question     string `json:"question"`
+	Answer       string `json:"answer,omitempty"`
+	UserResponse string `json:"user_response,omitempty"`
+}
+
+// loadProject 查询调用方自己的项目
+func loadProject(ctx context.Context, svcCtx *svc.ServiceContext, projectID int64) (*hps.Projects, error) {
+	project, err := svcCtx.ProjectModel.FindOneOwned(ctx, projectID, auth.UserID(ctx))
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, fmt.Errorf("项目不存在")
+		}
+		return nil, fmt.Errorf("查询项目失败: %w", err)
+	}
+	return project, nil
+}
+
+// loadMaterial 汇总项目的观察、提问和表达记录，序列化为发给模型的项目数据
+// 隔离、等待审核或被驳回的内容不发给模型；项目没有任何记录时返回errNoMaterial
+func loadMaterial(ctx context.Context, svcCtx *svc.ServiceContext, project *hps.Projects) (string, error) {
+	var (
+		observations []*hps.Observations
+		questions    []*hps.Questions
+		expressions  []*hps.Expressions
+	)
+	err := mr.Finish(func() (err error) {
+		observations, err = svcCtx.ObservationModel.FindByProject(ctx, project.ProjectId, materialLimit)
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		questions, err = svcCtx.QuestionModel.FindByProject(ctx, project.ProjectId, materialLimit)
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		expressions, err = svcCtx.ExpressionModel.FindByProject(ctx, project.ProjectId, materialLimit)
+		return ignoreNotFound(err)
+	})
+	if err != nil {
+		return "", fmt.Errorf("查询项目记录失败: %w", err)
+	}
+
+	m := &material{Title: project.Title, Description: project.Description.String, Category: project.Category}
+	for _, o := range observations {
+		if o.Quarantined() || o.RecognitionHidden() || !o.ObjectName.Valid {
+			continue
+		}
+		m.Observations = append(m.Observations, observationItem{ObjectName: o.ObjectName.String, Description: o.Description.String})
+	}
+	for _, q := range questions {
+		if !q.AiAnswer.Valid && !q.UserResponse.Valid {
+			continue
+		}
+		m.Questions = append(m.Questions, questionItem{Question: q.Content, Answer: q.AiAnswer.String, UserResponse: q.UserResponse.String})
+	}
+	for _, e := range expressions {
+		if e.ReviewStatus == hps.ReviewStatusPending || e.ReviewStatus == hps.ReviewStatusRejected {
+			continue
+		}
+		content := e.RawContent
+		if e.PolishedSummary.Valid && e.PolishedSummary.String != "" {
+			content = e.PolishedSummary.String
+		}
+		m.Expressions = append(m.Expressions, content)
+	}
+	if len(m.Observations) == 0 && len(m.Questions) == 0 && len(m.Expressions) == 0 {
+		return "", errNoMaterial
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("序列化项目数据失败: %w", err)
+	}
+	return string(data), nil
+}
+
+// checkOutput 检查生成的成果，过滤的内容直接写回v；被拦截时返回给孩子的提示，返回需要人工复核的字段
+func checkOutput(ctx context.Context, svcCtx *svc.ServiceContext, v interface{}) ([]security.Flagged, error) {
+	blocked, flagged, err := svcCtx.SafetyGuard.CheckValueForReview(ctx, v, security.OutputPolicy, security.DirectionOutput)
+	if err != nil {
+		return nil, fmt.Errorf("检查生成的成果失败: %w", err)
+	}
+	if blocked != nil {
+		return nil, blocked.Err()
+	}
+	return flagged, nil
+}
+
+// newAchievement 构造已生成的成果，content为成果内容，有需要复核的字段时等待人工审核
+func newAchievement(ctx context.Context, project *hps.Projects, achievementType, title string, content interface{},
+	flagged []security.Flagged) (*hps.Achievements, error) {
+	data, err := json.Marshal(content)
+	if err != nil {
+		return nil, fmt.Errorf("序列化成果内容失败: %w", err)
+	}
+	if title == "" {
+		title = project.Title
+	}
+	reviewStatus := hps.ReviewStatusNone
+	if len(flagged) > 0 {
+		reviewStatus = hps.ReviewStatusPending
+	}
+	return &hps.Achievements{
+		AchievementId: idgen.Next(),
+		ProjectId:     project.ProjectId,
+		UserId:        auth.UserID(ctx),
+		Type:          achievementType,
+		Title:         title,
+		Content:       string(data),
+		Status:        hps.AchievementStatusCompleted,
+		ReviewStatus:  reviewStatus,
+	}, nil
+}
+
+// saveAchievement 成果、生成活动、审核记录、项目进度和最后活动时间在同一个事务中写入；审核记录提交失败时成果一起回滚
+func saveAchievement(ctx context.Context, svcCtx *svc.ServiceContext, achievement *hps.Achievements,
+	activity *hps.ProjectActivities, flagged []security.Flagged) error {
+	return svcCtx.UnitOfWork.Do(ctx, func(ctx context.Context, tx *hps.Models) error {
+		if _, err := tx.Achievements.Insert(ctx, achievement); err != nil {
+			return fmt.Errorf("保存成果失败: %w", err)
+		}
+		if _, err := tx.ProjectActivities.Insert(ctx, activity); err != nil {
+			return fmt.Errorf("记录项目活动失败: %w", err)
+		}
+		if len(flagged) > 0 {
+			if err := submitReview(ctx, svcCtx, tx, achievement, flagged); err != nil {
+				return err
+			}
+		}
+		return svcCtx.Progress.RecordTx(ctx, tx, achievement.ProjectId, progress.StageAchievement)
+	})
+}
+
+// submitReview 在事务中把需要复核的成果提交人工审核，审核人可以修改成果内容
+func submitReview(ctx context.Context, svcCtx *svc.ServiceContext, tx *hps.Models, achievement *hps.Achievements,
+	flagged []security.Flagged) error {
+	riskLevel, details := moderation.SummarizeFlagged(flagged)
+	_, err := svcCtx.ReviewQueue.SubmitTx(ctx, tx, &moderation.Item{
+		ProjectID:   achievement.ProjectId,
+		UserID:      achievement.UserId,
+		SourceTable: moderation.SourceAchievements,
+		SourceID:    achievement.AchievementId,
+		SourceField: moderation.FieldContent,
+		ContentType: security.ContentTypeText,
+		Content:     achievement.Content,
+		RiskLevel:   riskLevel,
+		Details:     details,
+	})
+	return err
+}
+
+// buildActivity 生成成果活动；等待审核时不记录成果标题
+func buildActivity(achievement *hps.Achievements, activityType, name string) *hps.ProjectActivities {
+	description := fmt.Sprintf("完成了%s《%s》", name, achievement.Title)
+	if achievement.ReviewStatus == hps.ReviewStatusPending {
+		description = fmt.Sprintf("完成了一份%s，等待大人查看", name)
+	}
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"achievement_id": achievement.AchievementId,
+		"review_status":  achievement.ReviewStatus,
+	})
+
+	return &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   achievement.ProjectId,
+		UserId:      achievement.UserId,
+		Type:        activityType,
+		Description: description,
+		Metadata:    sql.NullString{String: string(metadata), Valid: true},
+	}
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func ignoreNotFound(err error) error {
+	if err == hps.ErrNotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+package achievement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/moderation"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	documentaryStyles  = map[string]bool{"narrative": true, "scientific": true, "adventure": true}
+	documentaryLengths = map[string]bool{"short": true, "medium": true, "long": true}
+)
+
+type GenerateDocumentaryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 生成纪录片脚本
+func NewGenerateDocumentaryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GenerateDocumentaryLogic {
+	return &GenerateDocumentaryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GenerateDocumentaryLogic) GenerateDocumentary(req *types.GenerateDocumentaryReq) (resp *types.GenerateDocumentaryResp, err error) {
+	if !documentaryStyles[req.Style] {
+		return nil, fmt.Errorf("不支持的纪录片风格: %s", req.Style)
+	}
+	if !documentaryLengths[req.Length] {
+		return nil, fmt.Errorf("不支持的纪录片时长: %s", req.Length)
+	}
+
+	project, err := loadProject(l.ctx, l.svcCtx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	data, err := loadMaterial(l.ctx, l.svcCtx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	script, err := l.svcCtx.AIClient.GenerateDocumentary(l.ctx, data, req.Style, req.Length)
+	if err != nil {
+		l.Logger.Errorf("生成纪录片脚本失败: %v", err)
+		return nil, err
+	}
+
+	// 模型输出入库前先做安全检查，过滤的内容直接替换；中等风险的内容等大人审核后再展示给孩子
+	flagged, err := checkOutput(l.ctx, l.svcCtx, script)
+	if err != nil {
+		return nil, err
+	}
+	achievement, err := newAchievement(l.ctx, project, hps.AchievementTypeDocumentary, script.Title, script, flagged)
+	if err != nil {
+		return nil, err
+	}
+	achievement.Style = nullString(req.Style)
+	achievement.Length = nullString(req.Length)
+
+	activity := buildActivity(achievement, hps.ActivityTypeGenerateDocumentary, "纪录片脚本")
+	if err := saveAchievement(l.ctx, l.svcCtx, achievement, activity, flagged); err != nil {
+		l.Logger.Errorf("保存纪录片脚本失败: %v", err)
+		return nil, err
+	}
+
+	resp = &types.GenerateDocumentaryResp{
+		AchievementId: achievement.AchievementId,
+		ReviewStatus:  achievement.ReviewStatus,
+	}
+	// 等待审核时不返回脚本内容
+	if achievement.ReviewStatus == hps.ReviewStatusPending {
+		resp.ReviewMessage = moderation.WaitingMessage
+		return resp, nil
+	}
+	if err := json.Unmarshal([]byte(achievement.Content), &resp.Documentary); err != nil {
+		return nil, fmt.Errorf("解析纪录片脚本失败: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,90 @@
+package achievement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/moderation"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	posterStyles  = map[string]bool{"scientific": true, "creative": true, "educational": true}
+	posterLayouts = map[string]bool{"standard": true, "creative": true, "minimal": true}
+)
+
+type GeneratePosterLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 生成学术海报
+func NewGeneratePosterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GeneratePosterLogic {
+	return &GeneratePosterLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GeneratePosterLogic) GeneratePoster(req *types.GeneratePosterReq) (resp *types.GeneratePosterResp, err error) {
+	if !posterStyles[req.Style] {
+		return nil, fmt.Errorf("不支持的海报风格: %s", req.Style)
+	}
+	if !posterLayouts[req.Layout] {
+		return nil, fmt.Errorf("不支持的海报布局: %s", req.Layout)
+	}
+
+	project, err := loadProject(l.ctx, l.svcCtx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	data, err := loadMaterial(l.ctx, l.svcCtx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	design, err := l.svcCtx.AIClient.GeneratePoster(l.ctx, data, req.Style, req.Layout)
+	if err != nil {
+		l.Logger.Errorf("生成海报设计失败: %v", err)
+		return nil, err
+	}
+
+	// 模型输出入库前先做安全检查，过滤的内容直接替换；中等风险的内容等大人审核后再展示给孩子
+	flagged, err := checkOutput(l.ctx, l.svcCtx, design)
+	if err != nil {
+		return nil, err
+	}
+	achievement, err := newAchievement(l.ctx, project, hps.AchievementTypePoster, design.Title, design, flagged)
+	if err != nil {
+		return nil, err
+	}
+	achievement.Style = nullString(req.Style)
+	achievement.Layout = nullString(req.Layout)
+
+	activity := buildActivity(achievement, hps.ActivityTypeGeneratePoster, "科学海报")
+	if err := saveAchievement(l.ctx, l.svcCtx, achievement, activity, flagged); err != nil {
+		l.Logger.Errorf("保存海报设计失败: %v", err)
+		return nil, err
+	}
+
+	resp = &types.GeneratePosterResp{
+		AchievementId: achievement.AchievementId,
+		ReviewStatus:  achievement.ReviewStatus,
+	}
+	// 等待审核时不返回海报内容
+	if achievement.ReviewStatus == hps.ReviewStatusPending {
+		resp.ReviewMessage = moderation.WaitingMessage
+		return resp, nil
+	}
+	if err := json.Unmarshal([]byte(achievement.Content), &resp.Poster); err != nil {
+		return nil, fmt.Errorf("解析海报设计失败: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,77 @@
+package achievement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/moderation"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GenerateReportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 生成研究简报
+func NewGenerateReportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GenerateReportLogic {
+	return &GenerateReportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GenerateReportLogic) GenerateReport(req *types.GenerateReportReq) (resp *types.GenerateReportResp, err error) {
+	project, err := loadProject(l.ctx, l.svcCtx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	data, err := loadMaterial(l.ctx, l.svcCtx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := l.svcCtx.AIClient.GenerateReport(l.ctx, data)
+	if err != nil {
+		l.Logger.Errorf("生成研究报告失败: %v", err)
+		return nil, err
+	}
+
+	// 模型输出入库前先做安全检查，过滤的内容直接替换；中等风险的内容等大人审核后再展示给孩子
+	flagged, err := checkOutput(l.ctx, l.svcCtx, report)
+	if err != nil {
+		return nil, err
+	}
+	achievement, err := newAchievement(l.ctx, project, hps.AchievementTypeReport, report.Title, report, flagged)
+	if err != nil {
+		return nil, err
+	}
+	achievement.Description = nullString(report.Abstract)
+
+	activity := buildActivity(achievement, hps.ActivityTypeGenerateReport, "研究报告")
+	if err := saveAchievement(l.ctx, l.svcCtx, achievement, activity, flagged); err != nil {
+		l.Logger.Errorf("保存研究报告失败: %v", err)
+		return nil, err
+	}
+
+	resp = &types.GenerateReportResp{
+		AchievementId: achievement.AchievementId,
+		ReviewStatus:  achievement.ReviewStatus,
+	}
+	// 等待审核时不返回报告内容
+	if achievement.ReviewStatus == hps.ReviewStatusPending {
+		resp.ReviewMessage = moderation.WaitingMessage
+		return resp, nil
+	}
+	if err := json.Unmarshal([]byte(achievement.Content), &resp.Report); err != nil {
+		return nil, fmt.Errorf("解析研究报告失败: %w", err)
+	}
+	return resp, nil
+}
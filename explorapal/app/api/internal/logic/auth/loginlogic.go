@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LoginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 账号密码登录
+func NewLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginLogic {
+	return &LoginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.TokenResp, err error) {
+	if req.Account == "" || req.Password == "" {
+		return nil, errors.New("请输入账号和密码")
+	}
+
+	user, err := l.findAccount(req.Account)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, errLoginFailed
+		}
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if !user.PasswordHash.Valid || !auth.VerifyPassword(req.Password, user.PasswordHash.String) {
+		return nil, errLoginFailed
+	}
+	if user.Status != hps.UserStatusActive {
+		return nil, errUserInactive
+	}
+
+	tokens, err := l.svcCtx.Auth.Issue(user.UserId, user.Role)
+	if err != nil {
+		l.Logger.Errorf("签发令牌失败: %v", err)
+		return nil, err
+	}
+
+	if err := l.svcCtx.UserModel.UpdateLastLogin(l.ctx, user, time.Now()); err != nil {
+		l.Logger.Errorf("记录登录时间失败: %v", err)
+		// 不影响登录，只记录错误
+	}
+
+	return toTokenResp(user, tokens), nil
+}
+
+// findAccount 按手机号、邮箱或用户名查找账号
+func (l *LoginLogic) findAccount(account string) (*hps.Users, error) {
+	switch {
+	case strings.Contains(account, "@"):
+		return l.svcCtx.UserModel.FindOneByEmail(l.ctx, account)
+	case isPhone(account):
+		user, err := l.svcCtx.UserModel.FindOneByPhone(l.ctx, account)
+		if err != hps.ErrNotFound {
+			return user, err
+		}
+		// 纯数字的用户名
+		return l.svcCtx.UserModel.FindOneByUsername(l.ctx, account)
+	default:
+		return l.svcCtx.UserModel.FindOneByUsername(l.ctx, account)
+	}
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RefreshTokenLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 刷新令牌
+func NewRefreshTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshTokenLogic {
+	return &RefreshTokenLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RefreshTokenLogic) RefreshToken(req *types.RefreshTokenReq) (resp *types.TokenResp, err error) {
+	claims, err := l.svcCtx.Auth.Parse(req.RefreshToken, auth.TokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	// 账号被删除或停用后不再续期；角色以当前资料为准
+	user, err := l.svcCtx.UserModel.FindOneByUserId(l.ctx, claims.UserID)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, auth.ErrTokenInvalid
+		}
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user.DeleteTime.Valid {
+		return nil, auth.ErrTokenInvalid
+	}
+	if user.Status != hps.UserStatusActive {
+		return nil, errUserInactive
+	}
+
+	tokens, err := l.svcCtx.Auth.Issue(user.UserId, user.Role)
+	if err != nil {
+		l.Logger.Errorf("签发令牌失败: %v", err)
+		return nil, err
+	}
+	return toTokenResp(user, tokens), nil
+}
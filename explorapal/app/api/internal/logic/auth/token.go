@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"errors"
+
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+)
+
+var (
+	// 账号不存在和密码错误使用同一提示，避免暴露账号是否存在
+	errLoginFailed  = errors.New("账号或密码错误")
+	errUserInactive = errors.New("账号已停用，请联系家长")
+)
+
+// isPhone 判断账号是否为手机号
+func isPhone(account string) bool {
+	if len(account) != 11 || account[0] != '1' {
+		return false
+	}
+	for _, c := range account {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func toTokenResp(user *hps.Users, tokens *auth.TokenPair) *types.TokenResp {
+	return &types.TokenResp{
+		UserId:        user.UserId,
+		Role:          user.Role,
+		AccessToken:   tokens.AccessToken,
+		AccessExpire:  tokens.AccessExpire,
+		RefreshToken:  tokens.RefreshToken,
+		RefreshExpire: tokens.RefreshExpire,
+	}
+}
@@ -0,0 +1,118 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/guardian"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+// 可以使用的贴纸及其在通知中的文字
+var stickers = map[string]string{
+	"star":      "⭐ 太棒了",
+	"thumbs_up": "👍 真厉害",
+	"heart":     "❤️ 好喜欢",
+	"rocket":    "🚀 继续探索",
+	"dinosaur":  "🦕 恐龙专家",
+	"lightbulb": "💡 好点子",
+}
+
+var errTargetNotFound = errors.New("留言对象不存在")
+
+// commentTarget 留言对象所在的项目和孩子
+type commentTarget struct {
+	ProjectID int64
+	ChildID   int64
+}
+
+// resolveTarget 查询留言对象所属的项目和孩子
+func resolveTarget(ctx context.Context, svcCtx *svc.ServiceContext, targetType string, targetID int64) (*commentTarget, error) {
+	var (
+		target  *commentTarget
+		deleted bool
+		err     error
+	)
+	switch targetType {
+	case hps.CommentTargetProject:
+		var project *hps.Projects
+		if project, err = svcCtx.ProjectModel.FindOneByProjectId(ctx, targetID); err == nil {
+			target, deleted = &commentTarget{ProjectID: project.ProjectId, ChildID: project.UserId}, project.DeleteTime.Valid
+		}
+	case hps.CommentTargetObservation:
+		var observation *hps.Observations
+		if observation, err = svcCtx.ObservationModel.FindOneByObservationId(ctx, targetID); err == nil {
+			// 隔离中的照片孩子看不到，也不能留言
+			target = &commentTarget{ProjectID: observation.ProjectId, ChildID: observation.UserId}
+			deleted = observation.DeleteTime.Valid || observation.Quarantined()
+		}
+	case hps.CommentTargetExpression:
+		var expression *hps.Expressions
+		if expression, err = svcCtx.ExpressionModel.FindOneByExpressionId(ctx, targetID); err == nil {
+			target, deleted = &commentTarget{ProjectID: expression.ProjectId, ChildID: expression.UserId}, expression.DeleteTime.Valid
+		}
+	case hps.CommentTargetAchievement:
+		var achievement *hps.Achievements
+		if achievement, err = svcCtx.AchievementModel.FindOneByAchievementId(ctx, targetID); err == nil {
+			target, deleted = &commentTarget{ProjectID: achievement.ProjectId, ChildID: achievement.UserId}, achievement.DeleteTime.Valid
+		}
+	default:
+		return nil, fmt.Errorf("留言对象类型只能是project、observation、expression或achievement")
+	}
+
+	if err == hps.ErrNotFound || (err == nil && deleted) {
+		return nil, errTargetNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询留言对象失败: %w", err)
+	}
+	return target, nil
+}
+
+// checkRelation 校验调用方是孩子关联的家长或孩子所在班级的老师，返回关系
+// 老师只凭关联不能留言，孩子需要在老师的班级中
+func checkRelation(ctx context.Context, svcCtx *svc.ServiceContext, identity *auth.Identity, childID int64) (string, error) {
+	if identity.Role == auth.RoleTeacher {
+		ok, err := svcCtx.ClassMemberModel.IsTeacherStudent(ctx, identity.UserID, childID)
+		if err != nil {
+			return "", fmt.Errorf("查询班级学生失败: %w", err)
+		}
+		if !ok {
+			return "", guardian.ErrNotLinked
+		}
+		return hps.GuardianRelationTeacher, nil
+	}
+
+	relation, err := guardian.Relation(ctx, svcCtx.GuardianLinkModel, identity.UserID, childID)
+	if err == guardian.ErrNotLinked {
+		return "", err
+	}
+	if err != nil {
+		return "", fmt.Errorf("查询孩子关联失败: %w", err)
+	}
+	return relation, nil
+}
+
+func toCommentInfo(c *hps.Comments) types.CommentInfo {
+	return types.CommentInfo{
+		CommentId:     c.CommentId,
+		ProjectId:     c.ProjectId,
+		TargetType:    c.TargetType,
+		TargetId:      c.TargetId,
+		AuthorId:      c.AuthorId,
+		AuthorRole:    c.AuthorRole,
+		AuthorName:    guardian.RelationName(c.Relation),
+		Kind:          c.Kind,
+		Content:       c.Content.String,
+		Sticker:       c.Sticker.String,
+		VoiceUrl:      c.VoiceUrl.String,
+		VoiceDuration: c.VoiceDuration.Float64,
+		CreateTime:    c.CreateTime.Format(timeLayout),
+	}
+}
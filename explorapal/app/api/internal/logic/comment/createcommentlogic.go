@@ -0,0 +1,270 @@
+package comment
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/guardian"
+	"explorapal/pkg/idgen"
+	"explorapal/pkg/profile"
+	"explorapal/third/security"
+	"explorapal/third/speech"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// 文字留言最大长度
+	maxCommentLength = 500
+	// 语音留言大小和时长上限
+	maxVoiceSize     = 5 << 20
+	maxVoiceDuration = 60
+	// 语音留言的存储目录
+	commentVoiceDir = "comments"
+	// 语音识别采样率
+	voiceSampleRate = 16000
+	// 通知内容中文字留言的预览长度
+	notificationPreviewLength = 50
+)
+
+// 支持的语音格式
+var voiceFormats = map[string]bool{"wav": true, "mp3": true, "m4a": true}
+
+var errCommentBlocked = fmt.Errorf("留言没有通过内容安全检查，请修改后再发送")
+
+type CreateCommentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 家长或老师留言
+func NewCreateCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCommentLogic {
+	return &CreateCommentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateCommentLogic) CreateComment(req *types.CreateCommentReq) (resp *types.CreateCommentResp, err error) {
+	identity, ok := auth.FromContext(l.ctx)
+	if !ok {
+		return nil, auth.ErrTokenInvalid
+	}
+	if identity.Role != auth.RoleParent && identity.Role != auth.RoleTeacher {
+		return nil, fmt.Errorf("只有家长和老师可以留言")
+	}
+
+	target, err := resolveTarget(l.ctx, l.svcCtx, req.TargetType, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	relation, err := checkRelation(l.ctx, l.svcCtx, identity, target.ChildID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &hps.Comments{
+		CommentId:  idgen.Next(),
+		ProjectId:  target.ProjectID,
+		ChildId:    target.ChildID,
+		AuthorId:   identity.UserID,
+		AuthorRole: identity.Role,
+		Relation:   relation,
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+		Kind:       req.Kind,
+	}
+
+	// 留言会展示给孩子，文字和语音识别文本都按返回给孩子的内容检查
+	var voiceKey string
+	switch req.Kind {
+	case hps.CommentKindText:
+		err = l.fillText(comment, req.Content)
+	case hps.CommentKindSticker:
+		if _, ok := stickers[req.Sticker]; !ok {
+			return nil, fmt.Errorf("不支持的贴纸")
+		}
+		comment.Sticker = sql.NullString{String: req.Sticker, Valid: true}
+	case hps.CommentKindVoice:
+		voiceKey, err = l.fillVoice(comment, req)
+	default:
+		return nil, fmt.Errorf("留言形式只能是text、sticker或voice")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 留言、时间线上的项目活动和给孩子的通知在同一个事务中写入
+	err = l.svcCtx.UnitOfWork.Do(l.ctx, func(ctx context.Context, tx *hps.Models) error {
+		if _, err := tx.Comments.Insert(ctx, comment); err != nil {
+			return err
+		}
+		if _, err := tx.ProjectActivities.Insert(ctx, buildActivity(comment)); err != nil {
+			return fmt.Errorf("记录项目活动失败: %w", err)
+		}
+		if _, err := tx.Notifications.Insert(ctx, buildNotification(comment)); err != nil {
+			return fmt.Errorf("发送留言通知失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		l.Logger.Errorf("保存留言失败: %v", err)
+		// 留言没有保存，删除已经保存的语音
+		if voiceKey != "" {
+			if err := l.svcCtx.Storage.Delete(context.Background(), voiceKey); err != nil {
+				l.Logger.Errorf("删除语音留言失败: %v", err)
+			}
+		}
+		return nil, err
+	}
+	// 重新查询以带上数据库生成的创建时间
+	if saved, err := l.svcCtx.CommentModel.FindOneByCommentId(l.ctx, comment.CommentId); err == nil {
+		comment = saved
+	}
+
+	return &types.CreateCommentResp{
+		Comment: toCommentInfo(comment),
+	}, nil
+}
+
+// fillText 检查文字留言，低、中风险的内容使用过滤后的版本
+func (l *CreateCommentLogic) fillText(comment *hps.Comments, content string) error {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return fmt.Errorf("留言内容不能为空")
+	}
+	if utf8.RuneCountInString(content) > maxCommentLength {
+		return fmt.Errorf("留言不能超过%d个字", maxCommentLength)
+	}
+
+	content, err := l.screen(content)
+	if err != nil {
+		return err
+	}
+	comment.Content = sql.NullString{String: content, Valid: true}
+	return nil
+}
+
+// fillVoice 识别语音留言并检查识别文本，通过后再保存音频，返回音频的存储路径
+// 无法读出时长的音频不能确认没有超过时长上限，直接拒绝
+func (l *CreateCommentLogic) fillVoice(comment *hps.Comments, req *types.CreateCommentReq) (string, error) {
+	format := strings.ToLower(req.AudioFormat)
+	if !voiceFormats[format] {
+		return "", fmt.Errorf("语音格式只能是wav、mp3或m4a")
+	}
+	audioData, err := base64.StdEncoding.DecodeString(req.AudioData)
+	if err != nil {
+		return "", fmt.Errorf("音频数据格式错误: %w", err)
+	}
+	if len(audioData) == 0 || len(audioData) > maxVoiceSize {
+		return "", fmt.Errorf("语音留言不能超过%dMB", maxVoiceSize>>20)
+	}
+	duration := speech.AudioDuration(audioData, format)
+	if duration <= 0 {
+		return "", fmt.Errorf("无法读取语音留言的时长，请重新录制")
+	}
+	if duration > maxVoiceDuration {
+		return "", fmt.Errorf("语音留言不能超过%d秒", maxVoiceDuration)
+	}
+
+	author, err := profile.Load(l.ctx, l.svcCtx.UserModel, comment.AuthorId)
+	if err != nil {
+		return "", err
+	}
+	text, err := l.svcCtx.SpeechClient.SpeechToText(l.ctx, audioData, format, voiceSampleRate, author.Language)
+	if err != nil {
+		l.Logger.Errorf("语音留言识别失败: %v", err)
+		return "", fmt.Errorf("语音留言识别失败，请重新录制")
+	}
+	if text, err = l.screen(text); err != nil {
+		return "", err
+	}
+
+	key := fmt.Sprintf("%s/%d/%d.%s", commentVoiceDir, comment.ProjectId, comment.CommentId, format)
+	voiceURL, err := l.svcCtx.Storage.Put(l.ctx, key, audioData)
+	if err != nil {
+		l.Logger.Errorf("保存语音留言失败: %v", err)
+		return "", err
+	}
+
+	comment.Content = sql.NullString{String: text, Valid: text != ""}
+	comment.VoiceUrl = sql.NullString{String: voiceURL, Valid: true}
+	comment.VoiceDuration = sql.NullFloat64{Float64: duration, Valid: true}
+	return key, nil
+}
+
+// screen 按返回给孩子的内容检查留言文字
+func (l *CreateCommentLogic) screen(content string) (string, error) {
+	decision := l.svcCtx.SafetyGuard.Check(l.ctx, security.CheckTarget{
+		Content:     content,
+		ContentType: security.ContentTypeText,
+		Direction:   security.DirectionOutput,
+		Field:       "comment",
+	})
+	if decision.Blocked() {
+		return "", errCommentBlocked
+	}
+	return decision.Content, nil
+}
+
+// buildActivity 留言记入项目活动，出现在孩子的项目时间线上
+func buildActivity(comment *hps.Comments) *hps.ProjectActivities {
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"comment_id":  comment.CommentId,
+		"author_role": comment.AuthorRole,
+		"relation":    comment.Relation,
+		"kind":        comment.Kind,
+		"target_type": comment.TargetType,
+		"target_id":   comment.TargetId,
+	})
+
+	return &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   comment.ProjectId,
+		UserId:      comment.AuthorId,
+		Type:        hps.ActivityTypeComment,
+		Description: fmt.Sprintf("%s留言：%s", guardian.RelationName(comment.Relation), preview(comment)),
+		Metadata:    sql.NullString{String: string(metadata), Valid: true},
+	}
+}
+
+// buildNotification 通知孩子有新留言
+func buildNotification(comment *hps.Comments) *hps.Notifications {
+	return &hps.Notifications{
+		NotificationId: idgen.Next(),
+		UserId:         comment.ChildId,
+		Type:           hps.NotificationTypeComment,
+		Title:          fmt.Sprintf("%s给你留言啦", guardian.RelationName(comment.Relation)),
+		Content:        sql.NullString{String: preview(comment), Valid: true},
+		ProjectId:      sql.NullInt64{Int64: comment.ProjectId, Valid: true},
+		RefType:        sql.NullString{String: hps.NotificationTypeComment, Valid: true},
+		RefId:          sql.NullInt64{Int64: comment.CommentId, Valid: true},
+	}
+}
+
+// preview 留言在时间线和通知中的简短文字
+func preview(comment *hps.Comments) string {
+	switch comment.Kind {
+	case hps.CommentKindSticker:
+		return stickers[comment.Sticker.String]
+	case hps.CommentKindVoice:
+		return "一条语音留言"
+	default:
+		runes := []rune(comment.Content.String)
+		if len(runes) > notificationPreviewLength {
+			return string(runes[:notificationPreviewLength]) + "…"
+		}
+		return comment.Content.String
+	}
+}
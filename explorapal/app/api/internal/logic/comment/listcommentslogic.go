@@ -0,0 +1,71 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 每页最多返回的留言数
+const maxPageSize = 100
+
+type ListCommentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取项目留言
+func NewListCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCommentsLogic {
+	return &ListCommentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ListComments 项目所属的孩子、关联该孩子的家长和孩子所在班级的老师可以查看
+func (l *ListCommentsLogic) ListComments(req *types.ListCommentsReq) (resp *types.ListCommentsResp, err error) {
+	project, err := l.svcCtx.ProjectModel.FindOneByProjectId(l.ctx, req.ProjectId)
+	if err != nil || project.DeleteTime.Valid {
+		if err == nil || err == hps.ErrNotFound {
+			return nil, fmt.Errorf("项目不存在")
+		}
+		return nil, fmt.Errorf("查询项目失败: %w", err)
+	}
+
+	identity, ok := auth.FromContext(l.ctx)
+	if !ok {
+		return nil, auth.ErrTokenInvalid
+	}
+	if project.UserId != identity.UserID {
+		if _, err := checkRelation(l.ctx, l.svcCtx, identity, project.UserId); err != nil {
+			return nil, fmt.Errorf("项目不存在")
+		}
+	}
+
+	page, pageSize := int64(req.Page), int64(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	comments, err := l.svcCtx.CommentModel.FindByProject(l.ctx, req.ProjectId, req.TargetType, req.TargetId, page, pageSize)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, fmt.Errorf("查询留言失败: %w", err)
+	}
+
+	list := make([]types.CommentInfo, 0, len(comments))
+	for _, c := range comments {
+		list = append(list, toCommentInfo(c))
+	}
+	return &types.ListCommentsResp{List: list}, nil
+}
@@ -0,0 +1,29 @@
+package common
+
+import (
+	"context"
+
+	"explorapal/app/api/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PingLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 健康检查
+func NewPingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PingLogic {
+	return &PingLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Ping 只说明API进程可以处理请求，不检查数据库和下游服务，便于负载均衡据此摘除卡死的实例
+func (l *PingLogic) Ping() error {
+	return nil
+}
@@ -0,0 +1,84 @@
+package expression
+
+import (
+	"context"
+	"time"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 发音练习记录查询上限
+const maxAssessmentHistoryLimit = 200
+
+type GetAssessmentHistoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取发音练习记录
+func NewGetAssessmentHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAssessmentHistoryLogic {
+	return &GetAssessmentHistoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAssessmentHistoryLogic) GetAssessmentHistory(req *types.GetAssessmentHistoryReq) (resp *types.GetAssessmentHistoryResp, err error) {
+	days := req.Days
+	if days <= 0 {
+		days = 30
+	}
+	limit := int64(req.Limit)
+	if limit <= 0 || limit > maxAssessmentHistoryLimit {
+		limit = maxAssessmentHistoryLimit
+	}
+
+	since := time.Now().AddDate(0, 0, -int(days))
+	expressions, err := l.svcCtx.ExpressionModel.FindAssessmentHistory(l.ctx, auth.UserID(l.ctx), req.ProjectId, since, limit)
+	if err != nil {
+		l.Logger.Errorf("查询发音练习记录失败: %v", err)
+		return nil, err
+	}
+
+	resp = &types.GetAssessmentHistoryResp{
+		List: make([]types.AssessmentRecord, 0, len(expressions)),
+	}
+
+	var totalAccuracy, totalPace float64
+	var paceCount int
+	for _, e := range expressions {
+		resp.List = append(resp.List, types.AssessmentRecord{
+			ExpressionId:   e.ExpressionId,
+			ProjectId:      e.ProjectId,
+			TargetText:     e.AssessmentTarget.String,
+			Transcript:     e.RawContent,
+			Accuracy:       e.AssessmentAccuracy.Float64,
+			PaceAssessed:   e.AssessmentPace.Valid,
+			WordsPerSecond: e.AssessmentPace.Float64,
+			FillerCount:    int32(e.AssessmentFillerCount.Int64),
+			CreateTime:     e.CreateTime.Unix(),
+		})
+
+		totalAccuracy += e.AssessmentAccuracy.Float64
+		// 时长未知的记录不参与语速统计
+		if e.AssessmentPace.Valid {
+			totalPace += e.AssessmentPace.Float64
+			paceCount++
+		}
+	}
+
+	if len(expressions) > 0 {
+		resp.AverageAccuracy = totalAccuracy / float64(len(expressions))
+	}
+	if paceCount > 0 {
+		resp.AveragePace = totalPace / float64(paceCount)
+	}
+
+	return resp, nil
+}
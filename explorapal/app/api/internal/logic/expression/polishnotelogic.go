@@ -0,0 +1,322 @@
+package expression
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/conceptgraph"
+	"explorapal/pkg/embedding"
+	"explorapal/pkg/idgen"
+	"explorapal/pkg/langdetect"
+	"explorapal/pkg/moderation"
+	"explorapal/pkg/profile"
+	"explorapal/pkg/progress"
+	"explorapal/third/openai"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 需要复核的平行译文在审核记录中的字段名
+const translationField = "translation"
+
+type PolishNoteLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// AI润色生成笔记
+func NewPolishNoteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PolishNoteLogic {
+	return &PolishNoteLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PolishNoteLogic) PolishNote(req *types.PolishNoteReq) (resp *types.PolishNoteResp, err error) {
+	translateTo := ""
+	if req.TranslateTo != "" {
+		translateTo = langdetect.Normalize(req.TranslateTo)
+		if translateTo == "" {
+			return nil, fmt.Errorf("不支持的翻译语言: %s", req.TranslateTo)
+		}
+	}
+
+	if _, err := l.svcCtx.ProjectModel.FindOneOwned(l.ctx, req.ProjectId, auth.UserID(l.ctx)); err != nil {
+		if err == hps.ErrNotFound {
+			return nil, fmt.Errorf("项目不存在")
+		}
+		return nil, fmt.Errorf("查询项目失败: %w", err)
+	}
+
+	// 孩子的年龄、昵称和偏好语言以服务端资料为准
+	child, err := profile.Load(l.ctx, l.svcCtx.UserModel, auth.UserID(l.ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// 检测孩子使用的语言，润色时保持原语言；无法判断时使用偏好语言
+	detection := langdetect.Detect(req.RawContent)
+	if detection.Language == langdetect.LanguageUnknown {
+		detection.Language = child.Language
+	}
+
+	note, err := l.svcCtx.AIClient.PolishNote(l.ctx, req.RawContent, l.buildContextInfo(req, child), detection.Language)
+	if err != nil {
+		l.Logger.Errorf("润色笔记失败: %v", err)
+		return nil, err
+	}
+
+	// 模型输出入库前先做安全检查，过滤的内容直接替换；中等风险的内容等大人审核后再展示给孩子
+	flagged, err := l.checkOutput(note)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按需生成平行译文，目标语言与原文相同（且非混合表达）时无需翻译
+	var translation *openai.PolishedNote
+	if translateTo != "" && (translateTo != detection.Language || detection.Mixed) {
+		translation, err = l.svcCtx.AIClient.TranslateNote(l.ctx, note, translateTo)
+		if err != nil {
+			l.Logger.Errorf("生成平行译文失败: %v", err)
+			return nil, err
+		}
+		translationFlagged, err := l.checkOutput(translation)
+		if err != nil {
+			return nil, err
+		}
+		// 译文作为一个整体审核
+		for _, f := range translationFlagged {
+			f.Field = translationField
+			flagged = append(flagged, f)
+		}
+	}
+
+	reviewStatus := hps.ReviewStatusNone
+	if len(flagged) > 0 {
+		reviewStatus = hps.ReviewStatusPending
+	}
+	expressionID, err := l.saveExpression(req, note, translation, translateTo, detection, reviewStatus, flagged)
+	if err != nil {
+		l.Logger.Errorf("保存表达记录失败: %v", err)
+		return nil, err
+	}
+
+	resp = &types.PolishNoteResp{
+		OriginalContent: req.RawContent,
+		ExpressionId:    expressionID,
+		Suggestions:     []string{},
+		KeyLearnings:    []string{},
+		Language:        detection.Language,
+		MixedLanguage:   detection.Mixed,
+		ReviewStatus:    reviewStatus,
+	}
+	// 等待审核时不返回润色结果
+	if reviewStatus == hps.ReviewStatusPending {
+		resp.ReviewMessage = moderation.WaitingMessage
+		return resp, nil
+	}
+
+	resp.PolishedNote = toPolishedNote(note)
+	if translation != nil {
+		resp.Translation = toPolishedNote(translation)
+		resp.TranslationLanguage = translateTo
+	}
+
+	return resp, nil
+}
+
+// checkOutput 检查润色结果，被拦截时返回给孩子的提示；返回需要人工复核的字段
+func (l *PolishNoteLogic) checkOutput(note *openai.PolishedNote) ([]security.Flagged, error) {
+	blocked, flagged, err := l.svcCtx.SafetyGuard.CheckValueForReview(l.ctx, note, security.OutputPolicy, security.DirectionOutput)
+	if err != nil {
+		l.Logger.Errorf("检查润色结果失败: %v", err)
+		return nil, err
+	}
+	if blocked != nil {
+		return nil, blocked.Err()
+	}
+	return flagged, nil
+}
+
+// submitReview 在事务中把需要复核的润色结果按字段提交人工审核，每个字段一条审核记录
+// 标题、摘要和润色文本审核人可以修改，其余字段只能通过或驳回
+func (l *PolishNoteLogic) submitReview(ctx context.Context, tx *hps.Models, req *types.PolishNoteReq, expressionID int64,
+	note, translation *openai.PolishedNote, flagged []security.Flagged) error {
+	byField := make(map[string][]security.Flagged)
+	var fields []string
+	for _, f := range flagged {
+		if _, ok := byField[f.Field]; !ok {
+			fields = append(fields, f.Field)
+		}
+		byField[f.Field] = append(byField[f.Field], f)
+	}
+
+	for _, field := range fields {
+		sourceField, content := reviewContent(field, note, translation)
+		riskLevel, details := moderation.SummarizeFlagged(byField[field])
+		_, err := l.svcCtx.ReviewQueue.SubmitTx(ctx, tx, &moderation.Item{
+			ProjectID:   req.ProjectId,
+			UserID:      auth.UserID(ctx),
+			SourceTable: moderation.SourceExpressions,
+			SourceID:    expressionID,
+			SourceField: sourceField,
+			ContentType: security.ContentTypeText,
+			Content:     content,
+			RiskLevel:   riskLevel,
+			Details:     details,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reviewContent 返回需要复核的润色字段对应的来源字段和审核人看到的内容（过滤后）
+func reviewContent(field string, note, translation *openai.PolishedNote) (string, string) {
+	switch field {
+	case "title":
+		return moderation.FieldPolishedTitle, note.Title
+	case "summary":
+		return moderation.FieldPolishedSummary, note.Summary
+	case "key_points":
+		return moderation.FieldPolishedKeyPoints, strings.Join(note.KeyPoints, "\n")
+	case "scientific_concepts":
+		return moderation.FieldPolishedConcepts, strings.Join(note.ScientificConcepts, "\n")
+	case "questions":
+		return moderation.FieldPolishedQuestions, strings.Join(note.Questions, "\n")
+	case "connections":
+		return moderation.FieldPolishedConnections, strings.Join(note.Connections, "\n")
+	case translationField:
+		return moderation.FieldPolishedTranslation, nullJSON(translation).String
+	default:
+		return moderation.FieldPolishedFormatted, note.FormattedText
+	}
+}
+
+// buildContextInfo 组装润色所需的上下文信息
+func (l *PolishNoteLogic) buildContextInfo(req *types.PolishNoteReq, child *profile.Profile) string {
+	parts := []string{child.PromptContext()}
+	if req.ContextInfo.ProjectCategory != "" {
+		parts = append(parts, "项目类别："+req.ContextInfo.ProjectCategory)
+	}
+	if req.ContextInfo.ObservationResults != "" {
+		parts = append(parts, "观察结果："+req.ContextInfo.ObservationResults)
+	}
+	if req.ContextInfo.PreviousAnswers != "" {
+		parts = append(parts, "之前的回答："+req.ContextInfo.PreviousAnswers)
+	}
+	return strings.Join(parts, "\n")
+}
+
+// saveExpression 保存润色后的表达记录，原文润色结果与平行译文同时保存；有需要复核的字段时同时提交人工审核
+func (l *PolishNoteLogic) saveExpression(req *types.PolishNoteReq, note, translation *openai.PolishedNote,
+	translateTo string, detection *langdetect.Result, reviewStatus string, flagged []security.Flagged) (int64, error) {
+	expression := &hps.Expressions{
+		ExpressionId:        idgen.Next(),
+		ProjectId:           req.ProjectId,
+		UserId:              auth.UserID(l.ctx),
+		QuestionId:          sql.NullInt64{Int64: req.QuestionId, Valid: req.QuestionId > 0},
+		Type:                "note",
+		RawContent:          req.RawContent,
+		Language:            detection.Language,
+		MixedLanguage:       boolToInt64(detection.Mixed),
+		PolishedTitle:       nullString(note.Title),
+		PolishedSummary:     nullString(note.Summary),
+		PolishedKeyPoints:   nullJSON(note.KeyPoints),
+		PolishedConcepts:    nullJSON(note.ScientificConcepts),
+		PolishedQuestions:   nullJSON(note.Questions),
+		PolishedConnections: nullJSON(note.Connections),
+		PolishedFormatted:   nullString(note.FormattedText),
+		ReviewStatus:        reviewStatus,
+	}
+	if translation != nil {
+		expression.TranslationLanguage = nullString(translateTo)
+		expression.PolishedTranslation = nullJSON(translation)
+	}
+
+	// 表达记录、润色活动、审核记录、项目进度和最后活动时间在同一个事务中写入；
+	// 审核记录提交失败时表达记录一起回滚，不会留下永远等待审核的内容
+	err := l.svcCtx.UnitOfWork.Do(l.ctx, func(ctx context.Context, tx *hps.Models) error {
+		if _, err := tx.Expressions.Insert(ctx, expression); err != nil {
+			return err
+		}
+		if _, err := tx.ProjectActivities.Insert(ctx, l.buildActivity(req, expression.ExpressionId, translateTo, detection)); err != nil {
+			return fmt.Errorf("记录项目活动失败: %w", err)
+		}
+		if len(flagged) > 0 {
+			if err := l.submitReview(ctx, tx, req, expression.ExpressionId, note, translation, flagged); err != nil {
+				return err
+			}
+		}
+		return l.svcCtx.Progress.RecordTx(ctx, tx, req.ProjectId, progress.StageExpress)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := l.svcCtx.Embeddings.Add(l.ctx, embedding.ExpressionDocument(expression)); err != nil {
+		l.Logger.Errorf("保存表达向量失败: %v", err)
+		// 不影响主要流程，只记录错误
+	}
+	// 等待审核的润色结果在审核通过后再写入概念图
+	if err := l.svcCtx.Concepts.Add(l.ctx, conceptgraph.ExpressionRecord(expression)); err != nil {
+		l.Logger.Errorf("更新概念图失败: %v", err)
+		// 不影响主要流程，只记录错误
+	}
+	return expression.ExpressionId, nil
+}
+
+// buildActivity 润色笔记活动，metadata中记录检测到的语言供家长端统计
+func (l *PolishNoteLogic) buildActivity(req *types.PolishNoteReq, expressionID int64, translateTo string, detection *langdetect.Result) *hps.ProjectActivities {
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"expression_id": expressionID,
+		"language":      detection.Language,
+		"mixed":         detection.Mixed,
+		"ratios":        detection.Ratios,
+		"translate_to":  translateTo,
+	})
+
+	return &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   req.ProjectId,
+		UserId:      auth.UserID(l.ctx),
+		Type:        hps.ActivityTypePolishNote,
+		Description: fmt.Sprintf("用%s写了一篇探索笔记", langdetect.DisplayName(detection.Language)),
+		Metadata:    sql.NullString{String: string(metadata), Valid: true},
+	}
+}
+
+func toPolishedNote(note *openai.PolishedNote) types.PolishedNote {
+	return types.PolishedNote{
+		Title:              note.Title,
+		Summary:            note.Summary,
+		KeyPoints:          note.KeyPoints,
+		ScientificConcepts: note.ScientificConcepts,
+		Questions:          note.Questions,
+		Connections:        note.Connections,
+		VisualElements:     []types.VisualElement{},
+		FormattedText:      note.FormattedText,
+	}
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func nullJSON(v interface{}) sql.NullString {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(data), Valid: true}
+}
@@ -0,0 +1,256 @@
+package expression
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/consent"
+	"explorapal/pkg/embedding"
+	"explorapal/pkg/idgen"
+	"explorapal/pkg/langdetect"
+	"explorapal/pkg/profile"
+	"explorapal/pkg/progress"
+	"explorapal/pkg/speechassess"
+	"explorapal/third/security"
+	"explorapal/third/speech"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// 语音识别默认采样率
+	defaultSampleRate = 16000
+	// 发音练习目标句最大长度
+	maxTargetTextLength = 200
+)
+
+type SpeechToTextLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 语音转文字
+func NewSpeechToTextLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SpeechToTextLogic {
+	return &SpeechToTextLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SpeechToTextLogic) SpeechToText(req *types.SpeechToTextReq) (resp *types.SpeechToTextResp, err error) {
+	// 孩子账号需要家长同意后才能录音
+	if err := l.svcCtx.Consent.Require(l.ctx, consent.FeatureVoiceRecording); err != nil {
+		return nil, err
+	}
+
+	audioData, err := base64.StdEncoding.DecodeString(req.AudioData)
+	if err != nil {
+		return nil, fmt.Errorf("音频数据格式错误: %w", err)
+	}
+
+	if _, err := l.svcCtx.ProjectModel.FindOneOwned(l.ctx, req.ProjectId, auth.UserID(l.ctx)); err != nil {
+		if err == hps.ErrNotFound {
+			return nil, fmt.Errorf("项目不存在")
+		}
+		return nil, fmt.Errorf("查询项目失败: %w", err)
+	}
+
+	// 客户端未指定语言时按孩子的偏好语言选择识别模型（中文模型支持中英混说），识别后再检测实际语言
+	asrLanguage := langdetect.Normalize(req.Language)
+	if asrLanguage == "" {
+		child, err := profile.Load(l.ctx, l.svcCtx.UserModel, auth.UserID(l.ctx))
+		if err != nil {
+			return nil, err
+		}
+		asrLanguage = child.Language
+	}
+
+	text, err := l.svcCtx.SpeechClient.SpeechToText(l.ctx, audioData, req.AudioFormat, defaultSampleRate, asrLanguage)
+	if err != nil {
+		l.Logger.Errorf("语音识别失败: %v", err)
+		return nil, err
+	}
+
+	// 识别文本是孩子说的话，入库前按用户输入检查
+	decision := l.svcCtx.SafetyGuard.Check(l.ctx, security.CheckTarget{
+		Content:     text,
+		ContentType: security.ContentTypeText,
+		Direction:   security.DirectionInput,
+		Field:       "transcript",
+	})
+	if decision.Blocked() {
+		return nil, decision.Err()
+	}
+	text = decision.Content
+
+	detection := langdetect.Detect(text)
+	if detection.Language == langdetect.LanguageUnknown {
+		detection.Language = asrLanguage
+	}
+
+	duration := speech.AudioDuration(audioData, req.AudioFormat)
+
+	// 发音练习模式：与目标句比对，给出准确率、语速和停顿词反馈
+	var assessment *speechassess.Result
+	if req.Assess {
+		target, err := l.resolveTargetText(req)
+		if err != nil {
+			return nil, err
+		}
+		assessment = speechassess.Assess(text, target, duration)
+	}
+
+	// 保存表达记录
+	expressionID := idgen.Next()
+	expression := &hps.Expressions{
+		ExpressionId:  expressionID,
+		ProjectId:     req.ProjectId,
+		UserId:        auth.UserID(l.ctx),
+		Type:          "speech",
+		RawContent:    text,
+		Language:      detection.Language,
+		MixedLanguage: boolToInt64(detection.Mixed),
+		AudioFormat:   sql.NullString{String: req.AudioFormat, Valid: req.AudioFormat != ""},
+		AudioSize:     sql.NullInt64{Int64: int64(len(audioData)), Valid: true},
+		Duration:      sql.NullFloat64{Float64: duration, Valid: duration > 0},
+		ReviewStatus:  hps.ReviewStatusNone,
+	}
+	if assessment != nil {
+		expression.AssessmentTarget = nullString(assessment.TargetText)
+		expression.AssessmentAccuracy = sql.NullFloat64{Float64: assessment.Accuracy, Valid: true}
+		expression.AssessmentPace = sql.NullFloat64{Float64: assessment.WordsPerSecond, Valid: assessment.PaceAssessed}
+		expression.AssessmentFillerCount = sql.NullInt64{Int64: int64(assessment.FillerCount), Valid: true}
+		expression.AssessmentDetail = nullJSON(map[string]interface{}{
+			"words":    assessment.Words,
+			"fillers":  assessment.Fillers,
+			"feedback": assessment.Feedback,
+		})
+	}
+
+	// 表达记录、语音活动、项目进度和最后活动时间在同一个事务中写入
+	err = l.svcCtx.UnitOfWork.Do(l.ctx, func(ctx context.Context, tx *hps.Models) error {
+		if _, err := tx.Expressions.Insert(ctx, expression); err != nil {
+			return fmt.Errorf("保存表达记录失败: %w", err)
+		}
+		if _, err := tx.ProjectActivities.Insert(ctx, l.buildActivity(req, expressionID, detection, assessment)); err != nil {
+			return fmt.Errorf("记录项目活动失败: %w", err)
+		}
+		return l.svcCtx.Progress.RecordTx(ctx, tx, req.ProjectId, progress.StageExpress)
+	})
+	if err != nil {
+		l.Logger.Errorf("保存表达记录失败: %v", err)
+		return nil, err
+	}
+
+	if err := l.svcCtx.Embeddings.Add(l.ctx, embedding.ExpressionDocument(expression)); err != nil {
+		l.Logger.Errorf("保存表达向量失败: %v", err)
+		// 不影响主要流程，只记录错误
+	}
+
+	resp = &types.SpeechToTextResp{
+		Text:          text,
+		Duration:      duration,
+		Language:      detection.Language,
+		MixedLanguage: detection.Mixed,
+		ExpressionId:  expressionID,
+	}
+	if assessment != nil {
+		resp.Assessment = toSpeechAssessment(assessment)
+	}
+
+	return resp, nil
+}
+
+// resolveTargetText 确定发音练习的目标句，未指定时使用关联观察记录的学名
+func (l *SpeechToTextLogic) resolveTargetText(req *types.SpeechToTextReq) (string, error) {
+	if req.TargetText != "" {
+		if utf8.RuneCountInString(req.TargetText) > maxTargetTextLength {
+			return "", fmt.Errorf("目标句不能超过%d个字符", maxTargetTextLength)
+		}
+		return req.TargetText, nil
+	}
+	if req.ObservationId == 0 {
+		return "", fmt.Errorf("发音评估需要提供目标句或观察记录ID")
+	}
+
+	observation, err := l.svcCtx.ObservationModel.FindOneByObservationId(l.ctx, req.ObservationId)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return "", fmt.Errorf("观察记录不存在")
+		}
+		return "", fmt.Errorf("查询观察记录失败: %w", err)
+	}
+	if observation.ProjectId != req.ProjectId {
+		return "", fmt.Errorf("观察记录不属于该项目")
+	}
+	if !observation.ScientificName.Valid || observation.ScientificName.String == "" {
+		return "", fmt.Errorf("观察记录没有学名，请提供目标句")
+	}
+	return observation.ScientificName.String, nil
+}
+
+// buildActivity 语音转文字活动，metadata中记录检测到的语言供家长端统计
+func (l *SpeechToTextLogic) buildActivity(req *types.SpeechToTextReq, expressionID int64, detection *langdetect.Result, assessment *speechassess.Result) *hps.ProjectActivities {
+	data := map[string]interface{}{
+		"expression_id": expressionID,
+		"language":      detection.Language,
+		"mixed":         detection.Mixed,
+		"ratios":        detection.Ratios,
+	}
+	if assessment != nil {
+		data["assessment"] = map[string]interface{}{
+			"target_text":      assessment.TargetText,
+			"accuracy":         assessment.Accuracy,
+			"pace_assessed":    assessment.PaceAssessed,
+			"words_per_second": assessment.WordsPerSecond,
+			"filler_count":     assessment.FillerCount,
+		}
+	}
+	metadata, _ := json.Marshal(data)
+
+	return &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   req.ProjectId,
+		UserId:      auth.UserID(l.ctx),
+		Type:        hps.ActivityTypeSpeechToText,
+		Description: fmt.Sprintf("录制了一段%s语音", langdetect.DisplayName(detection.Language)),
+		Metadata:    sql.NullString{String: string(metadata), Valid: true},
+	}
+}
+
+func toSpeechAssessment(result *speechassess.Result) types.SpeechAssessment {
+	words := make([]types.WordAssessment, 0, len(result.Words))
+	for _, w := range result.Words {
+		words = append(words, types.WordAssessment{
+			Word:   w.Word,
+			Heard:  w.Heard,
+			Status: w.Status,
+		})
+	}
+	return types.SpeechAssessment{
+		TargetText:     result.TargetText,
+		Accuracy:       result.Accuracy,
+		PaceAssessed:   result.PaceAssessed,
+		WordsPerSecond: result.WordsPerSecond,
+		FillerCount:    int32(result.FillerCount),
+		Words:          words,
+		Feedback:       result.Feedback,
+	}
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
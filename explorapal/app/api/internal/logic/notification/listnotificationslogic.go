@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	timeLayout = "2006-01-02 15:04:05"
+	// 每页最多返回的通知数
+	maxPageSize = 100
+)
+
+type ListNotificationsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取通知列表
+func NewListNotificationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListNotificationsLogic {
+	return &ListNotificationsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListNotificationsLogic) ListNotifications(req *types.ListNotificationsReq) (resp *types.ListNotificationsResp, err error) {
+	userID := auth.UserID(l.ctx)
+
+	page, pageSize := int64(req.Page), int64(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	notifications, err := l.svcCtx.NotificationModel.FindByUser(l.ctx, userID, req.UnreadOnly, page, pageSize)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, fmt.Errorf("查询通知失败: %w", err)
+	}
+	unread, err := l.svcCtx.NotificationModel.CountUnread(l.ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("统计未读通知失败: %w", err)
+	}
+
+	list := make([]types.NotificationInfo, 0, len(notifications))
+	for _, n := range notifications {
+		list = append(list, types.NotificationInfo{
+			NotificationId: n.NotificationId,
+			Type:           n.Type,
+			Title:          n.Title,
+			Content:        n.Content.String,
+			ProjectId:      n.ProjectId.Int64,
+			RefType:        n.RefType.String,
+			RefId:          n.RefId.Int64,
+			Read:           n.ReadTime.Valid,
+			CreateTime:     n.CreateTime.Format(timeLayout),
+		})
+	}
+
+	return &types.ListNotificationsResp{
+		List:   list,
+		Unread: unread,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MarkNotificationsReadLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 标记通知已读
+func NewMarkNotificationsReadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MarkNotificationsReadLogic {
+	return &MarkNotificationsReadLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *MarkNotificationsReadLogic) MarkNotificationsRead(req *types.MarkNotificationsReadReq) (resp *types.MarkNotificationsReadResp, err error) {
+	updated, err := l.svcCtx.NotificationModel.MarkRead(l.ctx, auth.UserID(l.ctx), req.NotificationIds, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("标记通知已读失败: %w", err)
+	}
+	return &types.MarkNotificationsReadResp{Updated: updated}, nil
+}
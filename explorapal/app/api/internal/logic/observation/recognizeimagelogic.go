@@ -0,0 +1,256 @@
+package observation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/conceptgraph"
+	"explorapal/pkg/consent"
+	"explorapal/pkg/embedding"
+	"explorapal/pkg/idgen"
+	"explorapal/pkg/moderation"
+	"explorapal/pkg/profile"
+	"explorapal/pkg/progress"
+	"explorapal/third/openai"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 图像识别提示词
+const recognizePrompt = `请识别图片中孩子观察的对象（动物、植物、昆虫、岩石、天气现象等），用适合儿童的语言描述。
+请以JSON格式返回包含以下字段的结果：
+- object_name: 对象名称
+- category: 类别
+- confidence: 置信度(0-1)
+- description: 描述
+- key_features: 关键特征数组
+- scientific_name: 学名`
+
+// 识别结果中推荐的相似观察数量
+const relatedImageLimit = 3
+
+type RecognizeImageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 识别图片内容
+func NewRecognizeImageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecognizeImageLogic {
+	return &RecognizeImageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RecognizeImageLogic) RecognizeImage(req *types.RecognizeImageReq) (resp *types.RecognizeImageResp, err error) {
+	// 孩子账号需要家长同意后才能识别照片
+	if err := l.svcCtx.Consent.Require(l.ctx, consent.FeaturePhotoUpload); err != nil {
+		return nil, err
+	}
+
+	observation, err := l.svcCtx.ObservationModel.FindOneByObservationId(l.ctx, req.ObservationId)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, fmt.Errorf("观察记录不存在")
+		}
+		return nil, fmt.Errorf("查询观察记录失败: %w", err)
+	}
+	userID := auth.UserID(l.ctx)
+	if observation.ProjectId != req.ProjectId || observation.UserId != userID {
+		return nil, fmt.Errorf("观察记录不属于该项目")
+	}
+
+	// 未通过图片安全筛查的照片已隔离，不能发给识别模型
+	if observation.Quarantined() {
+		return nil, errors.New(imageBlockedMessage)
+	}
+	// 上一次的识别结果还在等待审核
+	if observation.ReviewStatus == hps.ReviewStatusPending {
+		return nil, errors.New(moderation.WaitingMessage)
+	}
+
+	// 按孩子的年龄和偏好语言调整描述
+	child, err := profile.Load(l.ctx, l.svcCtx.UserModel, userID)
+	if err != nil {
+		return nil, err
+	}
+	prompt := fmt.Sprintf("%s\n\n%s\n请使用孩子的偏好语言回答。", recognizePrompt, child.PromptContext())
+
+	result, err := l.svcCtx.AIClient.AnalyzeImage(l.ctx, observation.ImageUrl, prompt)
+	if err != nil {
+		l.Logger.Errorf("图像识别失败: %v", err)
+		return nil, err
+	}
+
+	// 模型输出入库前先做安全检查，过滤的内容直接替换；中等风险的内容等大人审核后再展示给孩子
+	flagged, err := l.checkOutput(result)
+	if err != nil {
+		return nil, err
+	}
+	reviewStatus := hps.ReviewStatusNone
+	if len(flagged) > 0 {
+		reviewStatus = hps.ReviewStatusPending
+	}
+
+	keyFeatures, _ := json.Marshal(result.KeyFeatures)
+	observation.ObjectName = sql.NullString{String: result.ObjectName, Valid: result.ObjectName != ""}
+	observation.Category = sql.NullString{String: result.Category, Valid: result.Category != ""}
+	observation.Confidence = sql.NullFloat64{Float64: result.Confidence, Valid: result.Confidence > 0}
+	observation.Description = sql.NullString{String: result.Description, Valid: result.Description != ""}
+	observation.KeyFeatures = sql.NullString{String: string(keyFeatures), Valid: len(result.KeyFeatures) > 0}
+	observation.ScientificName = sql.NullString{String: result.ScientificName, Valid: result.ScientificName != ""}
+	observation.Status = hps.ObservationStatusRecognized
+	observation.ReviewStatus = reviewStatus
+
+	// 识别结果、识别活动、审核记录、项目进度和最后活动时间在同一个事务中写入
+	err = l.svcCtx.UnitOfWork.Do(l.ctx, func(ctx context.Context, tx *hps.Models) error {
+		if err := tx.Observations.Update(ctx, observation); err != nil {
+			return fmt.Errorf("更新观察记录失败: %w", err)
+		}
+		if _, err := tx.ProjectActivities.Insert(ctx, l.buildActivity(observation)); err != nil {
+			return fmt.Errorf("记录项目活动失败: %w", err)
+		}
+		if len(flagged) > 0 {
+			if err := l.submitReview(ctx, tx, observation, result, flagged); err != nil {
+				return err
+			}
+		}
+		return l.svcCtx.Progress.RecordTx(ctx, tx, observation.ProjectId, progress.StageObserve)
+	})
+	if err != nil {
+		l.Logger.Errorf("保存识别结果失败: %v", err)
+		return nil, err
+	}
+
+	// 等待审核时不返回识别结果，审核通过后再写入概念图
+	if reviewStatus == hps.ReviewStatusPending {
+		return &types.RecognizeImageResp{
+			ObservationId:    observation.ObservationId,
+			Recognition:      types.RecognitionResult{KeyFeatures: []string{}},
+			Suggestions:      []string{},
+			NextActions:      []string{},
+			InterestingFacts: []string{},
+			ReviewStatus:     reviewStatus,
+			ReviewMessage:    moderation.WaitingMessage,
+		}, nil
+	}
+
+	if err := l.svcCtx.Concepts.Add(l.ctx, conceptgraph.ObservationRecord(observation)); err != nil {
+		l.Logger.Errorf("更新概念图失败: %v", err)
+		// 不影响主要流程，只记录错误
+	}
+
+	keyFeatureList := result.KeyFeatures
+	if keyFeatureList == nil {
+		keyFeatureList = []string{}
+	}
+	return &types.RecognizeImageResp{
+		ObservationId: observation.ObservationId,
+		Recognition: types.RecognitionResult{
+			ObjectName:     result.ObjectName,
+			Category:       result.Category,
+			Confidence:     result.Confidence,
+			Description:    result.Description,
+			KeyFeatures:    keyFeatureList,
+			ScientificName: result.ScientificName,
+			RelatedImages:  l.relatedImages(observation),
+		},
+		Suggestions:      []string{},
+		NextActions:      []string{},
+		InterestingFacts: []string{},
+		ReviewStatus:     reviewStatus,
+	}, nil
+}
+
+// checkOutput 检查识别结果，被拦截时返回给孩子的提示；返回需要人工复核的字段
+func (l *RecognizeImageLogic) checkOutput(result *openai.ImageAnalysisResult) ([]security.Flagged, error) {
+	blocked, flagged, err := l.svcCtx.SafetyGuard.CheckValueForReview(l.ctx, result, security.OutputPolicy, security.DirectionOutput)
+	if err != nil {
+		l.Logger.Errorf("检查识别结果失败: %v", err)
+		return nil, err
+	}
+	if blocked != nil {
+		return nil, blocked.Err()
+	}
+	return flagged, nil
+}
+
+// submitReview 在事务中把需要复核的识别结果提交人工审核，识别结果只能通过或驳回
+func (l *RecognizeImageLogic) submitReview(ctx context.Context, tx *hps.Models, observation *hps.Observations,
+	result *openai.ImageAnalysisResult, flagged []security.Flagged) error {
+	riskLevel, details := moderation.SummarizeFlagged(flagged)
+	content := fmt.Sprintf("%s（%s）：%s\n关键特征：%s", result.ObjectName, result.ScientificName,
+		result.Description, strings.Join(result.KeyFeatures, "、"))
+	_, err := l.svcCtx.ReviewQueue.SubmitTx(ctx, tx, &moderation.Item{
+		ProjectID:   observation.ProjectId,
+		UserID:      observation.UserId,
+		SourceTable: moderation.SourceObservations,
+		SourceID:    observation.ObservationId,
+		SourceField: moderation.FieldRecognition,
+		ContentType: security.ContentTypeText,
+		Content:     content,
+		RiskLevel:   riskLevel,
+		Details:     details,
+	})
+	return err
+}
+
+// relatedImages 保存观察的向量，并从孩子以前的观察中找出相似的图片
+func (l *RecognizeImageLogic) relatedImages(observation *hps.Observations) []types.RelatedImage {
+	images := []types.RelatedImage{}
+	if err := l.svcCtx.Embeddings.Add(l.ctx, embedding.ObservationDocument(observation)); err != nil {
+		l.Logger.Errorf("保存观察向量失败: %v", err)
+		// 不影响主要流程，只记录错误
+		return images
+	}
+
+	similar, err := l.svcCtx.Embeddings.SimilarObservations(l.ctx, observation.UserId, observation.ObservationId, relatedImageLimit)
+	if err != nil {
+		l.Logger.Errorf("查询相似观察失败: %v", err)
+		return images
+	}
+	for _, s := range similar {
+		images = append(images, types.RelatedImage{
+			Url:         s.Observation.ImageUrl,
+			Title:       s.Observation.ObjectName.String,
+			Description: s.Observation.Description.String,
+			Credit:      "我的观察 · " + s.ProjectTitle,
+		})
+	}
+	return images
+}
+
+// buildActivity 图像识别活动，识别结果等待审核时不出现在时间线上
+func (l *RecognizeImageLogic) buildActivity(observation *hps.Observations) *hps.ProjectActivities {
+	objectName := observation.ObjectName.String
+	description := fmt.Sprintf("识别了%s", objectName)
+	if observation.ReviewStatus == hps.ReviewStatusPending {
+		objectName = ""
+		description = "识别了一张观察照片，结果等待大人查看"
+	}
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"observation_id": observation.ObservationId,
+		"object_name":    objectName,
+		"review_status":  observation.ReviewStatus,
+	})
+
+	return &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   observation.ProjectId,
+		UserId:      observation.UserId,
+		Type:        hps.ActivityTypeRecognizeImage,
+		Description: description,
+		Metadata:    sql.NullString{String: string(metadata), Valid: true},
+	}
+}
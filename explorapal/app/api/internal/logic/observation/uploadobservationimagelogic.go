@@ -0,0 +1,276 @@
+package observation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/consent"
+	"explorapal/pkg/idgen"
+	"explorapal/pkg/imagescreen"
+	"explorapal/pkg/moderation"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// 观察图片的存储目录
+	observationImageDir = "observations"
+	// 图片未通过安全筛查时给孩子的提示
+	imageBlockedMessage = "这张照片需要请大人先看一下哦，换一张只拍大自然的照片试试吧！"
+)
+
+// 支持的图片格式及存储扩展名
+var imageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+}
+
+type UploadObservationImageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 上传观察图片
+func NewUploadObservationImageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UploadObservationImageLogic {
+	return &UploadObservationImageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// screeningDetail 图片安全筛查结果，供人工审核查看
+type screeningDetail struct {
+	Local  *imagescreen.Result `json:"local"`
+	Remote *remoteScreening    `json:"remote,omitempty"`
+}
+
+// remoteScreening 安全中心的图片检查结论
+type remoteScreening struct {
+	Action    string                `json:"action"`
+	RiskLevel string                `json:"risk_level"`
+	Details   []security.RiskDetail `json:"details,omitempty"`
+}
+
+func (l *UploadObservationImageLogic) UploadObservationImage(req *types.UploadObservationImageReq) (resp *types.UploadObservationImageResp, err error) {
+	// 孩子账号需要家长同意后才能上传照片
+	if err := l.svcCtx.Consent.Require(l.ctx, consent.FeaturePhotoUpload); err != nil {
+		return nil, err
+	}
+
+	userID := auth.UserID(l.ctx)
+	if _, err := l.svcCtx.ProjectModel.FindOneOwned(l.ctx, req.ProjectId, userID); err != nil {
+		if err == hps.ErrNotFound {
+			return nil, fmt.Errorf("项目不存在")
+		}
+		return nil, fmt.Errorf("查询项目失败: %w", err)
+	}
+
+	data, ext, err := l.decodeImage(req.ImageData)
+	if err != nil {
+		return nil, err
+	}
+
+	// 本地筛查：人脸、门牌和屏幕文字；定位信息直接去除
+	screening, err := imagescreen.Screen(data, imagescreen.Options{
+		MaxFaces:     l.svcCtx.Config.ImageScreening.MaxFaces,
+		MaxTextRatio: l.svcCtx.Config.ImageScreening.MaxTextRatio,
+		MaxPixels:    l.svcCtx.Config.ImageScreening.MaxPixels,
+	})
+	if errors.Is(err, imagescreen.ErrTooLarge) {
+		return nil, fmt.Errorf("图片尺寸过大，请压缩后再上传")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("图片无法识别，请重新拍一张: %w", err)
+	}
+	if screening.HasGPS {
+		data = imagescreen.StripMetadata(data)
+	}
+
+	observationID := idgen.Next()
+	key := fmt.Sprintf("%s/%d/%d.%s", observationImageDir, req.ProjectId, observationID, ext)
+	observation := &hps.Observations{
+		ObservationId: observationID,
+		ProjectId:     req.ProjectId,
+		UserId:        userID,
+		ImageName:     sql.NullString{String: req.ImageName, Valid: req.ImageName != ""},
+		ImageType:     sql.NullString{String: ext, Valid: true},
+		ImageSize:     sql.NullInt64{Int64: int64(len(data)), Valid: true},
+		Status:        hps.ObservationStatusUploaded,
+		ReviewStatus:  hps.ReviewStatusNone,
+	}
+	detail := &screeningDetail{Local: screening}
+
+	message := ""
+	if screening.Passed() {
+		message, err = l.storeAndCheck(key, data, observation, detail)
+	} else {
+		message = imageBlockedMessage
+		err = l.quarantine(key, data, observation)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if detailData, err := json.Marshal(detail); err == nil {
+		observation.ScreeningDetail = sql.NullString{String: string(detailData), Valid: true}
+	}
+
+	// 观察记录、上传活动和隔离图片的审核记录在同一个事务中写入
+	err = l.svcCtx.UnitOfWork.Do(l.ctx, func(ctx context.Context, tx *hps.Models) error {
+		if _, err := tx.Observations.Insert(ctx, observation); err != nil {
+			return fmt.Errorf("保存观察记录失败: %w", err)
+		}
+		if _, err := tx.ProjectActivities.Insert(ctx, l.buildActivity(observation, detail)); err != nil {
+			return fmt.Errorf("记录项目活动失败: %w", err)
+		}
+		if observation.Status == hps.ObservationStatusBlocked {
+			return l.submitReview(ctx, tx, observation, detail)
+		}
+		return nil
+	})
+	if err != nil {
+		l.Logger.Errorf("保存观察记录失败: %v", err)
+		return nil, err
+	}
+
+	return &types.UploadObservationImageResp{
+		ObservationId: observationID,
+		ImageUrl:      observation.ImageUrl,
+		Status:        observation.Status,
+		Message:       message,
+	}, nil
+}
+
+// decodeImage 解码base64图片，校验大小和格式，返回图片数据和扩展名
+func (l *UploadObservationImageLogic) decodeImage(imageData string) ([]byte, string, error) {
+	// 兼容data URL格式：data:image/png;base64,xxxx
+	if i := strings.Index(imageData, ","); i >= 0 && strings.HasPrefix(imageData, "data:") {
+		imageData = imageData[i+1:]
+	}
+	data, err := base64.StdEncoding.DecodeString(imageData)
+	if err != nil {
+		return nil, "", fmt.Errorf("图片数据格式错误: %w", err)
+	}
+
+	maxSize := l.svcCtx.Config.ImageScreening.MaxSize
+	if maxSize > 0 && int64(len(data)) > maxSize {
+		return nil, "", fmt.Errorf("图片不能超过%dMB", maxSize>>20)
+	}
+
+	ext, ok := imageExtensions[http.DetectContentType(data)]
+	if !ok {
+		return nil, "", fmt.Errorf("仅支持jpeg和png格式的图片")
+	}
+	return data, ext, nil
+}
+
+// storeAndCheck 保存图片后请安全中心检查图片地址，未通过时移入隔离区，返回给孩子的提示
+func (l *UploadObservationImageLogic) storeAndCheck(key string, data []byte, observation *hps.Observations, detail *screeningDetail) (string, error) {
+	imageURL, err := l.svcCtx.Storage.Put(l.ctx, key, data)
+	if err != nil {
+		l.Logger.Errorf("保存观察图片失败: %v", err)
+		return "", err
+	}
+
+	decision := l.svcCtx.SafetyGuard.Check(l.ctx, security.CheckTarget{
+		Content:     imageURL,
+		ContentType: security.ContentTypeImageURL,
+		Direction:   security.DirectionInput,
+		Field:       "image_url",
+	})
+	detail.Remote = &remoteScreening{Action: decision.Action, RiskLevel: decision.RiskLevel}
+	if decision.Result != nil {
+		detail.Remote.Details = decision.Result.Details
+	}
+
+	if !decision.Blocked() {
+		observation.ImageUrl = imageURL
+		return "", nil
+	}
+
+	quarantineKey, err := l.svcCtx.Storage.Quarantine(l.ctx, key)
+	if err != nil {
+		l.Logger.Errorf("隔离观察图片失败: %v", err)
+		return "", err
+	}
+	observation.Status = hps.ObservationStatusBlocked
+	observation.QuarantineKey = sql.NullString{String: quarantineKey, Valid: true}
+
+	// 安全中心不可用时提示稍后再试，其余情况提示请大人查看
+	if decision.RiskLevel == security.RiskLevelError {
+		return decision.Message, nil
+	}
+	return imageBlockedMessage, nil
+}
+
+// quarantine 未通过本地筛查的图片直接保存到隔离区，不会发给安全中心和识别模型
+func (l *UploadObservationImageLogic) quarantine(key string, data []byte, observation *hps.Observations) error {
+	quarantineKey, err := l.svcCtx.Storage.PutQuarantine(l.ctx, key, data)
+	if err != nil {
+		l.Logger.Errorf("隔离观察图片失败: %v", err)
+		return err
+	}
+	observation.Status = hps.ObservationStatusBlocked
+	observation.QuarantineKey = sql.NullString{String: quarantineKey, Valid: true}
+	return nil
+}
+
+// submitReview 在事务中把隔离的图片提交人工审核，审核通过后移回并恢复识别
+func (l *UploadObservationImageLogic) submitReview(ctx context.Context, tx *hps.Models, observation *hps.Observations, detail *screeningDetail) error {
+	riskLevel := security.RiskLevelHigh
+	if detail.Remote != nil && detail.Remote.RiskLevel != "" {
+		riskLevel = detail.Remote.RiskLevel
+	}
+	_, err := l.svcCtx.ReviewQueue.SubmitTx(ctx, tx, &moderation.Item{
+		ProjectID:   observation.ProjectId,
+		UserID:      observation.UserId,
+		SourceTable: moderation.SourceObservations,
+		SourceID:    observation.ObservationId,
+		SourceField: moderation.FieldImage,
+		ContentType: security.ContentTypeImageURL,
+		ContentRef:  observation.QuarantineKey.String,
+		RiskLevel:   riskLevel,
+		Details:     detail,
+	})
+	return err
+}
+
+// buildActivity 上传图片活动，metadata中记录筛查结论
+func (l *UploadObservationImageLogic) buildActivity(observation *hps.Observations, detail *screeningDetail) *hps.ProjectActivities {
+	findings := make([]string, 0, len(detail.Local.Findings))
+	for _, f := range detail.Local.Findings {
+		findings = append(findings, f.Type)
+	}
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"observation_id": observation.ObservationId,
+		"status":         observation.Status,
+		"findings":       findings,
+	})
+
+	description := "上传了一张观察照片"
+	if observation.Status == hps.ObservationStatusBlocked {
+		description = "上传的观察照片等待大人查看"
+	}
+
+	return &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   observation.ProjectId,
+		UserId:      observation.UserId,
+		Type:        hps.ActivityTypeUploadImage,
+		Description: description,
+		Metadata:    sql.NullString{String: string(metadata), Valid: true},
+	}
+}
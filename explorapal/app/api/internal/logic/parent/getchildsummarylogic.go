@@ -0,0 +1,78 @@
+package parent
+
+import (
+	"context"
+	"time"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/pkg/dashboard"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const dateLayout = "2006-01-02"
+
+type GetChildSummaryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取孩子的探索汇总
+func NewGetChildSummaryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetChildSummaryLogic {
+	return &GetChildSummaryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetChildSummaryLogic) GetChildSummary(req *types.GetChildSummaryReq) (resp *types.GetChildSummaryResp, err error) {
+	period, err := dashboard.ParsePeriod(req.StartDate, req.EndDate, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 只有已关联该孩子的家长可以查看
+	summary, err := l.svcCtx.Dashboard.Summarize(l.ctx, req.ChildId, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetChildSummaryResp{
+		ChildId:           summary.ChildID,
+		Nickname:          summary.Nickname,
+		StartDate:         summary.Period.From.Format(dateLayout),
+		EndDate:           summary.Period.To.AddDate(0, 0, -1).Format(dateLayout),
+		ProjectsStarted:   summary.ProjectsStarted,
+		ProjectsCompleted: summary.ProjectsCompleted,
+		Observations:      toCategoryCounts(summary.Observations),
+		ObservationTotal:  summary.ObservationTotal,
+		QuestionsAnswered: summary.QuestionsAnswered,
+		Expressions:       toCategoryCounts(summary.Expressions),
+		ExpressionTotal:   summary.ExpressionTotal,
+		Achievements:      toCategoryCounts(summary.Achievements),
+		AchievementTotal:  summary.AchievementTotal,
+		TimeOfDay: types.TimeOfDay{
+			Hours:     summary.TimeOfDay.Hours,
+			Morning:   summary.TimeOfDay.Morning,
+			Afternoon: summary.TimeOfDay.Afternoon,
+			Evening:   summary.TimeOfDay.Evening,
+			Night:     summary.TimeOfDay.Night,
+			Peak:      summary.TimeOfDay.Peak,
+		},
+		Highlights: summary.Highlights,
+	}, nil
+}
+
+func toCategoryCounts(counts []dashboard.Count) []types.CategoryCount {
+	result := make([]types.CategoryCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, types.CategoryCount{
+			Name:  c.Name,
+			Total: c.Total,
+		})
+	}
+	return result
+}
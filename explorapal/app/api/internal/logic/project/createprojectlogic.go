@@ -0,0 +1,51 @@
+package project
+
+import (
+	"context"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/project-management/rpc/projectmanagementservice"
+	"explorapal/pkg/projectstatus"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateProjectLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建探索项目
+func NewCreateProjectLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateProjectLogic {
+	return &CreateProjectLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateProjectLogic) CreateProject(req *types.CreateProjectReq) (resp *types.CreateProjectResp, err error) {
+	// 项目归属于令牌中的用户，由项目管理服务从透传的令牌中取得
+	out, err := l.svcCtx.ProjectManagementRpc.CreateProject(l.ctx, &projectmanagementservice.CreateProjectReq{
+		Title:       req.Title,
+		Description: req.Description,
+		Category:    req.Category,
+		Tags:        req.Tags,
+		TemplateId:  req.TemplateId,
+	})
+	if err != nil {
+		l.Logger.Errorf("创建项目失败: %v", err)
+		return nil, err
+	}
+	if err := checkStatus(out.Status, out.Msg); err != nil {
+		return nil, err
+	}
+
+	return &types.CreateProjectResp{
+		ProjectId:   out.ProjectId,
+		ProjectCode: out.ProjectCode,
+		Status:      projectstatus.Active,
+	}, nil
+}
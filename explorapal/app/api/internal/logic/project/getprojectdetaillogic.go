@@ -0,0 +1,67 @@
+package project
+
+import (
+	"context"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/project-management/rpc/projectmanagementservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetProjectDetailLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取项目详情
+func NewGetProjectDetailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetProjectDetailLogic {
+	return &GetProjectDetailLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetProjectDetailLogic) GetProjectDetail(req *types.GetProjectDetailReq) (resp *types.GetProjectDetailResp, err error) {
+	// 等待审核的内容已由项目管理服务替换为提示语
+	out, err := l.svcCtx.ProjectManagementRpc.GetProjectDetail(l.ctx, &projectmanagementservice.GetProjectDetailReq{
+		ProjectId: req.ProjectId,
+	})
+	if err != nil {
+		l.Logger.Errorf("查询项目详情失败: %v", err)
+		return nil, err
+	}
+	if err := checkStatus(out.Status, out.Msg); err != nil {
+		return nil, err
+	}
+
+	resp = &types.GetProjectDetailResp{
+		Activities:   make([]types.ProjectActivity, 0, len(out.Activities)),
+		Achievements: make([]types.ProjectAchievement, 0, len(out.Achievements)),
+	}
+	if out.Project != nil {
+		resp.Project = toProjectDetail(out.Project)
+	}
+	for _, a := range out.Activities {
+		resp.Activities = append(resp.Activities, types.ProjectActivity{
+			ActivityId:  a.ActivityId,
+			Type:        a.Type,
+			Description: a.Description,
+			CreateTime:  a.CreateTime,
+		})
+	}
+	for _, a := range out.Achievements {
+		resp.Achievements = append(resp.Achievements, types.ProjectAchievement{
+			AchievementId: a.AchievementId,
+			Type:          a.Type,
+			Title:         a.Title,
+			Content:       a.Content,
+			Url:           a.Url,
+			CreateTime:    a.CreateTime,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,59 @@
+package project
+
+import (
+	"context"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/project-management/rpc/projectmanagementservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetProjectListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取项目列表
+func NewGetProjectListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetProjectListLogic {
+	return &GetProjectListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetProjectListLogic) GetProjectList(req *types.GetProjectListReq) (resp *types.GetProjectListResp, err error) {
+	out, err := l.svcCtx.ProjectManagementRpc.GetProjectList(l.ctx, &projectmanagementservice.GetProjectListReq{
+		Category:  req.Category,
+		Status:    req.Status,
+		PageSize:  req.PageSize,
+		Page:      req.Page,
+		Tags:      req.Tags,
+		Keyword:   req.Keyword,
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+		Cursor:    req.Cursor,
+	})
+	if err != nil {
+		l.Logger.Errorf("查询项目列表失败: %v", err)
+		return nil, err
+	}
+	if err := checkStatus(out.Status, out.Msg); err != nil {
+		return nil, err
+	}
+
+	resp = &types.GetProjectListResp{
+		List:       make([]types.ProjectInfo, 0, len(out.List)),
+		Total:      out.Total,
+		PageSize:   out.PageSize,
+		Page:       out.Page,
+		NextCursor: out.NextCursor,
+	}
+	for _, p := range out.List {
+		resp.List = append(resp.List, toProjectInfo(p))
+	}
+	return resp, nil
+}
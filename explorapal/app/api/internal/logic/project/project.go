@@ -0,0 +1,80 @@
+package project
+
+import (
+	"errors"
+	"net/http"
+
+	"explorapal/app/api/internal/types"
+	"explorapal/app/project-management/rpc/projectmanagementservice"
+)
+
+// checkStatus 项目管理服务返回的业务错误，提示直接展示给用户
+func checkStatus(status int32, msg string) error {
+	if status != http.StatusOK {
+		return errors.New(msg)
+	}
+	return nil
+}
+
+func toProjectInfo(p *projectmanagementservice.ProjectInfo) types.ProjectInfo {
+	return types.ProjectInfo{
+		ProjectId:    p.ProjectId,
+		ProjectCode:  p.ProjectCode,
+		Title:        p.Title,
+		Description:  p.Description,
+		Category:     p.Category,
+		Status:       p.Status,
+		Progress:     p.Progress,
+		CreateTime:   p.CreateTime,
+		UpdateTime:   p.UpdateTime,
+		LastActivity: p.LastActivity,
+		Tags:         p.Tags,
+	}
+}
+
+func toProjectDetail(p *projectmanagementservice.ProjectDetail) types.ProjectDetail {
+	detail := types.ProjectDetail{
+		ProjectId:    p.ProjectId,
+		ProjectCode:  p.ProjectCode,
+		Title:        p.Title,
+		Description:  p.Description,
+		Category:     p.Category,
+		Status:       p.Status,
+		Progress:     p.Progress,
+		CreateTime:   p.CreateTime,
+		UpdateTime:   p.UpdateTime,
+		LastActivity: p.LastActivity,
+		Tags:         p.Tags,
+		Observations: make([]types.ObservationInfo, 0, len(p.Observations)),
+		Questions:    make([]types.QuestionInfo, 0, len(p.Questions)),
+		Expressions:  make([]types.ExpressionInfo, 0, len(p.Expressions)),
+	}
+	for _, o := range p.Observations {
+		detail.Observations = append(detail.Observations, types.ObservationInfo{
+			ObservationId: o.ObservationId,
+			ImageUrl:      o.ImageUrl,
+			Recognition:   o.Recognition,
+			Status:        o.Status,
+			CreateTime:    o.CreateTime,
+		})
+	}
+	for _, q := range p.Questions {
+		detail.Questions = append(detail.Questions, types.QuestionInfo{
+			QuestionId:   q.QuestionId,
+			Question:     q.Question,
+			Answer:       q.Answer,
+			UserResponse: q.UserResponse,
+			CreateTime:   q.CreateTime,
+		})
+	}
+	for _, e := range p.Expressions {
+		detail.Expressions = append(detail.Expressions, types.ExpressionInfo{
+			ExpressionId: e.ExpressionId,
+			Content:      e.Content,
+			Type:         e.Type,
+			PolishedNote: e.PolishedNote,
+			CreateTime:   e.CreateTime,
+		})
+	}
+	return detail
+}
@@ -0,0 +1,46 @@
+package project
+
+import (
+	"context"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/project-management/rpc/projectmanagementservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateProjectStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 更新项目状态
+func NewUpdateProjectStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateProjectStatusLogic {
+	return &UpdateProjectStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateProjectStatusLogic) UpdateProjectStatus(req *types.UpdateProjectStatusReq) (resp *types.CommonStatusResp, err error) {
+	out, err := l.svcCtx.ProjectManagementRpc.UpdateProjectStatus(l.ctx, &projectmanagementservice.UpdateProjectStatusReq{
+		ProjectId: req.ProjectId,
+		Status:    req.Status,
+		Action:    req.Action,
+	})
+	if err != nil {
+		l.Logger.Errorf("更新项目状态失败: %v", err)
+		return nil, err
+	}
+	if err := checkStatus(out.Status, out.Msg); err != nil {
+		return nil, err
+	}
+
+	return &types.CommonStatusResp{
+		Code:    out.Status,
+		Message: out.Msg,
+	}, nil
+}
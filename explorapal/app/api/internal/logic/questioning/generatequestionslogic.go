@@ -0,0 +1,197 @@
+package questioning
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/embedding"
+	"explorapal/pkg/idgen"
+	"explorapal/pkg/profile"
+	"explorapal/pkg/progress"
+	"explorapal/third/openai"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 生成的问题全部未通过安全检查时给孩子的提示
+const noQuestionsMessage = "这次没有想出合适的问题，我们再试一次吧！"
+
+type GenerateQuestionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 生成引导问题
+func NewGenerateQuestionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GenerateQuestionsLogic {
+	return &GenerateQuestionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GenerateQuestionsLogic) GenerateQuestions(req *types.GenerateQuestionsReq) (resp *types.GenerateQuestionsResp, err error) {
+	userID := auth.UserID(l.ctx)
+	project, err := l.svcCtx.ProjectModel.FindOneOwned(l.ctx, req.ProjectId, userID)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, fmt.Errorf("项目不存在")
+		}
+		return nil, fmt.Errorf("查询项目失败: %w", err)
+	}
+
+	var observation *hps.Observations
+	if req.ObservationId > 0 {
+		observation, err = l.svcCtx.ObservationModel.FindOneByObservationId(l.ctx, req.ObservationId)
+		if err != nil {
+			if err == hps.ErrNotFound {
+				return nil, fmt.Errorf("观察记录不存在")
+			}
+			return nil, fmt.Errorf("查询观察记录失败: %w", err)
+		}
+		if observation.ProjectId != req.ProjectId || observation.UserId != userID {
+			return nil, fmt.Errorf("观察记录不属于该项目")
+		}
+	}
+
+	// 孩子的年龄、昵称和偏好语言以服务端资料为准
+	child, err := profile.Load(l.ctx, l.svcCtx.UserModel, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	generated, err := l.svcCtx.AIClient.GenerateQuestions(l.ctx, buildContextInfo(project, observation, child), project.Category)
+	if err != nil {
+		l.Logger.Errorf("生成引导问题失败: %v", err)
+		return nil, err
+	}
+
+	// 模型输出入库前先做安全检查，过滤的内容直接替换
+	generated, err = l.checkOutput(generated)
+	if err != nil {
+		return nil, err
+	}
+
+	questions := make([]*hps.Questions, 0, len(generated))
+	for _, g := range generated {
+		question := &hps.Questions{
+			QuestionId:       idgen.Next(),
+			ProjectId:        req.ProjectId,
+			UserId:           userID,
+			ObservationId:    sql.NullInt64{Int64: req.ObservationId, Valid: req.ObservationId > 0},
+			Content:          g.Content,
+			Type:             g.Type,
+			Difficulty:       g.Difficulty,
+			Purpose:          sql.NullString{String: g.Purpose, Valid: g.Purpose != ""},
+			ExpectedThinking: sql.NullString{String: g.ExpectedThinking, Valid: g.ExpectedThinking != ""},
+		}
+		if err := question.SetHints(g.Hints); err != nil {
+			return nil, fmt.Errorf("序列化问题提示失败: %w", err)
+		}
+		questions = append(questions, question)
+	}
+
+	// 问题、问题向量、生成活动、项目进度和最后活动时间在同一个事务中写入；问题得到回答后才计入提问阶段
+	err = l.svcCtx.UnitOfWork.Do(l.ctx, func(ctx context.Context, tx *hps.Models) error {
+		for _, question := range questions {
+			if _, err := tx.Questions.Insert(ctx, question); err != nil {
+				return fmt.Errorf("保存问题失败: %w", err)
+			}
+			if err := l.svcCtx.Embeddings.AddTx(ctx, tx, embedding.QuestionDocument(question)); err != nil {
+				return fmt.Errorf("保存问题向量失败: %w", err)
+			}
+		}
+		if _, err := tx.ProjectActivities.Insert(ctx, l.buildActivity(req, questions)); err != nil {
+			return fmt.Errorf("记录项目活动失败: %w", err)
+		}
+		return l.svcCtx.Progress.RecordTx(ctx, tx, req.ProjectId, progress.StageQuestion)
+	})
+	if err != nil {
+		l.Logger.Errorf("保存引导问题失败: %v", err)
+		return nil, err
+	}
+
+	resp = &types.GenerateQuestionsResp{Questions: make([]types.Question, 0, len(questions))}
+	for i, question := range questions {
+		hints := generated[i].Hints
+		if hints == nil {
+			hints = []string{}
+		}
+		resp.Questions = append(resp.Questions, types.Question{
+			QuestionId:       question.QuestionId,
+			Content:          question.Content,
+			Type:             question.Type,
+			Difficulty:       question.Difficulty,
+			Purpose:          question.Purpose.String,
+			Hints:            hints,
+			ExpectedThinking: question.ExpectedThinking.String,
+		})
+	}
+	return resp, nil
+}
+
+// checkOutput 逐个检查生成的问题；问题没有人工审核流程，被拦截或需要复核的问题直接丢弃
+func (l *GenerateQuestionsLogic) checkOutput(generated []openai.Question) ([]openai.Question, error) {
+	safe := make([]openai.Question, 0, len(generated))
+	for i := range generated {
+		blocked, flagged, err := l.svcCtx.SafetyGuard.CheckValueForReview(l.ctx, &generated[i], security.OutputPolicy, security.DirectionOutput)
+		if err != nil {
+			l.Logger.Errorf("检查引导问题失败: %v", err)
+			return nil, err
+		}
+		if blocked != nil || len(flagged) > 0 || generated[i].Content == "" {
+			continue
+		}
+		safe = append(safe, generated[i])
+	}
+	if len(safe) == 0 {
+		return nil, errors.New(noQuestionsMessage)
+	}
+	return safe, nil
+}
+
+// buildActivity 生成引导问题活动
+func (l *GenerateQuestionsLogic) buildActivity(req *types.GenerateQuestionsReq, questions []*hps.Questions) *hps.ProjectActivities {
+	questionIDs := make([]int64, 0, len(questions))
+	for _, question := range questions {
+		questionIDs = append(questionIDs, question.QuestionId)
+	}
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"observation_id": req.ObservationId,
+		"question_ids":   questionIDs,
+	})
+
+	return &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   req.ProjectId,
+		UserId:      auth.UserID(l.ctx),
+		Type:        hps.ActivityTypeGenerateQuestions,
+		Description: fmt.Sprintf("想出了%d个探索问题", len(questions)),
+		Metadata:    sql.NullString{String: string(metadata), Valid: true},
+	}
+}
+
+// buildContextInfo 组装生成问题所需的上下文信息；隔离或等待审核的识别结果不发给模型
+func buildContextInfo(project *hps.Projects, observation *hps.Observations, child *profile.Profile) string {
+	parts := []string{child.PromptContext(), "项目标题：" + project.Title}
+	if project.Description.Valid && project.Description.String != "" {
+		parts = append(parts, "项目描述："+project.Description.String)
+	}
+	if observation != nil && !observation.Quarantined() && !observation.RecognitionHidden() && observation.ObjectName.Valid {
+		parts = append(parts, "观察对象："+observation.ObjectName.String)
+		if observation.Description.Valid {
+			parts = append(parts, "观察描述："+observation.Description.String)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
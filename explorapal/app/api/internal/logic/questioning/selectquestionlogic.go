@@ -0,0 +1,226 @@
+package questioning
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"explorapal/app/api/internal/svc"
+	"explorapal/app/api/internal/types"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/idgen"
+	"explorapal/pkg/profile"
+	"explorapal/pkg/progress"
+	"explorapal/third/openai"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 回答需要人工复核时给孩子的提示；问题没有人工审核流程，这样的回答不保存
+const answerFlaggedMessage = "这个问题暂时回答不了，我们换一个问题继续探索吧！"
+
+type SelectQuestionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 选择问题获取AI回答
+func NewSelectQuestionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelectQuestionLogic {
+	return &SelectQuestionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SelectQuestionLogic) SelectQuestion(req *types.SelectQuestionReq) (resp *types.SelectQuestionResp, err error) {
+	userID := auth.UserID(l.ctx)
+	project, err := l.svcCtx.ProjectModel.FindOneOwned(l.ctx, req.ProjectId, userID)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, fmt.Errorf("项目不存在")
+		}
+		return nil, fmt.Errorf("查询项目失败: %w", err)
+	}
+
+	question, err := l.svcCtx.QuestionModel.FindOneByQuestionId(l.ctx, req.QuestionId)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, fmt.Errorf("问题不存在")
+		}
+		return nil, fmt.Errorf("查询问题失败: %w", err)
+	}
+	if question.ProjectId != req.ProjectId || question.UserId != userID {
+		return nil, fmt.Errorf("问题不属于该项目")
+	}
+
+	// 已经回答过的问题直接返回保存的回答
+	if question.AiAnswer.Valid {
+		return toSelectQuestionResp(question)
+	}
+
+	child, err := profile.Load(l.ctx, l.svcCtx.UserModel, userID)
+	if err != nil {
+		return nil, err
+	}
+	contextInfo := strings.Join([]string{child.PromptContext(), "项目标题：" + project.Title, "项目类别：" + project.Category}, "\n")
+
+	answer, err := l.svcCtx.AIClient.AnswerQuestion(l.ctx, question.Content, contextInfo)
+	if err != nil {
+		l.Logger.Errorf("回答问题失败: %v", err)
+		return nil, err
+	}
+
+	// 模型输出入库前先做安全检查，过滤的内容直接替换
+	if err := l.checkOutput(answer); err != nil {
+		return nil, err
+	}
+	if err := fillAnswer(question, answer); err != nil {
+		return nil, err
+	}
+
+	// 回答、选择问题活动、项目进度和最后活动时间在同一个事务中写入
+	err = l.svcCtx.UnitOfWork.Do(l.ctx, func(ctx context.Context, tx *hps.Models) error {
+		if err := tx.Questions.Update(ctx, question); err != nil {
+			return fmt.Errorf("保存回答失败: %w", err)
+		}
+		if _, err := tx.ProjectActivities.Insert(ctx, l.buildActivity(question)); err != nil {
+			return fmt.Errorf("记录项目活动失败: %w", err)
+		}
+		return l.svcCtx.Progress.RecordTx(ctx, tx, question.ProjectId, progress.StageQuestion)
+	})
+	if err != nil {
+		l.Logger.Errorf("保存问题回答失败: %v", err)
+		return nil, err
+	}
+
+	return toSelectQuestionResp(question)
+}
+
+// checkOutput 检查回答，被拦截或需要人工复核时返回给孩子的提示
+func (l *SelectQuestionLogic) checkOutput(answer *openai.QuestionAnswer) error {
+	blocked, flagged, err := l.svcCtx.SafetyGuard.CheckValueForReview(l.ctx, answer, security.OutputPolicy, security.DirectionOutput)
+	if err != nil {
+		l.Logger.Errorf("检查问题回答失败: %v", err)
+		return err
+	}
+	if blocked != nil {
+		return blocked.Err()
+	}
+	if len(flagged) > 0 {
+		return errors.New(answerFlaggedMessage)
+	}
+	return nil
+}
+
+// buildActivity 选择问题活动
+func (l *SelectQuestionLogic) buildActivity(question *hps.Questions) *hps.ProjectActivities {
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"question_id": question.QuestionId,
+		"type":        question.Type,
+	})
+
+	return &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   question.ProjectId,
+		UserId:      question.UserId,
+		Type:        hps.ActivityTypeSelectQuestion,
+		Description: fmt.Sprintf("探索了问题：%s", question.Content),
+		Metadata:    sql.NullString{String: string(metadata), Valid: true},
+	}
+}
+
+// fillAnswer 把回答写入问题记录的各个字段
+func fillAnswer(question *hps.Questions, answer *openai.QuestionAnswer) error {
+	activities := make([]hps.Activity, 0, len(answer.Activities))
+	for _, a := range answer.Activities {
+		activities = append(activities, hps.Activity(a))
+	}
+
+	question.AiAnswer = sql.NullString{String: answer.Answer, Valid: true}
+	for _, err := range []error{
+		question.SetKeyPoints(answer.KeyPoints),
+		question.SetExamples(answer.Examples),
+		question.SetAnalogies(answer.Analogies),
+		question.SetVisualAids(answer.VisualAids),
+		question.SetFollowUpQuestions(answer.FollowUpQuestions),
+		question.SetThinkingPrompts(answer.ThinkingPrompts),
+		question.SetActivities(activities),
+	} {
+		if err != nil {
+			return fmt.Errorf("序列化问题回答失败: %w", err)
+		}
+	}
+	return nil
+}
+
+func toSelectQuestionResp(question *hps.Questions) (*types.SelectQuestionResp, error) {
+	keyPoints, err := question.GetKeyPoints()
+	if err != nil {
+		return nil, fmt.Errorf("解析关键要点失败: %w", err)
+	}
+	examples, err := question.GetExamples()
+	if err != nil {
+		return nil, fmt.Errorf("解析举例说明失败: %w", err)
+	}
+	analogies, err := question.GetAnalogies()
+	if err != nil {
+		return nil, fmt.Errorf("解析类比失败: %w", err)
+	}
+	visualAids, err := question.GetVisualAids()
+	if err != nil {
+		return nil, fmt.Errorf("解析视觉辅助建议失败: %w", err)
+	}
+	followUps, err := question.GetFollowUpQuestions()
+	if err != nil {
+		return nil, fmt.Errorf("解析后续问题失败: %w", err)
+	}
+	prompts, err := question.GetThinkingPrompts()
+	if err != nil {
+		return nil, fmt.Errorf("解析思考提示失败: %w", err)
+	}
+	activities, err := question.GetActivities()
+	if err != nil {
+		return nil, fmt.Errorf("解析建议活动失败: %w", err)
+	}
+
+	resp := &types.SelectQuestionResp{
+		QuestionId: question.QuestionId,
+		Question:   question.Content,
+		AIResponse: types.AIResponse{
+			Answer:     question.AiAnswer.String,
+			KeyPoints:  orEmpty(keyPoints),
+			Examples:   orEmpty(examples),
+			Analogies:  orEmpty(analogies),
+			VisualAids: orEmpty(visualAids),
+		},
+		FollowUpQuestions: orEmpty(followUps),
+		ThinkingPrompts:   orEmpty(prompts),
+		Activities:        make([]types.Activity, 0, len(activities)),
+	}
+	for _, a := range activities {
+		resp.Activities = append(resp.Activities, types.Activity{
+			Type:        a.Type,
+			Title:       a.Title,
+			Description: a.Description,
+			Materials:   orEmpty(a.Materials),
+			Steps:       orEmpty(a.Steps),
+			Duration:    a.Duration,
+			Difficulty:  a.Difficulty,
+		})
+	}
+	return resp, nil
+}
+
+func orEmpty(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"explorapal/pkg/auth"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+type ContentSafetyMiddleware struct {
+	guard *security.Guard
+}
+
+func NewContentSafetyMiddleware(guard *security.Guard) *ContentSafetyMiddleware {
+	return &ContentSafetyMiddleware{
+		guard: guard,
+	}
+}
+
+// Handle 检查请求中孩子输入的文字、图片和音频地址，以及返回给孩子的全部文字内容
+func (m *ContentSafetyMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		ctx := security.WithDecisionCache(security.WithCallInfo(r.Context(), security.CallInfo{
+			UserID:    auth.UserID(r.Context()),
+			SessionID: sessionID(r),
+			Source:    r.Method + " " + r.URL.Path,
+		}))
+
+		if len(bytes.TrimSpace(body)) > 0 && isJSON(r.Header.Get("Content-Type")) {
+			checked, blocked, err := m.guard.CheckJSON(ctx, body, security.InputPolicy, security.DirectionInput)
+			if err != nil {
+				httpx.ErrorCtx(ctx, w, err)
+				return
+			}
+			if blocked != nil {
+				httpx.ErrorCtx(ctx, w, blocked.Err())
+				return
+			}
+			body = checked
+		}
+		r = r.WithContext(ctx)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+
+		recorder := newResponseRecorder()
+		next(recorder, r)
+
+		resp := recorder.body.Bytes()
+		if recorder.status == http.StatusOK && isJSON(recorder.Header().Get("Content-Type")) && len(resp) > 0 {
+			checked, blocked, err := m.guard.CheckJSON(ctx, resp, security.OutputPolicy, security.DirectionOutput)
+			if err != nil {
+				logx.WithContext(ctx).Errorf("检查返回内容失败: %v", err)
+				httpx.ErrorCtx(ctx, w, err)
+				return
+			}
+			if blocked != nil {
+				httpx.ErrorCtx(ctx, w, blocked.Err())
+				return
+			}
+			resp = checked
+		}
+
+		for key, values := range recorder.Header() {
+			w.Header()[key] = values
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(resp)))
+		w.WriteHeader(recorder.status)
+		if _, err := w.Write(resp); err != nil {
+			logx.WithContext(ctx).Errorf("写入响应失败: %v", err)
+		}
+	}
+}
+
+// responseRecorder 缓存下游的响应，检查通过后再写回客户端
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{
+		header: http.Header{},
+		status: http.StatusOK,
+	}
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func isJSON(contentType string) bool {
+	return strings.Contains(strings.ToLower(contentType), "application/json")
+}
+
+// sessionID 会话ID优先取客户端传入的会话头，其次取请求ID
+func sessionID(r *http.Request) string {
+	if id := r.Header.Get(security.SessionHeader); id != "" {
+		return id
+	}
+	return r.Header.Get("X-Request-Id")
+}
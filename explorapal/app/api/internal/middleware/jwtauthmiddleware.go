@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+type JwtAuthMiddleware struct {
+	issuer *auth.Issuer
+}
+
+func NewJwtAuthMiddleware(issuer *auth.Issuer) *JwtAuthMiddleware {
+	return &JwtAuthMiddleware{
+		issuer: issuer,
+	}
+}
+
+// Handle 校验Authorization头中的访问令牌，把用户身份放入请求上下文
+// 业务逻辑只从上下文获取用户ID，不使用请求体中的用户ID
+func (m *JwtAuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := auth.BearerToken(r.Header.Get("Authorization"))
+		if token == "" {
+			unauthorized(w, r)
+			return
+		}
+		claims, err := m.issuer.Parse(token, auth.TokenTypeAccess)
+		if err != nil {
+			unauthorized(w, r)
+			return
+		}
+
+		ctx := auth.WithIdentity(r.Context(), &auth.Identity{
+			UserID: claims.UserID,
+			Role:   claims.Role,
+			Token:  token,
+		})
+		next(w, r.WithContext(ctx))
+	}
+}
+
+func unauthorized(w http.ResponseWriter, r *http.Request) {
+	httpx.WriteJsonCtx(r.Context(), w, http.StatusUnauthorized, map[string]string{
+		"msg": auth.ErrTokenInvalid.Error(),
+	})
+}
@@ -0,0 +1,202 @@
+package svc
+
+import (
+	"explorapal/app/api/internal/config"
+	"explorapal/app/api/internal/middleware"
+	"explorapal/app/model/hps"
+	"explorapal/app/project-management/rpc/projectmanagementservice"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/conceptgraph"
+	"explorapal/pkg/consent"
+	"explorapal/pkg/dashboard"
+	"explorapal/pkg/embedding"
+	"explorapal/pkg/moderation"
+	"explorapal/pkg/progress"
+	"explorapal/pkg/safetyaudit"
+	"explorapal/pkg/storage"
+	"explorapal/third/openai"
+	"explorapal/third/security"
+	"explorapal/third/speech"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type ServiceContext struct {
+	Config                  config.Config
+	JwtAuthMiddleware       rest.Middleware
+	ContentSafetyMiddleware rest.Middleware
+
+	// 登录令牌签发与校验
+	Auth *auth.Issuer
+
+	// 家长同意校验
+	Consent *consent.Checker
+
+	// 数据库模型
+	UserModel            hps.UsersModel
+	ProjectModel         hps.ProjectsModel
+	ProjectActivityModel hps.ProjectActivitiesModel
+	ObservationModel     hps.ObservationsModel
+	QuestionModel        hps.QuestionsModel
+	ExpressionModel      hps.ExpressionsModel
+	AchievementModel     hps.AchievementsModel
+	SafetyAuditLogModel  hps.SafetyAuditLogsModel
+	ReviewItemModel      hps.ReviewItemsModel
+	GuardianLinkModel    hps.GuardianLinksModel
+	ClassMemberModel     hps.ClassMembersModel
+	CommentModel         hps.CommentsModel
+	NotificationModel    hps.NotificationsModel
+
+	// 多表写入的事务
+	UnitOfWork *hps.UnitOfWork
+
+	// 项目管理服务
+	ProjectManagementRpc projectmanagementservice.ProjectManagementService
+
+	// 第三方服务
+	AIClient       *openai.Client
+	SpeechClient   *speech.Client
+	SecurityClient *security.SecurityClient
+
+	// 文件存储
+	Storage *storage.Storage
+
+	// 内容安全守卫
+	SafetyGuard *security.Guard
+
+	// 人工审核队列
+	ReviewQueue *moderation.Queue
+
+	// 家长端孩子探索汇总
+	Dashboard *dashboard.Builder
+
+	// 项目进度计算
+	Progress *progress.Engine
+
+	// 内容向量索引
+	Embeddings *embedding.Index
+
+	// 跨项目概念图
+	Concepts *conceptgraph.Graph
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	conn := sqlx.NewMysql(c.DBConfig.DataSource)
+
+	safetyAuditLogModel := hps.NewSafetyAuditLogsModel(conn, c.Cache)
+	securityClient := security.NewSecurityClient(&security.Config{
+		BaseURL:         c.SecurityConfig.BaseURL,
+		APIKey:          c.SecurityConfig.APIKey,
+		Timeout:         c.SecurityConfig.Timeout,
+		BatchWorkers:    c.SecurityConfig.BatchWorkers,
+		BatchSize:       c.SecurityConfig.BatchSize,
+		LocalRules:      c.SecurityConfig.LocalRules,
+		FailurePolicies: c.SecurityConfig.FailurePolicies,
+	})
+	reviewItemModel := hps.NewReviewItemsModel(conn, c.Cache)
+	userModel := hps.NewUsersModel(conn, c.Cache)
+	projectModel := hps.NewProjectsModel(conn, c.Cache)
+	projectActivityModel := hps.NewProjectActivitiesModel(conn, c.Cache)
+	observationModel := hps.NewObservationsModel(conn, c.Cache)
+	questionModel := hps.NewQuestionsModel(conn, c.Cache)
+	expressionModel := hps.NewExpressionsModel(conn, c.Cache)
+	achievementModel := hps.NewAchievementsModel(conn, c.Cache)
+	guardianLinkModel := hps.NewGuardianLinksModel(conn, c.Cache)
+	aiClient := openai.NewClient(&openai.Config{
+		APIKey:      c.DashScope.APIKey,
+		BaseURL:     c.DashScope.BaseURL,
+		Timeout:     c.DashScope.Timeout,
+		MaxTokens:   c.DashScope.MaxTokens,
+		Temperature: c.DashScope.Temperature,
+	})
+	safetyGuard := security.NewGuard(securityClient, safetyaudit.NewAuditor(safetyAuditLogModel))
+	issuer := auth.NewIssuer(&auth.Config{
+		AccessSecret:  c.JwtAuth.AccessSecret,
+		AccessExpire:  c.JwtAuth.AccessExpire,
+		RefreshExpire: c.JwtAuth.RefreshExpire,
+	})
+
+	return &ServiceContext{
+		Config:                  c,
+		JwtAuthMiddleware:       middleware.NewJwtAuthMiddleware(issuer).Handle,
+		ContentSafetyMiddleware: middleware.NewContentSafetyMiddleware(safetyGuard).Handle,
+
+		Auth: issuer,
+
+		Consent: consent.NewChecker(hps.NewParentalConsentsModel(conn, c.Cache), c.Consent.TermsVersion),
+
+		UserModel:            userModel,
+		ProjectModel:         projectModel,
+		ProjectActivityModel: projectActivityModel,
+		ObservationModel:     observationModel,
+		QuestionModel:        questionModel,
+		ExpressionModel:      expressionModel,
+		AchievementModel:     achievementModel,
+		SafetyAuditLogModel:  safetyAuditLogModel,
+		ReviewItemModel:      reviewItemModel,
+		GuardianLinkModel:    guardianLinkModel,
+		ClassMemberModel:     hps.NewClassMembersModel(conn, c.Cache),
+		CommentModel:         hps.NewCommentsModel(conn, c.Cache),
+		NotificationModel:    hps.NewNotificationsModel(conn, c.Cache),
+
+		UnitOfWork: hps.NewUnitOfWork(conn, c.Cache),
+
+		ProjectManagementRpc: projectmanagementservice.NewProjectManagementService(zrpc.MustNewClient(c.ProjectManagementRpc,
+			zrpc.WithUnaryClientInterceptor(auth.UnaryClientInterceptor()))),
+
+		AIClient: aiClient,
+		SpeechClient: speech.NewClient(&speech.Config{
+			AccessKeyId:     c.SpeechService.AccessKeyId,
+			AccessKeySecret: c.SpeechService.AccessKeySecret,
+			AppKey:          c.SpeechService.AppKey,
+			Region:          c.SpeechService.Region,
+		}),
+		SecurityClient: securityClient,
+
+		Storage: storage.NewStorage(&storage.Config{
+			Root:             c.Storage.Root,
+			BaseURL:          c.Storage.BaseURL,
+			QuarantinePrefix: c.Storage.QuarantinePrefix,
+		}),
+
+		SafetyGuard: safetyGuard,
+
+		ReviewQueue: moderation.NewQueue(reviewItemModel),
+
+		Dashboard: dashboard.NewBuilder(&dashboard.Models{
+			Users:             userModel,
+			GuardianLinks:     guardianLinkModel,
+			Projects:          projectModel,
+			Observations:      observationModel,
+			Questions:         questionModel,
+			Expressions:       expressionModel,
+			Achievements:      achievementModel,
+			ProjectActivities: projectActivityModel,
+		}, aiClient),
+
+		Progress: progress.NewEngine(&progress.Models{
+			Projects:          projectModel,
+			Milestones:        hps.NewProjectMilestonesModel(conn, c.Cache),
+			Observations:      observationModel,
+			Questions:         questionModel,
+			Expressions:       expressionModel,
+			Achievements:      achievementModel,
+			ProjectActivities: projectActivityModel,
+		}),
+
+		Embeddings: embedding.NewIndex(&embedding.Models{
+			Embeddings:   hps.NewEmbeddingsModel(conn, c.Cache),
+			Projects:     projectModel,
+			Observations: observationModel,
+		}, embedding.NewProvider(c.Embedding, aiClient)),
+
+		Concepts: conceptgraph.NewGraph(&conceptgraph.Models{
+			Concepts: hps.NewConceptsModel(conn, c.Cache),
+			Edges:    hps.NewConceptEdgesModel(conn, c.Cache),
+			Mentions: hps.NewConceptMentionsModel(conn, c.Cache),
+			Projects: projectModel,
+		}),
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"explorapal/app/classroom/rpc/classroom"
+	"explorapal/app/classroom/rpc/internal/config"
+	"explorapal/app/classroom/rpc/internal/server"
+	"explorapal/app/classroom/rpc/internal/svc"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/idgen"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+var configFile = flag.String("f", "etc/classroom.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	idgen.MustSetup(c.IDGen)
+	ctx := svc.NewServiceContext(c)
+
+	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
+		classroom.RegisterClassroomServiceServer(grpcServer, server.NewClassroomServiceServer(ctx))
+
+		if c.Mode == service.DevMode || c.Mode == service.TestMode {
+			reflection.Register(grpcServer)
+		}
+	})
+	defer s.Stop()
+
+	// 身份认证：班级和作业只有创建它们的老师可以管理，具体归属在业务逻辑中校验
+	s.AddUnaryInterceptors(auth.UnaryServerInterceptor(ctx.Auth))
+
+	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
+	s.Start()
+}
@@ -0,0 +1,387 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: app/classroom/rpc/classroom.proto
+
+package classroom
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ClassroomService_CreateClass_FullMethodName      = "/classroom.ClassroomService/CreateClass"
+	ClassroomService_ListClasses_FullMethodName      = "/classroom.ClassroomService/ListClasses"
+	ClassroomService_AddStudents_FullMethodName      = "/classroom.ClassroomService/AddStudents"
+	ClassroomService_RemoveStudent_FullMethodName    = "/classroom.ClassroomService/RemoveStudent"
+	ClassroomService_ListStudents_FullMethodName     = "/classroom.ClassroomService/ListStudents"
+	ClassroomService_CreateAssignment_FullMethodName = "/classroom.ClassroomService/CreateAssignment"
+	ClassroomService_ListAssignments_FullMethodName  = "/classroom.ClassroomService/ListAssignments"
+	ClassroomService_GetClassProgress_FullMethodName = "/classroom.ClassroomService/GetClassProgress"
+)
+
+// ClassroomServiceClient is the client API for ClassroomService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClassroomServiceClient interface {
+	CreateClass(ctx context.Context, in *CreateClassReq, opts ...grpc.CallOption) (*CreateClassResp, error)
+	ListClasses(ctx context.Context, in *ListClassesReq, opts ...grpc.CallOption) (*ListClassesResp, error)
+	AddStudents(ctx context.Context, in *AddStudentsReq, opts ...grpc.CallOption) (*AddStudentsResp, error)
+	RemoveStudent(ctx context.Context, in *RemoveStudentReq, opts ...grpc.CallOption) (*RemoveStudentResp, error)
+	ListStudents(ctx context.Context, in *ListStudentsReq, opts ...grpc.CallOption) (*ListStudentsResp, error)
+	CreateAssignment(ctx context.Context, in *CreateAssignmentReq, opts ...grpc.CallOption) (*CreateAssignmentResp, error)
+	ListAssignments(ctx context.Context, in *ListAssignmentsReq, opts ...grpc.CallOption) (*ListAssignmentsResp, error)
+	GetClassProgress(ctx context.Context, in *GetClassProgressReq, opts ...grpc.CallOption) (*GetClassProgressResp, error)
+}
+
+type classroomServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClassroomServiceClient(cc grpc.ClientConnInterface) ClassroomServiceClient {
+	return &classroomServiceClient{cc}
+}
+
+func (c *classroomServiceClient) CreateClass(ctx context.Context, in *CreateClassReq, opts ...grpc.CallOption) (*CreateClassResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateClassResp)
+	err := c.cc.Invoke(ctx, ClassroomService_CreateClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classroomServiceClient) ListClasses(ctx context.Context, in *ListClassesReq, opts ...grpc.CallOption) (*ListClassesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClassesResp)
+	err := c.cc.Invoke(ctx, ClassroomService_ListClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classroomServiceClient) AddStudents(ctx context.Context, in *AddStudentsReq, opts ...grpc.CallOption) (*AddStudentsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddStudentsResp)
+	err := c.cc.Invoke(ctx, ClassroomService_AddStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classroomServiceClient) RemoveStudent(ctx context.Context, in *RemoveStudentReq, opts ...grpc.CallOption) (*RemoveStudentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveStudentResp)
+	err := c.cc.Invoke(ctx, ClassroomService_RemoveStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classroomServiceClient) ListStudents(ctx context.Context, in *ListStudentsReq, opts ...grpc.CallOption) (*ListStudentsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudentsResp)
+	err := c.cc.Invoke(ctx, ClassroomService_ListStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classroomServiceClient) CreateAssignment(ctx context.Context, in *CreateAssignmentReq, opts ...grpc.CallOption) (*CreateAssignmentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAssignmentResp)
+	err := c.cc.Invoke(ctx, ClassroomService_CreateAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classroomServiceClient) ListAssignments(ctx context.Context, in *ListAssignmentsReq, opts ...grpc.CallOption) (*ListAssignmentsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentsResp)
+	err := c.cc.Invoke(ctx, ClassroomService_ListAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classroomServiceClient) GetClassProgress(ctx context.Context, in *GetClassProgressReq, opts ...grpc.CallOption) (*GetClassProgressResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClassProgressResp)
+	err := c.cc.Invoke(ctx, ClassroomService_GetClassProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClassroomServiceServer is the server API for ClassroomService service.
+// All implementations must embed UnimplementedClassroomServiceServer
+// for forward compatibility.
+type ClassroomServiceServer interface {
+	CreateClass(context.Context, *CreateClassReq) (*CreateClassResp, error)
+	ListClasses(context.Context, *ListClassesReq) (*ListClassesResp, error)
+	AddStudents(context.Context, *AddStudentsReq) (*AddStudentsResp, error)
+	RemoveStudent(context.Context, *RemoveStudentReq) (*RemoveStudentResp, error)
+	ListStudents(context.Context, *ListStudentsReq) (*ListStudentsResp, error)
+	CreateAssignment(context.Context, *CreateAssignmentReq) (*CreateAssignmentResp, error)
+	ListAssignments(context.Context, *ListAssignmentsReq) (*ListAssignmentsResp, error)
+	GetClassProgress(context.Context, *GetClassProgressReq) (*GetClassProgressResp, error)
+	mustEmbedUnimplementedClassroomServiceServer()
+}
+
+// UnimplementedClassroomServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClassroomServiceServer struct{}
+
+func (UnimplementedClassroomServiceServer) CreateClass(context.Context, *CreateClassReq) (*CreateClassResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClass not implemented")
+}
+func (UnimplementedClassroomServiceServer) ListClasses(context.Context, *ListClassesReq) (*ListClassesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClasses not implemented")
+}
+func (UnimplementedClassroomServiceServer) AddStudents(context.Context, *AddStudentsReq) (*AddStudentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStudents not implemented")
+}
+func (UnimplementedClassroomServiceServer) RemoveStudent(context.Context, *RemoveStudentReq) (*RemoveStudentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStudent not implemented")
+}
+func (UnimplementedClassroomServiceServer) ListStudents(context.Context, *ListStudentsReq) (*ListStudentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudents not implemented")
+}
+func (UnimplementedClassroomServiceServer) CreateAssignment(context.Context, *CreateAssignmentReq) (*CreateAssignmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignment not implemented")
+}
+func (UnimplementedClassroomServiceServer) ListAssignments(context.Context, *ListAssignmentsReq) (*ListAssignmentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignments not implemented")
+}
+func (UnimplementedClassroomServiceServer) GetClassProgress(context.Context, *GetClassProgressReq) (*GetClassProgressResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassProgress not implemented")
+}
+func (UnimplementedClassroomServiceServer) mustEmbedUnimplementedClassroomServiceServer() {}
+func (UnimplementedClassroomServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeClassroomServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClassroomServiceServer will
+// result in compilation errors.
+type UnsafeClassroomServiceServer interface {
+	mustEmbedUnimplementedClassroomServiceServer()
+}
+
+func RegisterClassroomServiceServer(s grpc.ServiceRegistrar, srv ClassroomServiceServer) {
+	// If the following call pancis, it indicates UnimplementedClassroomServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClassroomService_ServiceDesc, srv)
+}
+
+func _ClassroomService_CreateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClassReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassroomServiceServer).CreateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassroomService_CreateClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassroomServiceServer).CreateClass(ctx, req.(*CreateClassReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassroomService_ListClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClassesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassroomServiceServer).ListClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassroomService_ListClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassroomServiceServer).ListClasses(ctx, req.(*ListClassesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassroomService_AddStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStudentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassroomServiceServer).AddStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassroomService_AddStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassroomServiceServer).AddStudents(ctx, req.(*AddStudentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassroomService_RemoveStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStudentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassroomServiceServer).RemoveStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassroomService_RemoveStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassroomServiceServer).RemoveStudent(ctx, req.(*RemoveStudentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassroomService_ListStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassroomServiceServer).ListStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassroomService_ListStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassroomServiceServer).ListStudents(ctx, req.(*ListStudentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassroomService_CreateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssignmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassroomServiceServer).CreateAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassroomService_CreateAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassroomServiceServer).CreateAssignment(ctx, req.(*CreateAssignmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassroomService_ListAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassroomServiceServer).ListAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassroomService_ListAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassroomServiceServer).ListAssignments(ctx, req.(*ListAssignmentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassroomService_GetClassProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassProgressReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassroomServiceServer).GetClassProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassroomService_GetClassProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassroomServiceServer).GetClassProgress(ctx, req.(*GetClassProgressReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClassroomService_ServiceDesc is the grpc.ServiceDesc for ClassroomService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClassroomService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "classroom.ClassroomService",
+	HandlerType: (*ClassroomServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClass",
+			Handler:    _ClassroomService_CreateClass_Handler,
+		},
+		{
+			MethodName: "ListClasses",
+			Handler:    _ClassroomService_ListClasses_Handler,
+		},
+		{
+			MethodName: "AddStudents",
+			Handler:    _ClassroomService_AddStudents_Handler,
+		},
+		{
+			MethodName: "RemoveStudent",
+			Handler:    _ClassroomService_RemoveStudent_Handler,
+		},
+		{
+			MethodName: "ListStudents",
+			Handler:    _ClassroomService_ListStudents_Handler,
+		},
+		{
+			MethodName: "CreateAssignment",
+			Handler:    _ClassroomService_CreateAssignment_Handler,
+		},
+		{
+			MethodName: "ListAssignments",
+			Handler:    _ClassroomService_ListAssignments_Handler,
+		},
+		{
+			MethodName: "GetClassProgress",
+			Handler:    _ClassroomService_GetClassProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "app/classroom/rpc/classroom.proto",
+}
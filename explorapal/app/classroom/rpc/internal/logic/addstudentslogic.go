@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/classroom/rpc/classroom"
+	"explorapal/app/classroom/rpc/internal/svc"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/guardian"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AddStudentsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAddStudentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddStudentsLogic {
+	return &AddStudentsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 老师把已关联的孩子加入班级，已在班级中的学生会跳过
+func (l *AddStudentsLogic) AddStudents(in *classroom.AddStudentsReq) (*classroom.AddStudentsResp, error) {
+	added, err := l.add(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "添加学生失败")
+		if err != nil {
+			l.Logger.Errorf("添加学生失败: %v", err)
+		}
+		return &classroom.AddStudentsResp{
+			Status: status,
+			Msg:    msg,
+			Added:  added,
+		}, err
+	}
+
+	return &classroom.AddStudentsResp{
+		Status: 200,
+		Msg:    "添加学生成功",
+		Added:  added,
+	}, nil
+}
+
+func (l *AddStudentsLogic) add(in *classroom.AddStudentsReq) (int32, error) {
+	class, err := ownedClass(l.ctx, l.svcCtx, in.ClassId)
+	if err != nil {
+		return 0, err
+	}
+
+	// 先全部校验再添加，避免只加入了一部分学生
+	for _, studentID := range in.StudentIds {
+		relation, err := guardian.Relation(l.ctx, l.svcCtx.GuardianLinkModel, class.TeacherId, studentID)
+		if err == guardian.ErrNotLinked || (err == nil && relation != hps.GuardianRelationTeacher) {
+			return 0, errStudentNotLinked
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var added int32
+	for _, studentID := range in.StudentIds {
+		_, err := l.svcCtx.ClassMemberModel.FindOneByClassIdStudentId(l.ctx, class.ClassId, studentID)
+		if err == nil {
+			continue
+		}
+		if err != hps.ErrNotFound {
+			return added, err
+		}
+		_, err = l.svcCtx.ClassMemberModel.Insert(l.ctx, &hps.ClassMembers{
+			ClassId:   class.ClassId,
+			StudentId: studentID,
+		})
+		if err != nil {
+			return added, err
+		}
+		added++
+	}
+	return added, nil
+}
@@ -0,0 +1,159 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"explorapal/app/classroom/rpc/classroom"
+	"explorapal/app/classroom/rpc/internal/svc"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+)
+
+const (
+	timeLayout = "2006-01-02 15:04:05"
+	dateLayout = "2006-01-02"
+)
+
+// classroomError 班级管理的业务错误，转换为响应中的状态码和提示
+type classroomError struct {
+	status int32
+	msg    string
+}
+
+func (e *classroomError) Error() string {
+	return e.msg
+}
+
+var (
+	errNotTeacher        = &classroomError{status: 403, msg: "只有老师账号可以管理班级"}
+	errClassNotFound     = &classroomError{status: 404, msg: "班级不存在"}
+	errStudentNotLinked  = &classroomError{status: 404, msg: "只能添加已关联的孩子，请先用孩子的账号关联"}
+	errStudentNotInClass = &classroomError{status: 404, msg: "该学生不在班级中"}
+	errNoStudents        = &classroomError{status: 400, msg: "班级里还没有学生"}
+	errInvalidClassName  = &classroomError{status: 400, msg: "班级名称不能为空，且不超过100个字"}
+	errInvalidTitle      = &classroomError{status: 400, msg: "作业标题不能为空，且不超过200个字"}
+	errInvalidCategory   = &classroomError{status: 400, msg: "项目类别不能为空"}
+	errInvalidQuestion   = &classroomError{status: 400, msg: "驱动问题不能为空"}
+	errInvalidRequired   = &classroomError{status: 400, msg: "要求的数量不正确"}
+	errInvalidDueDate    = &classroomError{status: 400, msg: "截止日期格式应为YYYY-MM-DD，且不能早于今天"}
+	errContentBlocked    = &classroomError{status: 400, msg: "作业内容没有通过内容安全检查，请修改后再布置"}
+)
+
+// errorStatus 返回错误对应的状态码和提示；非业务错误返回500
+func errorStatus(err error, fallback string) (int32, string, error) {
+	var ce *classroomError
+	if errors.As(err, &ce) {
+		return ce.status, ce.msg, nil
+	}
+	if errors.Is(err, auth.ErrTokenInvalid) {
+		return 401, err.Error(), nil
+	}
+	return 500, fallback, err
+}
+
+// currentTeacher 查询调用方的老师账号
+func currentTeacher(ctx context.Context, svcCtx *svc.ServiceContext) (*hps.Users, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, auth.ErrTokenInvalid
+	}
+	if identity.Role != auth.RoleTeacher {
+		return nil, errNotTeacher
+	}
+	teacher, err := svcCtx.UserModel.FindOneByUserId(ctx, identity.UserID)
+	if err == hps.ErrNotFound || (err == nil && (teacher.DeleteTime.Valid || teacher.Role != auth.RoleTeacher)) {
+		return nil, errNotTeacher
+	}
+	if err != nil {
+		return nil, err
+	}
+	return teacher, nil
+}
+
+// ownedClass 查询调用方老师创建的班级，班级不存在或不属于该老师时都返回班级不存在
+func ownedClass(ctx context.Context, svcCtx *svc.ServiceContext, classID int64) (*hps.Classes, error) {
+	teacher, err := currentTeacher(ctx, svcCtx)
+	if err != nil {
+		return nil, err
+	}
+	class, err := svcCtx.ClassModel.FindOneByClassId(ctx, classID)
+	if err == hps.ErrNotFound || (err == nil && (class.DeleteTime.Valid || class.TeacherId != teacher.UserId)) {
+		return nil, errClassNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return class, nil
+}
+
+// classStudents 查询班级中未删除的学生账号，按加入先后排序
+func classStudents(ctx context.Context, svcCtx *svc.ServiceContext, classID int64) ([]*hps.ClassMembers, map[int64]*hps.Users, error) {
+	members, err := svcCtx.ClassMemberModel.FindByClass(ctx, classID)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, nil, fmt.Errorf("查询班级成员失败: %w", err)
+	}
+
+	active := make([]*hps.ClassMembers, 0, len(members))
+	users := make(map[int64]*hps.Users, len(members))
+	for _, m := range members {
+		user, err := svcCtx.UserModel.FindOneByUserId(ctx, m.StudentId)
+		if err == hps.ErrNotFound || (err == nil && user.DeleteTime.Valid) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("查询学生账号失败: %w", err)
+		}
+		active = append(active, m)
+		users[m.StudentId] = user
+	}
+	return active, users, nil
+}
+
+func toClassInfo(class *hps.Classes, studentCount int64) *classroom.ClassInfo {
+	return &classroom.ClassInfo{
+		ClassId:      class.ClassId,
+		TeacherId:    class.TeacherId,
+		Name:         class.Name,
+		Grade:        class.Grade.String,
+		StudentCount: studentCount,
+		CreateTime:   class.CreateTime.Format(timeLayout),
+	}
+}
+
+func toStudent(member *hps.ClassMembers, user *hps.Users) *classroom.Student {
+	return &classroom.Student{
+		UserId:   user.UserId,
+		Username: user.Username.String,
+		Nickname: user.Nickname.String,
+		Avatar:   user.Avatar.String,
+		JoinTime: member.CreateTime.Format(timeLayout),
+	}
+}
+
+func toAssignment(a *hps.Assignments) *classroom.Assignment {
+	var tags []string
+	if a.Tags.Valid && a.Tags.String != "" {
+		_ = json.Unmarshal([]byte(a.Tags.String), &tags)
+	}
+	var dueDate string
+	if a.DueTime.Valid {
+		dueDate = a.DueTime.Time.Format(dateLayout)
+	}
+	return &classroom.Assignment{
+		AssignmentId:         a.AssignmentId,
+		ClassId:              a.ClassId,
+		Title:                a.Title,
+		Category:             a.Category,
+		DrivingQuestion:      a.DrivingQuestion,
+		Description:          a.Description.String,
+		Tags:                 tags,
+		RequiredObservations: int32(a.RequiredObservations),
+		RequiredQuestions:    int32(a.RequiredQuestions),
+		RequiredExpressions:  int32(a.RequiredExpressions),
+		DueDate:              dueDate,
+		CreateTime:           a.CreateTime.Format(timeLayout),
+	}
+}
@@ -0,0 +1,257 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"explorapal/app/classroom/rpc/classroom"
+	"explorapal/app/classroom/rpc/internal/svc"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/idgen"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// 每项要求的最大数量
+	maxRequired = 50
+	// 通知内容的预览长度
+	notificationPreviewLength = 200
+)
+
+type CreateAssignmentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateAssignmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateAssignmentLogic {
+	return &CreateAssignmentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 老师布置作业，为班级中的每个学生创建一个关联该作业的项目并通知学生
+func (l *CreateAssignmentLogic) CreateAssignment(in *classroom.CreateAssignmentReq) (*classroom.CreateAssignmentResp, error) {
+	assignment, projectCount, err := l.create(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "布置作业失败")
+		if err != nil {
+			l.Logger.Errorf("布置作业失败: %v", err)
+		}
+		return &classroom.CreateAssignmentResp{
+			Status:       status,
+			Msg:          msg,
+			ProjectCount: projectCount,
+		}, err
+	}
+
+	return &classroom.CreateAssignmentResp{
+		Status:       200,
+		Msg:          "布置作业成功",
+		Assignment:   toAssignment(assignment),
+		ProjectCount: projectCount,
+	}, nil
+}
+
+func (l *CreateAssignmentLogic) create(in *classroom.CreateAssignmentReq) (*hps.Assignments, int32, error) {
+	class, err := ownedClass(l.ctx, l.svcCtx, in.ClassId)
+	if err != nil {
+		return nil, 0, err
+	}
+	assignment, err := l.validate(in)
+	if err != nil {
+		return nil, 0, err
+	}
+	members, _, err := classStudents(l.ctx, l.svcCtx, class.ClassId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(members) == 0 {
+		return nil, 0, errNoStudents
+	}
+	if err := l.screen(assignment); err != nil {
+		return nil, 0, err
+	}
+
+	assignment.AssignmentId = idgen.Next()
+	assignment.ClassId = class.ClassId
+	assignment.TeacherId = class.TeacherId
+
+	// 作业、每个学生的项目、项目活动和通知在同一个事务中写入，任何一步失败都不会留下只布置给部分学生的作业
+	err = l.svcCtx.UnitOfWork.Do(l.ctx, func(ctx context.Context, tx *hps.Models) error {
+		if _, err := tx.Assignments.Insert(ctx, assignment); err != nil {
+			return err
+		}
+		for _, m := range members {
+			projectID, err := l.assign(ctx, tx, assignment, m.StudentId)
+			if err != nil {
+				return fmt.Errorf("为学生%d创建项目失败: %w", m.StudentId, err)
+			}
+			if err := l.notifyStudent(ctx, tx, assignment, m.StudentId, projectID); err != nil {
+				return fmt.Errorf("通知学生%d失败: %w", m.StudentId, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	projectCount := int32(len(members))
+
+	saved, err := l.svcCtx.AssignmentModel.FindOneByAssignmentId(l.ctx, assignment.AssignmentId)
+	if err != nil {
+		return nil, projectCount, err
+	}
+	return saved, projectCount, nil
+}
+
+// validate 校验作业内容，返回待保存的作业
+func (l *CreateAssignmentLogic) validate(in *classroom.CreateAssignmentReq) (*hps.Assignments, error) {
+	title := strings.TrimSpace(in.Title)
+	if title == "" || utf8.RuneCountInString(title) > 200 {
+		return nil, errInvalidTitle
+	}
+	category := strings.TrimSpace(in.Category)
+	if category == "" {
+		return nil, errInvalidCategory
+	}
+	question := strings.TrimSpace(in.DrivingQuestion)
+	if question == "" {
+		return nil, errInvalidQuestion
+	}
+	for _, n := range []int32{in.RequiredObservations, in.RequiredQuestions, in.RequiredExpressions} {
+		if n < 0 || n > maxRequired {
+			return nil, errInvalidRequired
+		}
+	}
+
+	assignment := &hps.Assignments{
+		Title:                title,
+		Category:             category,
+		DrivingQuestion:      question,
+		RequiredObservations: int64(in.RequiredObservations),
+		RequiredQuestions:    int64(in.RequiredQuestions),
+		RequiredExpressions:  int64(in.RequiredExpressions),
+	}
+	if description := strings.TrimSpace(in.Description); description != "" {
+		assignment.Description = sql.NullString{String: description, Valid: true}
+	}
+	if len(in.Tags) > 0 {
+		tags, err := json.Marshal(in.Tags)
+		if err != nil {
+			return nil, err
+		}
+		assignment.Tags = sql.NullString{String: string(tags), Valid: true}
+	}
+	if in.DueDate != "" {
+		now := time.Now()
+		due, err := time.ParseInLocation(dateLayout, in.DueDate, now.Location())
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		if err != nil || due.Before(today) {
+			return nil, errInvalidDueDate
+		}
+		// 截止到当天结束
+		assignment.DueTime = sql.NullTime{Time: due.Add(24*time.Hour - time.Second), Valid: true}
+	}
+	return assignment, nil
+}
+
+// screen 作业内容会出现在学生的项目中，按返回给孩子的内容检查，低、中风险使用过滤后的内容
+func (l *CreateAssignmentLogic) screen(assignment *hps.Assignments) error {
+	fields := []struct {
+		name  string
+		value *string
+	}{
+		{"title", &assignment.Title},
+		{"driving_question", &assignment.DrivingQuestion},
+		{"description", &assignment.Description.String},
+	}
+	targets := make([]security.CheckTarget, len(fields))
+	for i, f := range fields {
+		targets[i] = security.CheckTarget{
+			Content:     *f.value,
+			ContentType: security.ContentTypeText,
+			Direction:   security.DirectionOutput,
+			Field:       "assignment." + f.name,
+		}
+	}
+
+	for i, decision := range l.svcCtx.SafetyGuard.CheckAll(l.ctx, targets) {
+		if decision.Blocked() {
+			return errContentBlocked
+		}
+		if *fields[i].value != "" {
+			*fields[i].value = decision.Content
+		}
+	}
+	return nil
+}
+
+// assign 在事务中为学生创建作业项目和布置活动，返回项目ID
+func (l *CreateAssignmentLogic) assign(ctx context.Context, tx *hps.Models, assignment *hps.Assignments, studentID int64) (int64, error) {
+	projectID := idgen.Next()
+	_, err := tx.Projects.InsertWithCode(ctx, &hps.Projects{
+		ProjectId:    projectID,
+		ProjectCode:  idgen.ProjectCode(),
+		UserId:       studentID,
+		Title:        assignment.Title,
+		Description:  sql.NullString{String: assignment.DrivingQuestion, Valid: true},
+		Category:     assignment.Category,
+		Status:       "active",
+		Tags:         assignment.Tags,
+		AssignmentId: sql.NullInt64{Int64: assignment.AssignmentId, Valid: true},
+	}, idgen.ProjectCode)
+	if err != nil {
+		return 0, err
+	}
+
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"assignment_id": assignment.AssignmentId,
+		"class_id":      assignment.ClassId,
+	})
+	_, err = tx.ProjectActivities.Insert(ctx, &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   projectID,
+		UserId:      assignment.TeacherId,
+		Type:        hps.ActivityTypeAssignProject,
+		Description: fmt.Sprintf("老师布置了项目：%s", assignment.Title),
+		Metadata:    sql.NullString{String: string(metadata), Valid: true},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return projectID, nil
+}
+
+// notifyStudent 在事务中写入学生的新作业通知
+func (l *CreateAssignmentLogic) notifyStudent(ctx context.Context, tx *hps.Models, assignment *hps.Assignments, studentID, projectID int64) error {
+	_, err := tx.Notifications.Insert(ctx, &hps.Notifications{
+		NotificationId: idgen.Next(),
+		UserId:         studentID,
+		Type:           hps.NotificationTypeAssignment,
+		Title:          "老师布置了新的探索项目",
+		Content:        sql.NullString{String: preview(assignment.Title + "：" + assignment.DrivingQuestion), Valid: true},
+		ProjectId:      sql.NullInt64{Int64: projectID, Valid: true},
+		RefType:        sql.NullString{String: hps.NotificationTypeAssignment, Valid: true},
+		RefId:          sql.NullInt64{Int64: assignment.AssignmentId, Valid: true},
+	})
+	return err
+}
+
+// preview 通知中展示的作业标题和驱动问题
+func preview(content string) string {
+	runes := []rune(content)
+	if len(runes) > notificationPreviewLength {
+		return string(runes[:notificationPreviewLength]) + "…"
+	}
+	return content
+}
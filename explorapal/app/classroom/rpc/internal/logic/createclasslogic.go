@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"unicode/utf8"
+
+	"explorapal/app/classroom/rpc/classroom"
+	"explorapal/app/classroom/rpc/internal/svc"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/idgen"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateClassLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateClassLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateClassLogic {
+	return &CreateClassLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 老师创建班级
+func (l *CreateClassLogic) CreateClass(in *classroom.CreateClassReq) (*classroom.CreateClassResp, error) {
+	class, err := l.create(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "创建班级失败")
+		if err != nil {
+			l.Logger.Errorf("创建班级失败: %v", err)
+		}
+		return &classroom.CreateClassResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &classroom.CreateClassResp{
+		Status: 200,
+		Msg:    "创建班级成功",
+		Class:  toClassInfo(class, 0),
+	}, nil
+}
+
+func (l *CreateClassLogic) create(in *classroom.CreateClassReq) (*hps.Classes, error) {
+	teacher, err := currentTeacher(l.ctx, l.svcCtx)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(in.Name)
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return nil, errInvalidClassName
+	}
+	grade := strings.TrimSpace(in.Grade)
+
+	classID := idgen.Next()
+	_, err = l.svcCtx.ClassModel.Insert(l.ctx, &hps.Classes{
+		ClassId:   classID,
+		TeacherId: teacher.UserId,
+		Name:      name,
+		Grade:     sql.NullString{String: grade, Valid: grade != ""},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return l.svcCtx.ClassModel.FindOneByClassId(l.ctx, classID)
+}
@@ -0,0 +1,189 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/classroom/rpc/classroom"
+	"explorapal/app/classroom/rpc/internal/svc"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/projectstatus"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/mr"
+)
+
+type GetClassProgressLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetClassProgressLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetClassProgressLogic {
+	return &GetClassProgressLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 老师查看班级中每个学生在各个作业上的进度
+func (l *GetClassProgressLogic) GetClassProgress(in *classroom.GetClassProgressReq) (*classroom.GetClassProgressResp, error) {
+	resp, err := l.progress(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "查询班级进度失败")
+		if err != nil {
+			l.Logger.Errorf("查询班级进度失败: %v", err)
+		}
+		return &classroom.GetClassProgressResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	resp.Status = 200
+	resp.Msg = "查询班级进度成功"
+	return resp, nil
+}
+
+func (l *GetClassProgressLogic) progress(in *classroom.GetClassProgressReq) (*classroom.GetClassProgressResp, error) {
+	class, err := ownedClass(l.ctx, l.svcCtx, in.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	members, users, err := classStudents(l.ctx, l.svcCtx, class.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := l.svcCtx.AssignmentModel.FindByClass(l.ctx, class.ClassId)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, err
+	}
+
+	assignmentIDs := make([]int64, 0, len(assignments))
+	for _, a := range assignments {
+		assignmentIDs = append(assignmentIDs, a.AssignmentId)
+	}
+	projects, err := l.svcCtx.ProjectModel.FindByAssignments(l.ctx, assignmentIDs)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, err
+	}
+
+	// 作业ID -> 学生ID -> 项目
+	assigned := make(map[int64]map[int64]*hps.Projects, len(assignments))
+	projectIDs := make([]int64, 0, len(projects))
+	for _, p := range projects {
+		if assigned[p.AssignmentId.Int64] == nil {
+			assigned[p.AssignmentId.Int64] = map[int64]*hps.Projects{}
+		}
+		assigned[p.AssignmentId.Int64][p.UserId] = p
+		projectIDs = append(projectIDs, p.ProjectId)
+	}
+
+	var observations, questions, expressions map[int64]int64
+	err = mr.Finish(func() error {
+		counts, err := l.svcCtx.ObservationModel.CountByProjects(l.ctx, projectIDs)
+		observations = toCountMap(counts)
+		return ignoreNotFound(err)
+	}, func() error {
+		counts, err := l.svcCtx.QuestionModel.CountByProjects(l.ctx, projectIDs)
+		questions = toCountMap(counts)
+		return ignoreNotFound(err)
+	}, func() error {
+		counts, err := l.svcCtx.ExpressionModel.CountByProjects(l.ctx, projectIDs)
+		expressions = toCountMap(counts)
+		return ignoreNotFound(err)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &classroom.GetClassProgressResp{
+		Class:       toClassInfo(class, int64(len(members))),
+		Assignments: make([]*classroom.Assignment, 0, len(assignments)),
+		Students:    make([]*classroom.StudentProgress, 0, len(members)),
+	}
+	for _, a := range assignments {
+		resp.Assignments = append(resp.Assignments, toAssignment(a))
+	}
+
+	for _, m := range members {
+		student := &classroom.StudentProgress{
+			Student: toStudent(m, users[m.StudentId]),
+		}
+		var total int32
+		for _, a := range assignments {
+			// 学生在作业布置后才加入班级时没有对应的项目
+			project, ok := assigned[a.AssignmentId][m.StudentId]
+			if !ok {
+				continue
+			}
+			p := assignmentProgress(a, project,
+				observations[project.ProjectId], questions[project.ProjectId], expressions[project.ProjectId])
+			if p.Completed {
+				student.CompletedCount++
+			}
+			total += p.Progress
+			student.Assignments = append(student.Assignments, p)
+		}
+		if len(student.Assignments) > 0 {
+			student.AverageProgress = total / int32(len(student.Assignments))
+		}
+		resp.Students = append(resp.Students, student)
+	}
+	return resp, nil
+}
+
+// assignmentProgress 按作业要求的数量计算学生项目的进度；作业没有数量要求时使用项目自身的进度
+func assignmentProgress(a *hps.Assignments, project *hps.Projects, observations, questions, expressions int64) *classroom.AssignmentProgress {
+	result := &classroom.AssignmentProgress{
+		AssignmentId: a.AssignmentId,
+		ProjectId:    project.ProjectId,
+		Status:       project.Status,
+		Observations: observations,
+		Questions:    questions,
+		Expressions:  expressions,
+	}
+	if project.LastActivityAt.Valid {
+		result.LastActivityAt = project.LastActivityAt.Time.Format(timeLayout)
+	}
+
+	var parts []float64
+	for _, r := range []struct{ done, required int64 }{
+		{observations, a.RequiredObservations},
+		{questions, a.RequiredQuestions},
+		{expressions, a.RequiredExpressions},
+	} {
+		if r.required <= 0 {
+			continue
+		}
+		parts = append(parts, float64(min(r.done, r.required))/float64(r.required))
+	}
+
+	if len(parts) == 0 {
+		result.Progress = int32(project.Progress)
+		result.Completed = project.Status == projectstatus.Completed
+		return result
+	}
+	var sum float64
+	for _, p := range parts {
+		sum += p
+	}
+	result.Progress = int32(sum / float64(len(parts)) * 100)
+	result.Completed = result.Progress == 100 || project.Status == projectstatus.Completed
+	return result
+}
+
+func toCountMap(counts []*hps.ProjectCount) map[int64]int64 {
+	result := make(map[int64]int64, len(counts))
+	for _, c := range counts {
+		result[c.ProjectId] = c.Total
+	}
+	return result
+}
+
+func ignoreNotFound(err error) error {
+	if err == hps.ErrNotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/classroom/rpc/classroom"
+	"explorapal/app/classroom/rpc/internal/svc"
+	"explorapal/app/model/hps"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListAssignmentsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListAssignmentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAssignmentsLogic {
+	return &ListAssignmentsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 老师查询班级的作业，按布置时间倒序
+func (l *ListAssignmentsLogic) ListAssignments(in *classroom.ListAssignmentsReq) (*classroom.ListAssignmentsResp, error) {
+	assignments, err := l.list(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "查询作业失败")
+		if err != nil {
+			l.Logger.Errorf("查询作业失败: %v", err)
+		}
+		return &classroom.ListAssignmentsResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &classroom.ListAssignmentsResp{
+		Status:      200,
+		Msg:         "查询作业成功",
+		Assignments: assignments,
+	}, nil
+}
+
+func (l *ListAssignmentsLogic) list(in *classroom.ListAssignmentsReq) ([]*classroom.Assignment, error) {
+	class, err := ownedClass(l.ctx, l.svcCtx, in.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := l.svcCtx.AssignmentModel.FindByClass(l.ctx, class.ClassId)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, err
+	}
+
+	result := make([]*classroom.Assignment, 0, len(assignments))
+	for _, a := range assignments {
+		result = append(result, toAssignment(a))
+	}
+	return result, nil
+}
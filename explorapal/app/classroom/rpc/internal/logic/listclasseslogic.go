@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/classroom/rpc/classroom"
+	"explorapal/app/classroom/rpc/internal/svc"
+	"explorapal/app/model/hps"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListClassesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListClassesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListClassesLogic {
+	return &ListClassesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 老师查询自己的班级
+func (l *ListClassesLogic) ListClasses(in *classroom.ListClassesReq) (*classroom.ListClassesResp, error) {
+	classes, err := l.list()
+	if err != nil {
+		status, msg, err := errorStatus(err, "查询班级失败")
+		if err != nil {
+			l.Logger.Errorf("查询班级失败: %v", err)
+		}
+		return &classroom.ListClassesResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &classroom.ListClassesResp{
+		Status:  200,
+		Msg:     "查询班级成功",
+		Classes: classes,
+	}, nil
+}
+
+func (l *ListClassesLogic) list() ([]*classroom.ClassInfo, error) {
+	teacher, err := currentTeacher(l.ctx, l.svcCtx)
+	if err != nil {
+		return nil, err
+	}
+	classes, err := l.svcCtx.ClassModel.FindByTeacher(l.ctx, teacher.UserId)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, err
+	}
+
+	result := make([]*classroom.ClassInfo, 0, len(classes))
+	for _, class := range classes {
+		count, err := l.svcCtx.ClassMemberModel.CountByClass(l.ctx, class.ClassId)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, toClassInfo(class, count))
+	}
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/classroom/rpc/classroom"
+	"explorapal/app/classroom/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListStudentsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListStudentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListStudentsLogic {
+	return &ListStudentsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 老师查询班级中的学生
+func (l *ListStudentsLogic) ListStudents(in *classroom.ListStudentsReq) (*classroom.ListStudentsResp, error) {
+	students, err := l.list(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "查询学生失败")
+		if err != nil {
+			l.Logger.Errorf("查询学生失败: %v", err)
+		}
+		return &classroom.ListStudentsResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &classroom.ListStudentsResp{
+		Status:   200,
+		Msg:      "查询学生成功",
+		Students: students,
+	}, nil
+}
+
+func (l *ListStudentsLogic) list(in *classroom.ListStudentsReq) ([]*classroom.Student, error) {
+	class, err := ownedClass(l.ctx, l.svcCtx, in.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	members, users, err := classStudents(l.ctx, l.svcCtx, class.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	students := make([]*classroom.Student, 0, len(members))
+	for _, m := range members {
+		students = append(students, toStudent(m, users[m.StudentId]))
+	}
+	return students, nil
+}
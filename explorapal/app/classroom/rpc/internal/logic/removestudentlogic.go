@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/classroom/rpc/classroom"
+	"explorapal/app/classroom/rpc/internal/svc"
+	"explorapal/app/model/hps"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveStudentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveStudentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveStudentLogic {
+	return &RemoveStudentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 老师把学生移出班级，已布置给该学生的项目保留
+func (l *RemoveStudentLogic) RemoveStudent(in *classroom.RemoveStudentReq) (*classroom.RemoveStudentResp, error) {
+	if err := l.remove(in); err != nil {
+		status, msg, err := errorStatus(err, "移除学生失败")
+		if err != nil {
+			l.Logger.Errorf("移除学生失败: %v", err)
+		}
+		return &classroom.RemoveStudentResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &classroom.RemoveStudentResp{
+		Status: 200,
+		Msg:    "移除学生成功",
+	}, nil
+}
+
+func (l *RemoveStudentLogic) remove(in *classroom.RemoveStudentReq) error {
+	class, err := ownedClass(l.ctx, l.svcCtx, in.ClassId)
+	if err != nil {
+		return err
+	}
+	member, err := l.svcCtx.ClassMemberModel.FindOneByClassIdStudentId(l.ctx, class.ClassId, in.StudentId)
+	if err == hps.ErrNotFound {
+		return errStudentNotInClass
+	}
+	if err != nil {
+		return err
+	}
+	return l.svcCtx.ClassMemberModel.Delete(l.ctx, member.Id)
+}
@@ -0,0 +1,69 @@
+package svc
+
+import (
+	"explorapal/app/classroom/rpc/internal/config"
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/safetyaudit"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type ServiceContext struct {
+	Config config.Config
+
+	// 数据库模型
+	UserModel         hps.UsersModel
+	GuardianLinkModel hps.GuardianLinksModel
+	ClassModel        hps.ClassesModel
+	ClassMemberModel  hps.ClassMembersModel
+	AssignmentModel   hps.AssignmentsModel
+	ProjectModel      hps.ProjectsModel
+	ObservationModel  hps.ObservationsModel
+	QuestionModel     hps.QuestionsModel
+	ExpressionModel   hps.ExpressionsModel
+
+	// 多表写入的事务
+	UnitOfWork *hps.UnitOfWork
+
+	// 访问令牌校验
+	Auth *auth.Issuer
+
+	// 内容安全守卫
+	SafetyGuard *security.Guard
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	conn := sqlx.NewMysql(c.DBConfig.DataSource)
+
+	securityClient := security.NewSecurityClient(&security.Config{
+		BaseURL:         c.SecurityConfig.BaseURL,
+		APIKey:          c.SecurityConfig.APIKey,
+		Timeout:         c.SecurityConfig.Timeout,
+		BatchWorkers:    c.SecurityConfig.BatchWorkers,
+		BatchSize:       c.SecurityConfig.BatchSize,
+		LocalRules:      c.SecurityConfig.LocalRules,
+		FailurePolicies: c.SecurityConfig.FailurePolicies,
+	})
+
+	return &ServiceContext{
+		Config: c,
+
+		UserModel:         hps.NewUsersModel(conn, c.Cache),
+		GuardianLinkModel: hps.NewGuardianLinksModel(conn, c.Cache),
+		ClassModel:        hps.NewClassesModel(conn, c.Cache),
+		ClassMemberModel:  hps.NewClassMembersModel(conn, c.Cache),
+		AssignmentModel:   hps.NewAssignmentsModel(conn, c.Cache),
+		ProjectModel:      hps.NewProjectsModel(conn, c.Cache),
+		ObservationModel:  hps.NewObservationsModel(conn, c.Cache),
+		QuestionModel:     hps.NewQuestionsModel(conn, c.Cache),
+		ExpressionModel:   hps.NewExpressionsModel(conn, c.Cache),
+
+		UnitOfWork: hps.NewUnitOfWork(conn, c.Cache),
+
+		Auth: auth.NewIssuer(&auth.Config{AccessSecret: c.JwtAuth.AccessSecret}),
+
+		SafetyGuard: security.NewGuard(securityClient, safetyaudit.NewAuditor(hps.NewSafetyAuditLogsModel(conn, c.Cache))),
+	}
+}
@@ -1,10 +1,29 @@
 package hps
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
+// 成果类型
+const (
+	AchievementTypeReport      = "report"
+	AchievementTypeDocumentary = "documentary"
+	AchievementTypePoster      = "poster"
+)
+
+// 成果状态
+const (
+	AchievementStatusGenerating = "generating" // 生成中
+	AchievementStatusCompleted  = "completed"  // 已生成
+	AchievementStatusFailed     = "failed"     // 生成失败
+)
+
 var _ AchievementsModel = (*customAchievementsModel)(nil)
 
 type (
@@ -12,6 +31,9 @@ type (
 	// and implement the added methods in customAchievementsModel.
 	AchievementsModel interface {
 		achievementsModel
+		CountByType(ctx context.Context, userID int64, from, to time.Time) ([]*GroupCount, error)
+		CountByProjectType(ctx context.Context, projectID int64) ([]*GroupCount, error)
+		FindByProject(ctx context.Context, projectID, limit int64) ([]*Achievements, error)
 	}
 
 	customAchievementsModel struct {
@@ -25,3 +47,211 @@ func NewAchievementsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Op
 		defaultAchievementsModel: newAchievementsModel(conn, c, opts...),
 	}
 }
+
+// CountByType 统计用户在时间段内生成的成果，按类型分组
+func (m *customAchievementsModel) CountByType(ctx context.Context, userID int64, from, to time.Time) ([]*GroupCount, error) {
+	var resp []*GroupCount
+	query := fmt.Sprintf("select `type` as `name`, count(*) as `total` from %s "+
+		"where `user_id` = ? and `create_time` >= ? and `create_time` < ? and `delete_time` IS NULL "+
+		"group by `name` order by `total` desc", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userID, from, to)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// CountByProjectType 按类型统计项目的成果
+func (m *customAchievementsModel) CountByProjectType(ctx context.Context, projectID int64) ([]*GroupCount, error) {
+	var resp []*GroupCount
+	query := fmt.Sprintf("select `type` as `name`, count(*) as `total` from %s "+
+		"where `project_id` = ? and `delete_time` IS NULL group by `name`", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, projectID)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByProject 查询项目最近的成果，按创建时间倒序
+func (m *customAchievementsModel) FindByProject(ctx context.Context, projectID, limit int64) ([]*Achievements, error) {
+	var resp []*Achievements
+	query := fmt.Sprintf("select %s from %s where `project_id` = ? and `delete_time` IS NULL order by `create_time` desc limit ?", achievementsRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, projectID, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// ResearchReport 研究报告结构
+type ResearchReport struct {
+	Title         string         `json:"title"`
+	Abstract      string         `json:"abstract"`
+	Introduction  string         `json:"introduction"`
+	Methodology   string         `json:"methodology"`
+	Findings      []Finding      `json:"findings"`
+	Discussion    string         `json:"discussion"`
+	Conclusion    string         `json:"conclusion"`
+	References    []Reference    `json:"references"`
+	Visuals       []ReportVisual `json:"visuals"`
+	ChildInsights string         `json:"child_insights"`
+	NextSteps     []string       `json:"next_steps"`
+}
+
+// Finding 发现结构
+type Finding struct {
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	Evidence     []string `json:"evidence"`
+	Significance string   `json:"significance"`
+}
+
+// Reference 参考资料结构
+type Reference struct {
+	Title  string `json:"title"`
+	Type   string `json:"type"`
+	URL    string `json:"url,omitempty"`
+	Credit string `json:"credit"`
+}
+
+// ReportVisual 报告视觉元素结构
+type ReportVisual struct {
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Data        string `json:"data"`
+}
+
+// DocumentaryScript 纪录片脚本结构
+type DocumentaryScript struct {
+	Title     string   `json:"title"`
+	Duration  int32    `json:"duration"`
+	Style     string   `json:"style"`
+	Scenes    []Scene  `json:"scenes"`
+	Narration string   `json:"narration"`
+	Music     string   `json:"music"`
+	Effects   []string `json:"effects"`
+}
+
+// Scene 场景结构
+type Scene struct {
+	SceneNumber int32    `json:"scene_number"`
+	Duration    int32    `json:"duration"`
+	Description string   `json:"description"`
+	Visuals     []string `json:"visuals"`
+	Narration   string   `json:"narration"`
+	Transitions string   `json:"transitions,omitempty"`
+}
+
+// PosterDesign 海报设计结构
+type PosterDesign struct {
+	Title          string          `json:"title"`
+	Style          string          `json:"style"`
+	Layout         string          `json:"layout"`
+	Sections       []PosterSection `json:"sections"`
+	ColorScheme    ColorScheme     `json:"color_scheme"`
+	Typography     Typography      `json:"typography"`
+	VisualElements []VisualElement `json:"visual_elements"`
+}
+
+// PosterSection 海报区域结构
+type PosterSection struct {
+	Type    string  `json:"type"`
+	X       float64 `json:"x"`
+	Y       float64 `json:"y"`
+	Width   float64 `json:"width"`
+	Height  float64 `json:"height"`
+	Content string  `json:"content"`
+	Style   string  `json:"style"`
+}
+
+// ColorScheme 配色方案结构
+type ColorScheme struct {
+	Primary   string   `json:"primary"`
+	Secondary string   `json:"secondary"`
+	Accent    string   `json:"accent"`
+	Palette   []string `json:"palette"`
+}
+
+// Typography 字体设计结构
+type Typography struct {
+	TitleFont   string `json:"title_font"`
+	BodyFont    string `json:"body_font"`
+	HeadingFont string `json:"heading_font"`
+	TitleSize   int32  `json:"title_size"`
+	BodySize    int32  `json:"body_size"`
+	HeadingSize int32  `json:"heading_size"`
+}
+
+// GetResearchReport 解析研究报告JSON
+func (a *Achievements) GetResearchReport() (*ResearchReport, error) {
+	value := &ResearchReport{}
+	if a.Content == "" {
+		return value, nil
+	}
+	err := json.Unmarshal([]byte(a.Content), value)
+	return value, err
+}
+
+// SetResearchReport 设置研究报告JSON
+func (a *Achievements) SetResearchReport(report *ResearchReport) error {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	a.Content = string(data)
+	return nil
+}
+
+// GetDocumentaryScript 解析纪录片脚本JSON
+func (a *Achievements) GetDocumentaryScript() (*DocumentaryScript, error) {
+	value := &DocumentaryScript{}
+	if a.Content == "" {
+		return value, nil
+	}
+	err := json.Unmarshal([]byte(a.Content), value)
+	return value, err
+}
+
+// SetDocumentaryScript 设置纪录片脚本JSON
+func (a *Achievements) SetDocumentaryScript(script *DocumentaryScript) error {
+	data, err := json.Marshal(script)
+	if err != nil {
+		return err
+	}
+	a.Content = string(data)
+	return nil
+}
+
+// GetPosterDesign 解析海报设计JSON
+func (a *Achievements) GetPosterDesign() (*PosterDesign, error) {
+	value := &PosterDesign{}
+	if a.Content == "" {
+		return value, nil
+	}
+	err := json.Unmarshal([]byte(a.Content), value)
+	return value, err
+}
+
+// SetPosterDesign 设置海报设计JSON
+func (a *Achievements) SetPosterDesign(design *PosterDesign) error {
+	data, err := json.Marshal(design)
+	if err != nil {
+		return err
+	}
+	a.Content = string(data)
+	return nil
+}
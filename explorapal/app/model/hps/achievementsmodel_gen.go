@@ -60,6 +60,7 @@ type (
 		Layout        sql.NullString `db:"layout"`         // 布局参数
 		Length        sql.NullString `db:"length"`         // 时长参数
 		Status        string         `db:"status"`         // 状态：generating,completed,failed
+		ReviewStatus  string         `db:"review_status"`  // 人工审核状态：none,pending,approved,rejected
 		ErrorMsg      sql.NullString `db:"error_msg"`      // 错误信息
 		ViewCount     int64          `db:"view_count"`     // 查看次数
 		LikeCount     int64          `db:"like_count"`     // 点赞次数
@@ -130,8 +131,8 @@ func (m *defaultAchievementsModel) Insert(ctx context.Context, data *Achievement
 	achievementsAchievementIdKey := fmt.Sprintf("%s%v", cacheAchievementsAchievementIdPrefix, data.AchievementId)
 	achievementsIdKey := fmt.Sprintf("%s%v", cacheAchievementsIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, achievementsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.DeleteTime, data.AchievementId, data.ProjectId, data.UserId, data.Type, data.Title, data.Description, data.Content, data.Url, data.FileSize, data.Style, data.Layout, data.Length, data.Status, data.ErrorMsg, data.ViewCount, data.LikeCount, data.ShareCount)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, achievementsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.DeleteTime, data.AchievementId, data.ProjectId, data.UserId, data.Type, data.Title, data.Description, data.Content, data.Url, data.FileSize, data.Style, data.Layout, data.Length, data.Status, data.ReviewStatus, data.ErrorMsg, data.ViewCount, data.LikeCount, data.ShareCount)
 	}, achievementsAchievementIdKey, achievementsIdKey)
 	return ret, err
 }
@@ -146,7 +147,7 @@ func (m *defaultAchievementsModel) Update(ctx context.Context, newData *Achievem
 	achievementsIdKey := fmt.Sprintf("%s%v", cacheAchievementsIdPrefix, data.Id)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, achievementsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.AchievementId, newData.ProjectId, newData.UserId, newData.Type, newData.Title, newData.Description, newData.Content, newData.Url, newData.FileSize, newData.Style, newData.Layout, newData.Length, newData.Status, newData.ErrorMsg, newData.ViewCount, newData.LikeCount, newData.ShareCount, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.AchievementId, newData.ProjectId, newData.UserId, newData.Type, newData.Title, newData.Description, newData.Content, newData.Url, newData.FileSize, newData.Style, newData.Layout, newData.Length, newData.Status, newData.ReviewStatus, newData.ErrorMsg, newData.ViewCount, newData.LikeCount, newData.ShareCount, newData.Id)
 	}, achievementsAchievementIdKey, achievementsIdKey)
 	return err
 }
@@ -0,0 +1,46 @@
+package hps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ AssignmentsModel = (*customAssignmentsModel)(nil)
+
+type (
+	// AssignmentsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customAssignmentsModel.
+	AssignmentsModel interface {
+		assignmentsModel
+		FindByClass(ctx context.Context, classID int64) ([]*Assignments, error)
+	}
+
+	customAssignmentsModel struct {
+		*defaultAssignmentsModel
+	}
+)
+
+// NewAssignmentsModel returns a model for the database table.
+func NewAssignmentsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) AssignmentsModel {
+	return &customAssignmentsModel{
+		defaultAssignmentsModel: newAssignmentsModel(conn, c, opts...),
+	}
+}
+
+// FindByClass 查询班级的全部作业，按布置时间倒序
+func (m *customAssignmentsModel) FindByClass(ctx context.Context, classID int64) ([]*Assignments, error) {
+	var resp []*Assignments
+	query := fmt.Sprintf("select %s from %s where `class_id` = ? and `delete_time` IS NULL order by `create_time` desc", assignmentsRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, classID)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
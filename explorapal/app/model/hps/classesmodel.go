@@ -0,0 +1,46 @@
+package hps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ClassesModel = (*customClassesModel)(nil)
+
+type (
+	// ClassesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customClassesModel.
+	ClassesModel interface {
+		classesModel
+		FindByTeacher(ctx context.Context, teacherID int64) ([]*Classes, error)
+	}
+
+	customClassesModel struct {
+		*defaultClassesModel
+	}
+)
+
+// NewClassesModel returns a model for the database table.
+func NewClassesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ClassesModel {
+	return &customClassesModel{
+		defaultClassesModel: newClassesModel(conn, c, opts...),
+	}
+}
+
+// FindByTeacher 查询老师的全部班级，按创建先后排序
+func (m *customClassesModel) FindByTeacher(ctx context.Context, teacherID int64) ([]*Classes, error) {
+	var resp []*Classes
+	query := fmt.Sprintf("select %s from %s where `teacher_id` = ? and `delete_time` IS NULL order by `id` asc", classesRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, teacherID)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,65 @@
+package hps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ClassMembersModel = (*customClassMembersModel)(nil)
+
+type (
+	// ClassMembersModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customClassMembersModel.
+	ClassMembersModel interface {
+		classMembersModel
+		FindByClass(ctx context.Context, classID int64) ([]*ClassMembers, error)
+		CountByClass(ctx context.Context, classID int64) (int64, error)
+		IsTeacherStudent(ctx context.Context, teacherID, studentID int64) (bool, error)
+	}
+
+	customClassMembersModel struct {
+		*defaultClassMembersModel
+	}
+)
+
+// NewClassMembersModel returns a model for the database table.
+func NewClassMembersModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ClassMembersModel {
+	return &customClassMembersModel{
+		defaultClassMembersModel: newClassMembersModel(conn, c, opts...),
+	}
+}
+
+// FindByClass 查询班级的全部学生，按加入先后排序
+func (m *customClassMembersModel) FindByClass(ctx context.Context, classID int64) ([]*ClassMembers, error) {
+	var resp []*ClassMembers
+	query := fmt.Sprintf("select %s from %s where `class_id` = ? order by `id` asc", classMembersRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, classID)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// CountByClass 统计班级的学生人数
+func (m *customClassMembersModel) CountByClass(ctx context.Context, classID int64) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `class_id` = ?", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, classID)
+	return count, err
+}
+
+// IsTeacherStudent 学生是否在老师未删除的某个班级中
+func (m *customClassMembersModel) IsTeacherStudent(ctx context.Context, teacherID, studentID int64) (bool, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s m join `classes` c on c.`class_id` = m.`class_id` "+
+		"where c.`teacher_id` = ? and c.`delete_time` IS NULL and m.`student_id` = ?", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, teacherID, studentID)
+	return count > 0, err
+}
@@ -0,0 +1,73 @@
+package hps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 留言对象类型
+const (
+	CommentTargetProject     = "project"
+	CommentTargetObservation = "observation"
+	CommentTargetExpression  = "expression"
+	CommentTargetAchievement = "achievement"
+)
+
+// 留言形式
+const (
+	CommentKindText    = "text"
+	CommentKindSticker = "sticker"
+	CommentKindVoice   = "voice"
+)
+
+var _ CommentsModel = (*customCommentsModel)(nil)
+
+type (
+	// CommentsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customCommentsModel.
+	CommentsModel interface {
+		commentsModel
+		FindByProject(ctx context.Context, projectID int64, targetType string, targetID int64, page, pageSize int64) ([]*Comments, error)
+	}
+
+	customCommentsModel struct {
+		*defaultCommentsModel
+	}
+)
+
+// NewCommentsModel returns a model for the database table.
+func NewCommentsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) CommentsModel {
+	return &customCommentsModel{
+		defaultCommentsModel: newCommentsModel(conn, c, opts...),
+	}
+}
+
+// FindByProject 分页查询项目下的留言，按时间倒序；targetType为空时不限留言对象，targetID为0时不限具体记录
+func (m *customCommentsModel) FindByProject(ctx context.Context, projectID int64, targetType string, targetID int64, page, pageSize int64) ([]*Comments, error) {
+	var resp []*Comments
+	query := fmt.Sprintf("select %s from %s where `project_id` = ? and `delete_time` IS NULL", commentsRows, m.table)
+	args := []any{projectID}
+	if targetType != "" {
+		query += " and `target_type` = ?"
+		args = append(args, targetType)
+	}
+	if targetID > 0 {
+		query += " and `target_id` = ?"
+		args = append(args, targetID)
+	}
+	query += " order by `create_time` desc, `id` desc limit ?,?"
+	args = append(args, (page-1)*pageSize, pageSize)
+
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
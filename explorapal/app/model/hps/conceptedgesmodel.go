@@ -0,0 +1,52 @@
+package hps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ConceptEdgesModel = (*customConceptEdgesModel)(nil)
+
+// 概念图边的关系
+const (
+	ConceptRelationContains = "contains" // 项目包含概念
+	ConceptRelationRelated  = "related"  // 概念之间相关
+)
+
+type (
+	// ConceptEdgesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customConceptEdgesModel.
+	ConceptEdgesModel interface {
+		conceptEdgesModel
+		FindByUser(ctx context.Context, userID int64, relation string) ([]*ConceptEdges, error)
+	}
+
+	customConceptEdgesModel struct {
+		*defaultConceptEdgesModel
+	}
+)
+
+// NewConceptEdgesModel returns a model for the database table.
+func NewConceptEdgesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ConceptEdgesModel {
+	return &customConceptEdgesModel{
+		defaultConceptEdgesModel: newConceptEdgesModel(conn, c, opts...),
+	}
+}
+
+// FindByUser 查询用户概念图中某种关系的全部边，按权重从高到低排列
+func (m *customConceptEdgesModel) FindByUser(ctx context.Context, userID int64, relation string) ([]*ConceptEdges, error) {
+	var resp []*ConceptEdges
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `relation` = ? order by `weight` desc, `id`", conceptEdgesRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userID, relation)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
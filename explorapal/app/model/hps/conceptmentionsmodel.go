@@ -0,0 +1,27 @@
+package hps
+
+import (
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ConceptMentionsModel = (*customConceptMentionsModel)(nil)
+
+type (
+	// ConceptMentionsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customConceptMentionsModel.
+	ConceptMentionsModel interface {
+		conceptMentionsModel
+	}
+
+	customConceptMentionsModel struct {
+		*defaultConceptMentionsModel
+	}
+)
+
+// NewConceptMentionsModel returns a model for the database table.
+func NewConceptMentionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ConceptMentionsModel {
+	return &customConceptMentionsModel{
+		defaultConceptMentionsModel: newConceptMentionsModel(conn, c, opts...),
+	}
+}
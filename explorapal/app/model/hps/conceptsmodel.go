@@ -0,0 +1,57 @@
+package hps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ConceptsModel = (*customConceptsModel)(nil)
+
+// 概念类型
+const (
+	ConceptKindConcept  = "concept"
+	ConceptKindCategory = "category"
+	ConceptKindSpecies  = "species"
+)
+
+type (
+	// ConceptsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customConceptsModel.
+	ConceptsModel interface {
+		conceptsModel
+		FindByConceptIds(ctx context.Context, conceptIDs []int64) ([]*Concepts, error)
+	}
+
+	customConceptsModel struct {
+		*defaultConceptsModel
+	}
+)
+
+// NewConceptsModel returns a model for the database table.
+func NewConceptsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ConceptsModel {
+	return &customConceptsModel{
+		defaultConceptsModel: newConceptsModel(conn, c, opts...),
+	}
+}
+
+// FindByConceptIds 按概念ID批量查询
+func (m *customConceptsModel) FindByConceptIds(ctx context.Context, conceptIDs []int64) ([]*Concepts, error) {
+	if len(conceptIDs) == 0 {
+		return nil, ErrNotFound
+	}
+	var resp []*Concepts
+	placeholders, args := inArgs(conceptIDs)
+	query := fmt.Sprintf("select %s from %s where `concept_id` in (%s)", conceptsRows, m.table, placeholders)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
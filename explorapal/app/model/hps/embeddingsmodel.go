@@ -0,0 +1,58 @@
+package hps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ EmbeddingsModel = (*customEmbeddingsModel)(nil)
+
+// 向量对应的记录类型
+const (
+	EmbeddingEntityObservation = "observation"
+	EmbeddingEntityQuestion    = "question"
+	EmbeddingEntityExpression  = "expression"
+)
+
+type (
+	// EmbeddingsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customEmbeddingsModel.
+	EmbeddingsModel interface {
+		embeddingsModel
+		FindByUser(ctx context.Context, userID int64, model, entityType string) ([]*Embeddings, error)
+	}
+
+	customEmbeddingsModel struct {
+		*defaultEmbeddingsModel
+	}
+)
+
+// NewEmbeddingsModel returns a model for the database table.
+func NewEmbeddingsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) EmbeddingsModel {
+	return &customEmbeddingsModel{
+		defaultEmbeddingsModel: newEmbeddingsModel(conn, c, opts...),
+	}
+}
+
+// FindByUser 查询用户某个向量模型的全部向量，entityType为空时不限记录类型
+func (m *customEmbeddingsModel) FindByUser(ctx context.Context, userID int64, model, entityType string) ([]*Embeddings, error) {
+	var resp []*Embeddings
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `model` = ?", embeddingsRows, m.table)
+	args := []any{userID, model}
+	if entityType != "" {
+		query += " and `entity_type` = ?"
+		args = append(args, entityType)
+	}
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
@@ -1,6 +1,10 @@
 package hps
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
@@ -12,6 +16,12 @@ type (
 	// and implement the added methods in customExpressionsModel.
 	ExpressionsModel interface {
 		expressionsModel
+		FindAssessmentHistory(ctx context.Context, userID, projectID int64, since time.Time, limit int64) ([]*Expressions, error)
+		CountByType(ctx context.Context, userID int64, from, to time.Time) ([]*GroupCount, error)
+		CountByProjects(ctx context.Context, projectIDs []int64) ([]*ProjectCount, error)
+		CountByProjectType(ctx context.Context, projectID int64) ([]*GroupCount, error)
+		FindByProject(ctx context.Context, projectID, limit int64) ([]*Expressions, error)
+		Search(ctx context.Context, userID int64, keyword string, limit int64) ([]*SearchMatch, error)
 	}
 
 	customExpressionsModel struct {
@@ -25,3 +35,234 @@ func NewExpressionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Opt
 		defaultExpressionsModel: newExpressionsModel(conn, c, opts...),
 	}
 }
+
+// FindAssessmentHistory 查询用户做过发音评估的表达记录，按时间倒序；projectID为0时不限项目
+func (m *customExpressionsModel) FindAssessmentHistory(ctx context.Context, userID, projectID int64, since time.Time, limit int64) ([]*Expressions, error) {
+	var resp []*Expressions
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `assessment_accuracy` IS NOT NULL and `create_time` >= ? and `delete_time` IS NULL", expressionsRows, m.table)
+	args := []any{userID, since}
+	if projectID > 0 {
+		query += " and `project_id` = ?"
+		args = append(args, projectID)
+	}
+	query += " order by `create_time` desc limit ?"
+	args = append(args, limit)
+
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// CountByType 统计用户在时间段内的表达记录，按类型分组
+func (m *customExpressionsModel) CountByType(ctx context.Context, userID int64, from, to time.Time) ([]*GroupCount, error) {
+	var resp []*GroupCount
+	query := fmt.Sprintf("select `type` as `name`, count(*) as `total` from %s "+
+		"where `user_id` = ? and `create_time` >= ? and `create_time` < ? and `delete_time` IS NULL "+
+		"group by `name` order by `total` desc", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userID, from, to)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// CountByProjects 按项目统计表达记录
+func (m *customExpressionsModel) CountByProjects(ctx context.Context, projectIDs []int64) ([]*ProjectCount, error) {
+	if len(projectIDs) == 0 {
+		return nil, ErrNotFound
+	}
+	var resp []*ProjectCount
+	placeholders, args := inArgs(projectIDs)
+	query := fmt.Sprintf("select `project_id`, count(*) as `total` from %s "+
+		"where `project_id` in (%s) and `delete_time` IS NULL group by `project_id`", m.table, placeholders)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// CountByProjectType 按类型统计项目的表达记录
+func (m *customExpressionsModel) CountByProjectType(ctx context.Context, projectID int64) ([]*GroupCount, error) {
+	var resp []*GroupCount
+	query := fmt.Sprintf("select `type` as `name`, count(*) as `total` from %s "+
+		"where `project_id` = ? and `delete_time` IS NULL group by `name`", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, projectID)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByProject 查询项目最近的表达记录，按创建时间倒序
+func (m *customExpressionsModel) FindByProject(ctx context.Context, projectID, limit int64) ([]*Expressions, error) {
+	var resp []*Expressions
+	query := fmt.Sprintf("select %s from %s where `project_id` = ? and `delete_time` IS NULL order by `create_time` desc limit ?", expressionsRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, projectID, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// Search 在用户表达记录的原文和润色内容中全文检索；等待审核或被驳回的润色内容不参与检索，不包含已删除项目中的记录
+func (m *customExpressionsModel) Search(ctx context.Context, userID int64, keyword string, limit int64) ([]*SearchMatch, error) {
+	var resp []*SearchMatch
+	visible := "e.`review_status` not in ('" + ReviewStatusPending + "', '" + ReviewStatusRejected + "')"
+	rawMatch := "match(e.`raw_content`) against (? in natural language mode)"
+	polishedMatch := "match(e.`polished_title`, e.`polished_summary`, e.`polished_formatted`) against (? in natural language mode)"
+	query := fmt.Sprintf("select e.`expression_id` as `entity_id`, e.`project_id`, p.`title` as `project_title`, "+
+		"coalesce(if(%[2]s, e.`polished_title`, null), '') as `title`, "+
+		"concat_ws(' ', e.`raw_content`, if(%[2]s, e.`polished_summary`, null), if(%[2]s, e.`polished_formatted`, null)) as `content`, e.`create_time`, "+
+		"%[3]s + if(%[2]s, %[4]s, 0) as `score` "+
+		"from %[1]s e join `projects` p on p.`project_id` = e.`project_id` and p.`delete_time` IS NULL "+
+		"where e.`user_id` = ? and e.`delete_time` IS NULL and (%[3]s or (%[2]s and %[4]s)) "+
+		"order by `score` desc limit ?", m.table, visible, rawMatch, polishedMatch)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keyword, keyword, userID, keyword, keyword, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// GetPolishedKeyPoints 解析润色后的关键要点JSON
+func (e *Expressions) GetPolishedKeyPoints() ([]string, error) {
+	return unmarshalStrings(e.PolishedKeyPoints)
+}
+
+// SetPolishedKeyPoints 设置润色后的关键要点JSON
+func (e *Expressions) SetPolishedKeyPoints(points []string) error {
+	data, err := marshalField(points)
+	if err != nil {
+		return err
+	}
+	e.PolishedKeyPoints = data
+	return nil
+}
+
+// GetPolishedConcepts 解析润色后的科学概念JSON
+func (e *Expressions) GetPolishedConcepts() ([]string, error) {
+	return unmarshalStrings(e.PolishedConcepts)
+}
+
+// SetPolishedConcepts 设置润色后的科学概念JSON
+func (e *Expressions) SetPolishedConcepts(concepts []string) error {
+	data, err := marshalField(concepts)
+	if err != nil {
+		return err
+	}
+	e.PolishedConcepts = data
+	return nil
+}
+
+// GetPolishedQuestions 解析润色后的疑问JSON
+func (e *Expressions) GetPolishedQuestions() ([]string, error) {
+	return unmarshalStrings(e.PolishedQuestions)
+}
+
+// SetPolishedQuestions 设置润色后的疑问JSON
+func (e *Expressions) SetPolishedQuestions(questions []string) error {
+	data, err := marshalField(questions)
+	if err != nil {
+		return err
+	}
+	e.PolishedQuestions = data
+	return nil
+}
+
+// GetPolishedConnections 解析润色后的关联知识JSON
+func (e *Expressions) GetPolishedConnections() ([]string, error) {
+	return unmarshalStrings(e.PolishedConnections)
+}
+
+// SetPolishedConnections 设置润色后的关联知识JSON
+func (e *Expressions) SetPolishedConnections(connections []string) error {
+	data, err := marshalField(connections)
+	if err != nil {
+		return err
+	}
+	e.PolishedConnections = data
+	return nil
+}
+
+// VisualElement 视觉元素结构
+type VisualElement struct {
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Data        string `json:"data"`
+	Position    string `json:"position"`
+}
+
+// GetPolishedVisuals 解析润色后的视觉元素JSON
+func (e *Expressions) GetPolishedVisuals() ([]VisualElement, error) {
+	values := []VisualElement{}
+	err := unmarshalField(e.PolishedVisuals, &values)
+	return values, err
+}
+
+// SetPolishedVisuals 设置润色后的视觉元素JSON
+func (e *Expressions) SetPolishedVisuals(visuals []VisualElement) error {
+	data, err := marshalField(visuals)
+	if err != nil {
+		return err
+	}
+	e.PolishedVisuals = data
+	return nil
+}
+
+// GetSuggestions 解析改进建议JSON
+func (e *Expressions) GetSuggestions() ([]string, error) {
+	return unmarshalStrings(e.Suggestions)
+}
+
+// SetSuggestions 设置改进建议JSON
+func (e *Expressions) SetSuggestions(suggestions []string) error {
+	data, err := marshalField(suggestions)
+	if err != nil {
+		return err
+	}
+	e.Suggestions = data
+	return nil
+}
+
+// GetKeyLearnings 解析关键学习点JSON
+func (e *Expressions) GetKeyLearnings() ([]string, error) {
+	return unmarshalStrings(e.KeyLearnings)
+}
+
+// SetKeyLearnings 设置关键学习点JSON
+func (e *Expressions) SetKeyLearnings(learnings []string) error {
+	data, err := marshalField(learnings)
+	if err != nil {
+		return err
+	}
+	e.KeyLearnings = data
+	return nil
+}
@@ -43,32 +43,41 @@ type (
 	}
 
 	Expressions struct {
-		Id                  uint64          `db:"id"`                   // 主键ID
-		CreateTime          time.Time       `db:"create_time"`          // 创建时间
-		UpdateTime          time.Time       `db:"update_time"`          // 更新时间
-		DeleteTime          sql.NullTime    `db:"delete_time"`          // 删除时间
-		ExpressionId        int64           `db:"expression_id"`        // 表达记录ID
-		ProjectId           int64           `db:"project_id"`           // 项目ID
-		UserId              int64           `db:"user_id"`              // 用户ID
-		QuestionId          sql.NullInt64   `db:"question_id"`          // 关联的问题ID
-		Type                string          `db:"type"`                 // 类型：speech,text,note
-		RawContent          string          `db:"raw_content"`          // 原始内容
-		Language            string          `db:"language"`             // 语言代码
-		AudioUrl            sql.NullString  `db:"audio_url"`            // 音频URL
-		AudioFormat         sql.NullString  `db:"audio_format"`         // 音频格式：wav,mp3,m4a
-		AudioSize           sql.NullInt64   `db:"audio_size"`           // 音频大小(字节)
-		Duration            sql.NullFloat64 `db:"duration"`             // 音频时长(秒)
-		Confidence          sql.NullFloat64 `db:"confidence"`           // 识别置信度
-		PolishedTitle       sql.NullString  `db:"polished_title"`       // 润色后的标题
-		PolishedSummary     sql.NullString  `db:"polished_summary"`     // 内容总结
-		PolishedKeyPoints   sql.NullString  `db:"polished_key_points"`  // 关键要点JSON数组
-		PolishedConcepts    sql.NullString  `db:"polished_concepts"`    // 科学概念JSON数组
-		PolishedQuestions   sql.NullString  `db:"polished_questions"`   // 引发的疑问JSON数组
-		PolishedConnections sql.NullString  `db:"polished_connections"` // 关联知识JSON数组
-		PolishedVisuals     sql.NullString  `db:"polished_visuals"`     // 视觉元素JSON数组
-		PolishedFormatted   sql.NullString  `db:"polished_formatted"`   // 格式化文本
-		Suggestions         sql.NullString  `db:"suggestions"`          // 改进建议JSON数组
-		KeyLearnings        sql.NullString  `db:"key_learnings"`        // 关键学习点JSON数组
+		Id                    uint64          `db:"id"`                      // 主键ID
+		CreateTime            time.Time       `db:"create_time"`             // 创建时间
+		UpdateTime            time.Time       `db:"update_time"`             // 更新时间
+		DeleteTime            sql.NullTime    `db:"delete_time"`             // 删除时间
+		ExpressionId          int64           `db:"expression_id"`           // 表达记录ID
+		ProjectId             int64           `db:"project_id"`              // 项目ID
+		UserId                int64           `db:"user_id"`                 // 用户ID
+		QuestionId            sql.NullInt64   `db:"question_id"`             // 关联的问题ID
+		Type                  string          `db:"type"`                    // 类型：speech,text,note
+		RawContent            string          `db:"raw_content"`             // 原始内容
+		Language              string          `db:"language"`                // 检测到的主要语言代码
+		MixedLanguage         int64           `db:"mixed_language"`          // 是否中英混合表达
+		AudioUrl              sql.NullString  `db:"audio_url"`               // 音频URL
+		AudioFormat           sql.NullString  `db:"audio_format"`            // 音频格式：wav,mp3,m4a
+		AudioSize             sql.NullInt64   `db:"audio_size"`              // 音频大小(字节)
+		Duration              sql.NullFloat64 `db:"duration"`                // 音频时长(秒)
+		Confidence            sql.NullFloat64 `db:"confidence"`              // 识别置信度
+		PolishedTitle         sql.NullString  `db:"polished_title"`          // 润色后的标题
+		PolishedSummary       sql.NullString  `db:"polished_summary"`        // 内容总结
+		PolishedKeyPoints     sql.NullString  `db:"polished_key_points"`     // 关键要点JSON数组
+		PolishedConcepts      sql.NullString  `db:"polished_concepts"`       // 科学概念JSON数组
+		PolishedQuestions     sql.NullString  `db:"polished_questions"`      // 引发的疑问JSON数组
+		PolishedConnections   sql.NullString  `db:"polished_connections"`    // 关联知识JSON数组
+		PolishedVisuals       sql.NullString  `db:"polished_visuals"`        // 视觉元素JSON数组
+		PolishedFormatted     sql.NullString  `db:"polished_formatted"`      // 格式化文本
+		Suggestions           sql.NullString  `db:"suggestions"`             // 改进建议JSON数组
+		KeyLearnings          sql.NullString  `db:"key_learnings"`           // 关键学习点JSON数组
+		TranslationLanguage   sql.NullString  `db:"translation_language"`    // 平行翻译的目标语言
+		PolishedTranslation   sql.NullString  `db:"polished_translation"`    // 润色笔记的平行译文JSON
+		AssessmentTarget      sql.NullString  `db:"assessment_target"`       // 发音练习的目标句
+		AssessmentAccuracy    sql.NullFloat64 `db:"assessment_accuracy"`     // 单词准确率(0-1)
+		AssessmentPace        sql.NullFloat64 `db:"assessment_pace"`         // 语速(每秒词数)
+		AssessmentFillerCount sql.NullInt64   `db:"assessment_filler_count"` // 停顿词数量
+		AssessmentDetail      sql.NullString  `db:"assessment_detail"`       // 逐词评估与反馈JSON
+		ReviewStatus          string          `db:"review_status"`           // 人工审核状态：none,pending,approved,rejected
 	}
 )
 
@@ -135,8 +144,8 @@ func (m *defaultExpressionsModel) Insert(ctx context.Context, data *Expressions)
 	expressionsExpressionIdKey := fmt.Sprintf("%s%v", cacheExpressionsExpressionIdPrefix, data.ExpressionId)
 	expressionsIdKey := fmt.Sprintf("%s%v", cacheExpressionsIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, expressionsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.DeleteTime, data.ExpressionId, data.ProjectId, data.UserId, data.QuestionId, data.Type, data.RawContent, data.Language, data.AudioUrl, data.AudioFormat, data.AudioSize, data.Duration, data.Confidence, data.PolishedTitle, data.PolishedSummary, data.PolishedKeyPoints, data.PolishedConcepts, data.PolishedQuestions, data.PolishedConnections, data.PolishedVisuals, data.PolishedFormatted, data.Suggestions, data.KeyLearnings)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, expressionsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.DeleteTime, data.ExpressionId, data.ProjectId, data.UserId, data.QuestionId, data.Type, data.RawContent, data.Language, data.MixedLanguage, data.AudioUrl, data.AudioFormat, data.AudioSize, data.Duration, data.Confidence, data.PolishedTitle, data.PolishedSummary, data.PolishedKeyPoints, data.PolishedConcepts, data.PolishedQuestions, data.PolishedConnections, data.PolishedVisuals, data.PolishedFormatted, data.Suggestions, data.KeyLearnings, data.TranslationLanguage, data.PolishedTranslation, data.AssessmentTarget, data.AssessmentAccuracy, data.AssessmentPace, data.AssessmentFillerCount, data.AssessmentDetail, data.ReviewStatus)
 	}, expressionsExpressionIdKey, expressionsIdKey)
 	return ret, err
 }
@@ -151,7 +160,7 @@ func (m *defaultExpressionsModel) Update(ctx context.Context, newData *Expressio
 	expressionsIdKey := fmt.Sprintf("%s%v", cacheExpressionsIdPrefix, data.Id)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, expressionsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.ExpressionId, newData.ProjectId, newData.UserId, newData.QuestionId, newData.Type, newData.RawContent, newData.Language, newData.AudioUrl, newData.AudioFormat, newData.AudioSize, newData.Duration, newData.Confidence, newData.PolishedTitle, newData.PolishedSummary, newData.PolishedKeyPoints, newData.PolishedConcepts, newData.PolishedQuestions, newData.PolishedConnections, newData.PolishedVisuals, newData.PolishedFormatted, newData.Suggestions, newData.KeyLearnings, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.ExpressionId, newData.ProjectId, newData.UserId, newData.QuestionId, newData.Type, newData.RawContent, newData.Language, newData.MixedLanguage, newData.AudioUrl, newData.AudioFormat, newData.AudioSize, newData.Duration, newData.Confidence, newData.PolishedTitle, newData.PolishedSummary, newData.PolishedKeyPoints, newData.PolishedConcepts, newData.PolishedQuestions, newData.PolishedConnections, newData.PolishedVisuals, newData.PolishedFormatted, newData.Suggestions, newData.KeyLearnings, newData.TranslationLanguage, newData.PolishedTranslation, newData.AssessmentTarget, newData.AssessmentAccuracy, newData.AssessmentPace, newData.AssessmentFillerCount, newData.AssessmentDetail, newData.ReviewStatus, newData.Id)
 	}, expressionsExpressionIdKey, expressionsIdKey)
 	return err
 }
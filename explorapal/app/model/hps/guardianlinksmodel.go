@@ -0,0 +1,54 @@
+package hps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 大人与孩子的关系；老师账号关联的孩子关系固定为teacher
+const (
+	GuardianRelationFather   = "father"
+	GuardianRelationMother   = "mother"
+	GuardianRelationGuardian = "guardian"
+	GuardianRelationTeacher  = "teacher"
+)
+
+var _ GuardianLinksModel = (*customGuardianLinksModel)(nil)
+
+type (
+	// GuardianLinksModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customGuardianLinksModel.
+	GuardianLinksModel interface {
+		guardianLinksModel
+		FindByParent(ctx context.Context, parentId int64) ([]*GuardianLinks, error)
+	}
+
+	customGuardianLinksModel struct {
+		*defaultGuardianLinksModel
+	}
+)
+
+// NewGuardianLinksModel returns a model for the database table.
+func NewGuardianLinksModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) GuardianLinksModel {
+	return &customGuardianLinksModel{
+		defaultGuardianLinksModel: newGuardianLinksModel(conn, c, opts...),
+	}
+}
+
+// FindByParent 查询家长关联的全部孩子，按关联先后排序
+func (m *customGuardianLinksModel) FindByParent(ctx context.Context, parentId int64) ([]*GuardianLinks, error) {
+	var resp []*GuardianLinks
+	query := fmt.Sprintf("select %s from %s where `parent_id` = ? and `delete_time` is null order by `id` asc", guardianLinksRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, parentId)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,33 @@
+package hps
+
+import (
+	"database/sql"
+	"encoding/json"
+)
+
+// unmarshalStrings 解析JSON字符串数组字段，字段为空时返回空数组
+func unmarshalStrings(data sql.NullString) ([]string, error) {
+	if !data.Valid || data.String == "" {
+		return []string{}, nil
+	}
+	var values []string
+	err := json.Unmarshal([]byte(data.String), &values)
+	return values, err
+}
+
+// unmarshalField 解析JSON字段，字段为空时v保持不变
+func unmarshalField(data sql.NullString, v any) error {
+	if !data.Valid || data.String == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(data.String), v)
+}
+
+// marshalField 把值序列化为JSON字段
+func marshalField(v any) (sql.NullString, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
@@ -0,0 +1,67 @@
+package hps
+
+import (
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// Models 全部数据表的模型，每个迁移中的表都对应一个字段
+// 新增数据表时用goctl生成模型后在这里加上字段和构造，服务按需取用
+type Models struct {
+	Achievements      AchievementsModel
+	Assignments       AssignmentsModel
+	ClassMembers      ClassMembersModel
+	Classes           ClassesModel
+	Comments          CommentsModel
+	ConceptEdges      ConceptEdgesModel
+	ConceptMentions   ConceptMentionsModel
+	Concepts          ConceptsModel
+	Embeddings        EmbeddingsModel
+	Expressions       ExpressionsModel
+	GuardianLinks     GuardianLinksModel
+	Notifications     NotificationsModel
+	Observations      ObservationsModel
+	ParentalConsents  ParentalConsentsModel
+	ProjectActivities ProjectActivitiesModel
+	ProjectMilestones ProjectMilestonesModel
+	ProjectTemplates  ProjectTemplatesModel
+	Projects          ProjectsModel
+	Questions         QuestionsModel
+	ReviewItems       ReviewItemsModel
+	SafetyAuditLogs   SafetyAuditLogsModel
+	Users             UsersModel
+}
+
+// NewModels 使用同一个连接和缓存配置创建全部数据表的模型
+func NewModels(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *Models {
+	return newModels(sqlc.NewConn(conn, c, opts...))
+}
+
+// newModels 在同一个带缓存的连接上创建全部数据表的模型，事务中用它创建绑定事务的模型
+func newModels(conn sqlc.CachedConn) *Models {
+	return &Models{
+		Achievements:      &customAchievementsModel{defaultAchievementsModel: &defaultAchievementsModel{CachedConn: conn, table: "`achievements`"}},
+		Assignments:       &customAssignmentsModel{defaultAssignmentsModel: &defaultAssignmentsModel{CachedConn: conn, table: "`assignments`"}},
+		ClassMembers:      &customClassMembersModel{defaultClassMembersModel: &defaultClassMembersModel{CachedConn: conn, table: "`class_members`"}},
+		Classes:           &customClassesModel{defaultClassesModel: &defaultClassesModel{CachedConn: conn, table: "`classes`"}},
+		Comments:          &customCommentsModel{defaultCommentsModel: &defaultCommentsModel{CachedConn: conn, table: "`comments`"}},
+		ConceptEdges:      &customConceptEdgesModel{defaultConceptEdgesModel: &defaultConceptEdgesModel{CachedConn: conn, table: "`concept_edges`"}},
+		ConceptMentions:   &customConceptMentionsModel{defaultConceptMentionsModel: &defaultConceptMentionsModel{CachedConn: conn, table: "`concept_mentions`"}},
+		Concepts:          &customConceptsModel{defaultConceptsModel: &defaultConceptsModel{CachedConn: conn, table: "`concepts`"}},
+		Embeddings:        &customEmbeddingsModel{defaultEmbeddingsModel: &defaultEmbeddingsModel{CachedConn: conn, table: "`embeddings`"}},
+		Expressions:       &customExpressionsModel{defaultExpressionsModel: &defaultExpressionsModel{CachedConn: conn, table: "`expressions`"}},
+		GuardianLinks:     &customGuardianLinksModel{defaultGuardianLinksModel: &defaultGuardianLinksModel{CachedConn: conn, table: "`guardian_links`"}},
+		Notifications:     &customNotificationsModel{defaultNotificationsModel: &defaultNotificationsModel{CachedConn: conn, table: "`notifications`"}},
+		Observations:      &customObservationsModel{defaultObservationsModel: &defaultObservationsModel{CachedConn: conn, table: "`observations`"}},
+		ParentalConsents:  &customParentalConsentsModel{defaultParentalConsentsModel: &defaultParentalConsentsModel{CachedConn: conn, table: "`parental_consents`"}},
+		ProjectActivities: &customProjectActivitiesModel{defaultProjectActivitiesModel: &defaultProjectActivitiesModel{CachedConn: conn, table: "`project_activities`"}},
+		ProjectMilestones: &customProjectMilestonesModel{defaultProjectMilestonesModel: &defaultProjectMilestonesModel{CachedConn: conn, table: "`project_milestones`"}},
+		ProjectTemplates:  &customProjectTemplatesModel{defaultProjectTemplatesModel: &defaultProjectTemplatesModel{CachedConn: conn, table: "`project_templates`"}},
+		Projects:          &customProjectsModel{defaultProjectsModel: &defaultProjectsModel{CachedConn: conn, table: "`projects`"}},
+		Questions:         &customQuestionsModel{defaultQuestionsModel: &defaultQuestionsModel{CachedConn: conn, table: "`questions`"}},
+		ReviewItems:       &customReviewItemsModel{defaultReviewItemsModel: &defaultReviewItemsModel{CachedConn: conn, table: "`review_items`"}},
+		SafetyAuditLogs:   &customSafetyAuditLogsModel{defaultSafetyAuditLogsModel: &defaultSafetyAuditLogsModel{CachedConn: conn, table: "`safety_audit_logs`"}},
+		Users:             &customUsersModel{defaultUsersModel: &defaultUsersModel{CachedConn: conn, table: "`users`"}},
+	}
+}
@@ -0,0 +1,109 @@
+package hps
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 通知类型
+const (
+	NotificationTypeComment    = "comment"
+	NotificationTypeAssignment = "assignment"
+)
+
+var _ NotificationsModel = (*customNotificationsModel)(nil)
+
+type (
+	// NotificationsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customNotificationsModel.
+	NotificationsModel interface {
+		notificationsModel
+		FindByUser(ctx context.Context, userID int64, unreadOnly bool, page, pageSize int64) ([]*Notifications, error)
+		CountUnread(ctx context.Context, userID int64) (int64, error)
+		MarkRead(ctx context.Context, userID int64, notificationIDs []int64, readAt time.Time) (int64, error)
+	}
+
+	customNotificationsModel struct {
+		*defaultNotificationsModel
+	}
+)
+
+// NewNotificationsModel returns a model for the database table.
+func NewNotificationsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) NotificationsModel {
+	return &customNotificationsModel{
+		defaultNotificationsModel: newNotificationsModel(conn, c, opts...),
+	}
+}
+
+// FindByUser 分页查询用户的通知，按时间倒序
+func (m *customNotificationsModel) FindByUser(ctx context.Context, userID int64, unreadOnly bool, page, pageSize int64) ([]*Notifications, error) {
+	var resp []*Notifications
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `delete_time` IS NULL", notificationsRows, m.table)
+	if unreadOnly {
+		query += " and `read_time` IS NULL"
+	}
+	query += " order by `create_time` desc, `id` desc limit ?,?"
+
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userID, (page-1)*pageSize, pageSize)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// CountUnread 统计用户的未读通知数
+func (m *customNotificationsModel) CountUnread(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `user_id` = ? and `read_time` IS NULL and `delete_time` IS NULL", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, userID)
+	return count, err
+}
+
+// MarkRead 把用户的未读通知标记为已读，notificationIDs为空时标记全部；返回标记的数量
+func (m *customNotificationsModel) MarkRead(ctx context.Context, userID int64, notificationIDs []int64, readAt time.Time) (int64, error) {
+	// 先查出要标记的记录，更新时一并清除它们的缓存
+	var unread []*Notifications
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `read_time` IS NULL and `delete_time` IS NULL", notificationsRows, m.table)
+	args := []any{userID}
+	if len(notificationIDs) > 0 {
+		query += " and `notification_id` in (" + strings.TrimSuffix(strings.Repeat("?,", len(notificationIDs)), ",") + ")"
+		for _, id := range notificationIDs {
+			args = append(args, id)
+		}
+	}
+	if err := m.QueryRowsNoCacheCtx(ctx, &unread, query, args...); err != nil && err != sqlx.ErrNotFound {
+		return 0, err
+	}
+	if len(unread) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]any, 0, len(unread)+1)
+	ids = append(ids, readAt)
+	keys := make([]string, 0, len(unread)*2)
+	for _, n := range unread {
+		ids = append(ids, n.Id)
+		keys = append(keys,
+			fmt.Sprintf("%s%v", cacheNotificationsIdPrefix, n.Id),
+			fmt.Sprintf("%s%v", cacheNotificationsNotificationIdPrefix, n.NotificationId))
+	}
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `read_time` = ? where `id` in (%s) and `read_time` IS NULL", m.table,
+			strings.TrimSuffix(strings.Repeat("?,", len(unread)), ","))
+		return conn.ExecCtx(ctx, query, ids...)
+	}, keys...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
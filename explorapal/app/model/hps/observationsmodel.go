@@ -1,10 +1,22 @@
 package hps
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
+// ObservationStatus 观察记录状态常量
+const (
+	ObservationStatusUploaded   = "uploaded"   // 已上传，等待识别
+	ObservationStatusRecognized = "recognized" // 已识别
+	ObservationStatusBlocked    = "blocked"    // 未通过图片安全筛查，已隔离，等待人工审核
+	ObservationStatusRejected   = "rejected"   // 人工审核驳回，图片继续留在隔离区
+)
+
 var _ ObservationsModel = (*customObservationsModel)(nil)
 
 type (
@@ -12,6 +24,10 @@ type (
 	// and implement the added methods in customObservationsModel.
 	ObservationsModel interface {
 		observationsModel
+		CountByCategory(ctx context.Context, userID int64, from, to time.Time) ([]*GroupCount, error)
+		CountByProjects(ctx context.Context, projectIDs []int64) ([]*ProjectCount, error)
+		FindByProject(ctx context.Context, projectID, limit int64) ([]*Observations, error)
+		Search(ctx context.Context, userID int64, keyword string, limit int64) ([]*SearchMatch, error)
 	}
 
 	customObservationsModel struct {
@@ -25,3 +41,172 @@ func NewObservationsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Op
 		defaultObservationsModel: newObservationsModel(conn, c, opts...),
 	}
 }
+
+// CountByCategory 统计用户在时间段内识别过的观察记录，按类别分组；未识别出类别的记为空字符串
+func (m *customObservationsModel) CountByCategory(ctx context.Context, userID int64, from, to time.Time) ([]*GroupCount, error) {
+	var resp []*GroupCount
+	query := fmt.Sprintf("select COALESCE(`category`, '') as `name`, count(*) as `total` from %s "+
+		"where `user_id` = ? and `create_time` >= ? and `create_time` < ? and `status` = 'recognized' and `delete_time` IS NULL "+
+		"group by `name` order by `total` desc", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userID, from, to)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// CountByProjects 按项目统计识别过的观察记录
+func (m *customObservationsModel) CountByProjects(ctx context.Context, projectIDs []int64) ([]*ProjectCount, error) {
+	if len(projectIDs) == 0 {
+		return nil, ErrNotFound
+	}
+	var resp []*ProjectCount
+	placeholders, args := inArgs(projectIDs)
+	query := fmt.Sprintf("select `project_id`, count(*) as `total` from %s "+
+		"where `project_id` in (%s) and `status` = 'recognized' and `delete_time` IS NULL group by `project_id`", m.table, placeholders)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByProject 查询项目最近的观察记录，按创建时间倒序
+func (m *customObservationsModel) FindByProject(ctx context.Context, projectID, limit int64) ([]*Observations, error) {
+	var resp []*Observations
+	query := fmt.Sprintf("select %s from %s where `project_id` = ? and `delete_time` IS NULL order by `create_time` desc limit ?", observationsRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, projectID, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// Search 在用户观察记录的识别对象、学名和描述中全文检索，不包含被拦截的图片、对孩子隐藏的识别结果和已删除项目中的记录
+func (m *customObservationsModel) Search(ctx context.Context, userID int64, keyword string, limit int64) ([]*SearchMatch, error) {
+	var resp []*SearchMatch
+	query := fmt.Sprintf("select o.`observation_id` as `entity_id`, o.`project_id`, p.`title` as `project_title`, coalesce(o.`object_name`, '') as `title`, "+
+		"concat_ws(' ', o.`scientific_name`, o.`description`) as `content`, o.`create_time`, "+
+		"match(o.`object_name`, o.`scientific_name`, o.`description`) against (? in natural language mode) as `score` "+
+		"from %s o join `projects` p on p.`project_id` = o.`project_id` and p.`delete_time` IS NULL "+
+		"where o.`user_id` = ? and o.`delete_time` IS NULL and o.`status` not in (?, ?) and o.`review_status` not in (?, ?) "+
+		"and match(o.`object_name`, o.`scientific_name`, o.`description`) against (? in natural language mode) "+
+		"order by `score` desc limit ?", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keyword, userID, ObservationStatusBlocked, ObservationStatusRejected,
+		ReviewStatusPending, ReviewStatusRejected, keyword, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// Quarantined 图片是否在隔离区中（等待审核或已被驳回），隔离中的图片孩子看不到
+func (o *Observations) Quarantined() bool {
+	return o.Status == ObservationStatusBlocked || o.Status == ObservationStatusRejected
+}
+
+// RecognitionHidden 识别结果是否对孩子隐藏（等待审核或已被驳回）
+func (o *Observations) RecognitionHidden() bool {
+	return o.ReviewStatus == ReviewStatusPending || o.ReviewStatus == ReviewStatusRejected
+}
+
+// GetKeyFeatures 解析关键特征JSON
+func (o *Observations) GetKeyFeatures() ([]string, error) {
+	return unmarshalStrings(o.KeyFeatures)
+}
+
+// SetKeyFeatures 设置关键特征JSON
+func (o *Observations) SetKeyFeatures(features []string) error {
+	data, err := marshalField(features)
+	if err != nil {
+		return err
+	}
+	o.KeyFeatures = data
+	return nil
+}
+
+// GetSuggestions 解析AI建议JSON
+func (o *Observations) GetSuggestions() ([]string, error) {
+	return unmarshalStrings(o.Suggestions)
+}
+
+// SetSuggestions 设置AI建议JSON
+func (o *Observations) SetSuggestions(suggestions []string) error {
+	data, err := marshalField(suggestions)
+	if err != nil {
+		return err
+	}
+	o.Suggestions = data
+	return nil
+}
+
+// GetInterestingFacts 解析有趣事实JSON
+func (o *Observations) GetInterestingFacts() ([]string, error) {
+	return unmarshalStrings(o.InterestingFacts)
+}
+
+// SetInterestingFacts 设置有趣事实JSON
+func (o *Observations) SetInterestingFacts(facts []string) error {
+	data, err := marshalField(facts)
+	if err != nil {
+		return err
+	}
+	o.InterestingFacts = data
+	return nil
+}
+
+// ARInformation AR信息结构
+type ARInformation struct {
+	Hotspots []ARHotspot `json:"hotspots"`
+	Labels   []ARLabel   `json:"labels"`
+}
+
+// ARHotspot AR热点
+type ARHotspot struct {
+	X       float64 `json:"x"`
+	Y       float64 `json:"y"`
+	Title   string  `json:"title"`
+	Content string  `json:"content"`
+	Type    string  `json:"type"`
+}
+
+// ARLabel AR标签
+type ARLabel struct {
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	Text  string  `json:"text"`
+	Color string  `json:"color"`
+}
+
+// GetARInfo 解析AR信息JSON
+func (o *Observations) GetARInfo() (*ARInformation, error) {
+	value := &ARInformation{}
+	err := unmarshalField(o.ArInfo, value)
+	return value, err
+}
+
+// SetARInfo 设置AR信息JSON
+func (o *Observations) SetARInfo(arInfo *ARInformation) error {
+	data, err := marshalField(arInfo)
+	if err != nil {
+		return err
+	}
+	o.ArInfo = data
+	return nil
+}
@@ -54,6 +54,9 @@ type (
 		ImageName        sql.NullString  `db:"image_name"`        // 图片名称
 		ImageType        sql.NullString  `db:"image_type"`        // 图片类型：jpeg,png,jpg
 		ImageSize        sql.NullInt64   `db:"image_size"`        // 图片大小(字节)
+		Status           string          `db:"status"`            // 状态：uploaded,recognized,blocked
+		QuarantineKey    sql.NullString  `db:"quarantine_key"`    // 隔离区存储路径
+		ScreeningDetail  sql.NullString  `db:"screening_detail"`  // 图片安全筛查结果JSON
 		ObjectName       sql.NullString  `db:"object_name"`       // 识别对象名称
 		Category         sql.NullString  `db:"category"`          // 类别
 		Confidence       sql.NullFloat64 `db:"confidence"`        // 置信度
@@ -63,6 +66,7 @@ type (
 		ArInfo           sql.NullString  `db:"ar_info"`           // AR信息JSON
 		Suggestions      sql.NullString  `db:"suggestions"`       // AI建议JSON数组
 		InterestingFacts sql.NullString  `db:"interesting_facts"` // 有趣事实JSON数组
+		ReviewStatus     string          `db:"review_status"`     // 识别结果的人工审核状态：none,pending,approved,rejected
 	}
 )
 
@@ -129,8 +133,8 @@ func (m *defaultObservationsModel) Insert(ctx context.Context, data *Observation
 	observationsIdKey := fmt.Sprintf("%s%v", cacheObservationsIdPrefix, data.Id)
 	observationsObservationIdKey := fmt.Sprintf("%s%v", cacheObservationsObservationIdPrefix, data.ObservationId)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, observationsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.DeleteTime, data.ObservationId, data.ProjectId, data.UserId, data.ImageUrl, data.ImageName, data.ImageType, data.ImageSize, data.ObjectName, data.Category, data.Confidence, data.Description, data.KeyFeatures, data.ScientificName, data.ArInfo, data.Suggestions, data.InterestingFacts)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, observationsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.DeleteTime, data.ObservationId, data.ProjectId, data.UserId, data.ImageUrl, data.ImageName, data.ImageType, data.ImageSize, data.Status, data.QuarantineKey, data.ScreeningDetail, data.ObjectName, data.Category, data.Confidence, data.Description, data.KeyFeatures, data.ScientificName, data.ArInfo, data.Suggestions, data.InterestingFacts, data.ReviewStatus)
 	}, observationsIdKey, observationsObservationIdKey)
 	return ret, err
 }
@@ -145,7 +149,7 @@ func (m *defaultObservationsModel) Update(ctx context.Context, newData *Observat
 	observationsObservationIdKey := fmt.Sprintf("%s%v", cacheObservationsObservationIdPrefix, data.ObservationId)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, observationsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.ObservationId, newData.ProjectId, newData.UserId, newData.ImageUrl, newData.ImageName, newData.ImageType, newData.ImageSize, newData.ObjectName, newData.Category, newData.Confidence, newData.Description, newData.KeyFeatures, newData.ScientificName, newData.ArInfo, newData.Suggestions, newData.InterestingFacts, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.ObservationId, newData.ProjectId, newData.UserId, newData.ImageUrl, newData.ImageName, newData.ImageType, newData.ImageSize, newData.Status, newData.QuarantineKey, newData.ScreeningDetail, newData.ObjectName, newData.Category, newData.Confidence, newData.Description, newData.KeyFeatures, newData.ScientificName, newData.ArInfo, newData.Suggestions, newData.InterestingFacts, newData.ReviewStatus, newData.Id)
 	}, observationsIdKey, observationsObservationIdKey)
 	return err
 }
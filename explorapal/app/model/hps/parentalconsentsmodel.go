@@ -0,0 +1,46 @@
+package hps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ParentalConsentsModel = (*customParentalConsentsModel)(nil)
+
+type (
+	// ParentalConsentsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customParentalConsentsModel.
+	ParentalConsentsModel interface {
+		parentalConsentsModel
+		FindLatest(ctx context.Context, childId int64) (*ParentalConsents, error)
+	}
+
+	customParentalConsentsModel struct {
+		*defaultParentalConsentsModel
+	}
+)
+
+// NewParentalConsentsModel returns a model for the database table.
+func NewParentalConsentsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ParentalConsentsModel {
+	return &customParentalConsentsModel{
+		defaultParentalConsentsModel: newParentalConsentsModel(conn, c, opts...),
+	}
+}
+
+// FindLatest 查询孩子当前生效的同意记录，即最近一次同意或变更
+func (m *customParentalConsentsModel) FindLatest(ctx context.Context, childId int64) (*ParentalConsents, error) {
+	var resp ParentalConsents
+	query := fmt.Sprintf("select %s from %s where `child_id` = ? and `delete_time` is null order by `consent_time` desc, `id` desc limit 1", parentalConsentsRows, m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, childId)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
@@ -1,10 +1,34 @@
 package hps
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
+// ActivityType 活动类型常量
+const (
+	ActivityTypeCreateProject       = "create_project"
+	ActivityTypeUploadImage         = "upload_image"
+	ActivityTypeRecognizeImage      = "recognize_image"
+	ActivityTypeGenerateQuestions   = "generate_questions"
+	ActivityTypeSelectQuestion      = "select_question"
+	ActivityTypeSpeechToText        = "speech_to_text"
+	ActivityTypePolishNote          = "polish_note"
+	ActivityTypeGenerateReport      = "generate_report"
+	ActivityTypeGenerateDocumentary = "generate_documentary"
+	ActivityTypeGeneratePoster      = "generate_poster"
+	ActivityTypeComment             = "comment"
+	ActivityTypeAssignProject       = "assign_project"
+	ActivityTypeStageReached        = "stage_reached"
+	ActivityTypeMilestoneCompleted  = "milestone_completed"
+	ActivityTypeCompleteProject     = "complete_project"
+	ActivityTypeChangeStatus        = "change_status"
+)
+
 var _ ProjectActivitiesModel = (*customProjectActivitiesModel)(nil)
 
 type (
@@ -12,11 +36,21 @@ type (
 	// and implement the added methods in customProjectActivitiesModel.
 	ProjectActivitiesModel interface {
 		projectActivitiesModel
+		CountLanguageUsage(ctx context.Context, userID int64, since time.Time) ([]*LanguageUsage, error)
+		CountByHour(ctx context.Context, userID int64, from, to time.Time) ([]*HourCount, error)
+		FindByUserRange(ctx context.Context, userID int64, from, to time.Time, limit int64) ([]*ProjectActivities, error)
+		FindByProject(ctx context.Context, projectID, limit int64) ([]*ProjectActivities, error)
 	}
 
 	customProjectActivitiesModel struct {
 		*defaultProjectActivitiesModel
 	}
+
+	// LanguageUsage 按语言统计的表达次数
+	LanguageUsage struct {
+		Language string `db:"language"` // 语言代码
+		Total    int64  `db:"total"`    // 使用次数
+	}
 )
 
 // NewProjectActivitiesModel returns a model for the database table.
@@ -25,3 +59,68 @@ func NewProjectActivitiesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cac
 		defaultProjectActivitiesModel: newProjectActivitiesModel(conn, c, opts...),
 	}
 }
+
+// CountLanguageUsage 统计用户语音和笔记活动中各语言的使用次数（读取metadata中的language字段）
+func (m *customProjectActivitiesModel) CountLanguageUsage(ctx context.Context, userID int64, since time.Time) ([]*LanguageUsage, error) {
+	var resp []*LanguageUsage
+	query := fmt.Sprintf("select JSON_UNQUOTE(JSON_EXTRACT(`metadata`, '$.language')) as `language`, count(*) as `total` from %s "+
+		"where `user_id` = ? and `type` in (?, ?) and `create_time` >= ? and `delete_time` IS NULL and JSON_VALID(`metadata`) "+
+		"group by `language` having `language` IS NOT NULL order by `total` desc", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userID, ActivityTypeSpeechToText, ActivityTypePolishNote, since)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// CountByHour 统计用户在时间段内每个小时的活动次数
+func (m *customProjectActivitiesModel) CountByHour(ctx context.Context, userID int64, from, to time.Time) ([]*HourCount, error) {
+	var resp []*HourCount
+	query := fmt.Sprintf("select HOUR(`create_time`) as `hour`, count(*) as `total` from %s "+
+		"where `user_id` = ? and `create_time` >= ? and `create_time` < ? and `delete_time` IS NULL "+
+		"group by `hour` order by `hour`", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userID, from, to)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByUserRange 查询用户在时间段内的活动，按时间倒序
+func (m *customProjectActivitiesModel) FindByUserRange(ctx context.Context, userID int64, from, to time.Time, limit int64) ([]*ProjectActivities, error) {
+	var resp []*ProjectActivities
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `create_time` >= ? and `create_time` < ? and `delete_time` IS NULL "+
+		"order by `create_time` desc limit ?", projectActivitiesRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userID, from, to, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByProject 查询项目最近的活动，按创建时间倒序
+func (m *customProjectActivitiesModel) FindByProject(ctx context.Context, projectID, limit int64) ([]*ProjectActivities, error) {
+	var resp []*ProjectActivities
+	query := fmt.Sprintf("select %s from %s where `project_id` = ? and `delete_time` IS NULL order by `create_time` desc limit ?", projectActivitiesRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, projectID, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
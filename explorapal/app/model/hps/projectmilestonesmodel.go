@@ -0,0 +1,73 @@
+package hps
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 里程碑所属的探索阶段：观察 → 提问 → 表达 → 成果
+const (
+	MilestoneStageObserve     = "observe"
+	MilestoneStageQuestion    = "question"
+	MilestoneStageExpress     = "express"
+	MilestoneStageAchievement = "achievement"
+)
+
+var _ ProjectMilestonesModel = (*customProjectMilestonesModel)(nil)
+
+type (
+	// ProjectMilestonesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customProjectMilestonesModel.
+	ProjectMilestonesModel interface {
+		projectMilestonesModel
+		FindByProject(ctx context.Context, projectID int64) ([]*ProjectMilestones, error)
+		MarkCompleted(ctx context.Context, data *ProjectMilestones, completedAt time.Time) (bool, error)
+	}
+
+	customProjectMilestonesModel struct {
+		*defaultProjectMilestonesModel
+	}
+)
+
+// NewProjectMilestonesModel returns a model for the database table.
+func NewProjectMilestonesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ProjectMilestonesModel {
+	return &customProjectMilestonesModel{
+		defaultProjectMilestonesModel: newProjectMilestonesModel(conn, c, opts...),
+	}
+}
+
+// FindByProject 按顺序查询项目的里程碑
+func (m *customProjectMilestonesModel) FindByProject(ctx context.Context, projectID int64) ([]*ProjectMilestones, error) {
+	var resp []*ProjectMilestones
+	query := fmt.Sprintf("select %s from %s where `project_id` = ? order by `seq` asc", projectMilestonesRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, projectID)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// MarkCompleted 把未完成的里程碑标记为完成；里程碑已经完成时返回false
+func (m *customProjectMilestonesModel) MarkCompleted(ctx context.Context, data *ProjectMilestones, completedAt time.Time) (bool, error) {
+	projectMilestonesIdKey := fmt.Sprintf("%s%v", cacheProjectMilestonesIdPrefix, data.Id)
+	projectMilestonesMilestoneIdKey := fmt.Sprintf("%s%v", cacheProjectMilestonesMilestoneIdPrefix, data.MilestoneId)
+	projectMilestonesProjectIdSeqKey := fmt.Sprintf("%s%v:%v", cacheProjectMilestonesProjectIdSeqPrefix, data.ProjectId, data.Seq)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `completed_time` = ? where `id` = ? and `completed_time` IS NULL", m.table)
+		return conn.ExecCtx(ctx, query, completedAt, data.Id)
+	}, projectMilestonesIdKey, projectMilestonesMilestoneIdKey, projectMilestonesProjectIdSeqKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
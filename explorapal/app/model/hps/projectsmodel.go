@@ -3,7 +3,12 @@ package hps
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -11,15 +16,71 @@ import (
 
 var _ ProjectsModel = (*customProjectsModel)(nil)
 
+// 项目列表的排序字段
+const (
+	ProjectSortCreateTime   = "create_time"
+	ProjectSortLastActivity = "last_activity_at"
+	ProjectSortProgress     = "progress"
+)
+
+// 排序字段对应的排序表达式，没有活动过的项目按创建时间排序
+var projectSortExprs = map[string]string{
+	ProjectSortCreateTime:   "`create_time`",
+	ProjectSortLastActivity: "coalesce(`last_activity_at`, `create_time`)",
+	ProjectSortProgress:     "`progress`",
+}
+
+// 游标中时间排序值的格式，与数据库中的datetime一致
+const projectCursorTimeLayout = "2006-01-02 15:04:05"
+
+var ErrInvalidCursor = errors.New("分页游标不正确")
+
+// 项目编码冲突时最多尝试的次数
+const projectCodeAttempts = 3
+
+// ProjectListQuery 项目列表的查询条件，各条件同时满足
+type ProjectListQuery struct {
+	UserID   int64
+	Category string
+	// Statuses 为空时不限状态
+	Statuses []string
+	// Tags 项目需要包含全部标签
+	Tags []string
+	// Keyword 在标题和描述中查找
+	Keyword string
+	// SortBy 为空时按创建时间排序
+	SortBy string
+	Asc    bool
+	// Cursor 不为空时从游标之后开始查询，忽略Page
+	Cursor   *ProjectCursor
+	Page     int64
+	PageSize int64
+}
+
+// ProjectCursor 游标分页的位置：上一页的排序字段和方向，以及最后一个项目的排序值和主键
+type ProjectCursor struct {
+	SortBy string `json:"s"`
+	Order  string `json:"o"` // asc或desc
+	Value  string `json:"v"`
+	Id     uint64 `json:"id"`
+}
+
 type (
 	// ProjectsModel is an interface to be customized, add more methods here,
 	// and implement the added methods in customProjectsModel.
 	ProjectsModel interface {
 		projectsModel
-		FindByUserID(ctx context.Context, userID int64, page, pageSize int64) ([]*Projects, error)
-		FindByCategory(ctx context.Context, userID int64, category string, page, pageSize int64) ([]*Projects, error)
+		InsertWithCode(ctx context.Context, data *Projects, newCode func() string) (sql.Result, error)
+		FindOneOwned(ctx context.Context, projectID, userID int64) (*Projects, error)
+		FindList(ctx context.Context, q *ProjectListQuery) ([]*Projects, int64, *ProjectCursor, error)
 		UpdateProgress(ctx context.Context, projectID int64, progress int32) error
 		UpdateLastActivity(ctx context.Context, projectID int64) error
+		UpdateStatus(ctx context.Context, project *Projects, status string) (bool, error)
+		MarkStageReached(ctx context.Context, project *Projects, stage string) (bool, error)
+		CountCreated(ctx context.Context, userID int64, from, to time.Time) (int64, error)
+		CountCompleted(ctx context.Context, userID int64, from, to time.Time) (int64, error)
+		FindByAssignments(ctx context.Context, assignmentIDs []int64) ([]*Projects, error)
+		Search(ctx context.Context, userID int64, keyword string, limit int64) ([]*SearchMatch, error)
 	}
 
 	customProjectsModel struct {
@@ -34,13 +95,87 @@ func NewProjectsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option
 	}
 }
 
-func (m *customProjectsModel) FindByUserID(ctx context.Context, userID int64, page, pageSize int64) ([]*Projects, error) {
-	var resp []*Projects
-	offset := (page - 1) * pageSize
+// InsertWithCode 插入项目，项目编码与已有项目冲突时用newCode重新生成编码后重试
+// 可以在事务中使用：MySQL的唯一键冲突只回滚出错的语句，事务中已执行的写入不受影响
+func (m *customProjectsModel) InsertWithCode(ctx context.Context, data *Projects, newCode func() string) (sql.Result, error) {
+	for attempt := 1; ; attempt++ {
+		result, err := m.Insert(ctx, data)
+		if err == nil || attempt == projectCodeAttempts || !isDuplicateKey(err, "idx_project_code") {
+			return result, err
+		}
+		data.ProjectCode = newCode()
+	}
+}
+
+// FindOneOwned 查询属于该用户的未删除项目，项目不存在或不属于该用户时都返回ErrNotFound
+func (m *customProjectsModel) FindOneOwned(ctx context.Context, projectID, userID int64) (*Projects, error) {
+	project, err := m.FindOneByProjectId(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.UserId != userID || project.DeleteTime.Valid {
+		return nil, ErrNotFound
+	}
+	return project, nil
+}
+
+func (m *customProjectsModel) UpdateProgress(ctx context.Context, projectID int64, progress int32) error {
+	data, err := m.FindOneByProjectId(ctx, projectID)
+	if err != nil {
+		return err
+	}
 
-	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `delete_time` IS NULL order by `create_time` desc limit ?,?",
-		projectsRows, m.table)
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userID, offset, pageSize)
+	projectsIdKey := fmt.Sprintf("%s%v", cacheProjectsIdPrefix, data.Id)
+	projectsProjectCodeKey := fmt.Sprintf("%s%v", cacheProjectsProjectCodePrefix, data.ProjectCode)
+	projectsProjectIdKey := fmt.Sprintf("%s%v", cacheProjectsProjectIdPrefix, data.ProjectId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set `progress` = ? where `project_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, progress, projectID)
+	}, projectsIdKey, projectsProjectCodeKey, projectsProjectIdKey)
+	return err
+}
+
+func (m *customProjectsModel) UpdateLastActivity(ctx context.Context, projectID int64) error {
+	data, err := m.FindOneByProjectId(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	projectsIdKey := fmt.Sprintf("%s%v", cacheProjectsIdPrefix, data.Id)
+	projectsProjectCodeKey := fmt.Sprintf("%s%v", cacheProjectsProjectCodePrefix, data.ProjectCode)
+	projectsProjectIdKey := fmt.Sprintf("%s%v", cacheProjectsProjectIdPrefix, data.ProjectId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set `last_activity_at` = now() where `project_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, projectID)
+	}, projectsIdKey, projectsProjectCodeKey, projectsProjectIdKey)
+	return err
+}
+
+// CountCreated 统计用户在时间段内开始的项目数
+func (m *customProjectsModel) CountCreated(ctx context.Context, userID int64, from, to time.Time) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `user_id` = ? and `create_time` >= ? and `create_time` < ? and `delete_time` IS NULL", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, userID, from, to)
+	return count, err
+}
+
+// CountCompleted 统计用户在时间段内完成的项目数，以完成状态的最后更新时间为完成时间
+func (m *customProjectsModel) CountCompleted(ctx context.Context, userID int64, from, to time.Time) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `user_id` = ? and `status` = 'completed' and `update_time` >= ? and `update_time` < ? and `delete_time` IS NULL", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, userID, from, to)
+	return count, err
+}
+
+// FindByAssignments 查询作业布置给学生的项目
+func (m *customProjectsModel) FindByAssignments(ctx context.Context, assignmentIDs []int64) ([]*Projects, error) {
+	if len(assignmentIDs) == 0 {
+		return nil, ErrNotFound
+	}
+	var resp []*Projects
+	placeholders, args := inArgs(assignmentIDs)
+	query := fmt.Sprintf("select %s from %s where `assignment_id` in (%s) and `delete_time` IS NULL", projectsRows, m.table, placeholders)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
 	switch err {
 	case nil:
 		return resp, nil
@@ -51,13 +186,170 @@ func (m *customProjectsModel) FindByUserID(ctx context.Context, userID int64, pa
 	}
 }
 
-func (m *customProjectsModel) FindByCategory(ctx context.Context, userID int64, category string, page, pageSize int64) ([]*Projects, error) {
+// UpdateStatus 仅当项目仍是读取时的状态时更新状态，避免并发修改互相覆盖；状态已被修改时返回false
+func (m *customProjectsModel) UpdateStatus(ctx context.Context, project *Projects, status string) (bool, error) {
+	projectsIdKey := fmt.Sprintf("%s%v", cacheProjectsIdPrefix, project.Id)
+	projectsProjectCodeKey := fmt.Sprintf("%s%v", cacheProjectsProjectCodePrefix, project.ProjectCode)
+	projectsProjectIdKey := fmt.Sprintf("%s%v", cacheProjectsProjectIdPrefix, project.ProjectId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table)
+		return conn.ExecCtx(ctx, query, status, project.Id, project.Status)
+	}, projectsIdKey, projectsProjectCodeKey, projectsProjectIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// MarkStageReached 把探索阶段加入项目已达成的阶段，返回本次是否从未达成变为达成
+// 只在阶段不在列表中时更新，并发记录同一个阶段时只有一个返回true
+func (m *customProjectsModel) MarkStageReached(ctx context.Context, project *Projects, stage string) (bool, error) {
+	projectsIdKey := fmt.Sprintf("%s%v", cacheProjectsIdPrefix, project.Id)
+	projectsProjectCodeKey := fmt.Sprintf("%s%v", cacheProjectsProjectCodePrefix, project.ProjectCode)
+	projectsProjectIdKey := fmt.Sprintf("%s%v", cacheProjectsProjectIdPrefix, project.ProjectId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `reached_stages` = concat_ws(',', nullif(`reached_stages`, ''), ?) "+
+			"where `id` = ? and find_in_set(?, `reached_stages`) = 0", m.table)
+		return conn.ExecCtx(ctx, query, stage, project.Id, stage)
+	}, projectsIdKey, projectsProjectCodeKey, projectsProjectIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// FindList 按条件查询项目列表，同时返回符合条件的项目总数；还有下一页时返回下一页的游标
+func (m *customProjectsModel) FindList(ctx context.Context, q *ProjectListQuery) ([]*Projects, int64, *ProjectCursor, error) {
+	sortBy := q.SortBy
+	if sortBy == "" {
+		sortBy = ProjectSortCreateTime
+	}
+	expr, ok := projectSortExprs[sortBy]
+	if !ok {
+		return nil, 0, nil, fmt.Errorf("不支持的排序字段: %s", sortBy)
+	}
+
+	where, args := q.where()
+	var total int64
+	countQuery := fmt.Sprintf("select count(*) from %s where %s", m.table, where)
+	if err := m.QueryRowNoCacheCtx(ctx, &total, countQuery, args...); err != nil {
+		return nil, 0, nil, err
+	}
+	if total == 0 {
+		return []*Projects{}, 0, nil, nil
+	}
+
+	order, cmp := "desc", "<"
+	if q.Asc {
+		order, cmp = "asc", ">"
+	}
+	offset := (q.Page - 1) * q.PageSize
+	if q.Cursor != nil {
+		// 游标只能用于生成它的排序字段和方向，否则会跳过或重复项目
+		if q.Cursor.SortBy != sortBy || q.Cursor.Order != order {
+			return nil, 0, nil, ErrInvalidCursor
+		}
+		where += fmt.Sprintf(" and (%s %s ? or (%s = ? and `id` %s ?))", expr, cmp, expr, cmp)
+		args = append(args, q.Cursor.Value, q.Cursor.Value, q.Cursor.Id)
+		offset = 0
+	}
+
+	// 多查一条判断是否还有下一页
 	var resp []*Projects
-	offset := (page - 1) * pageSize
+	query := fmt.Sprintf("select %s from %s where %s order by %s %s, `id` %s limit ?,?",
+		projectsRows, m.table, where, expr, order, order)
+	args = append(args, offset, q.PageSize+1)
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...); err != nil {
+		return nil, 0, nil, err
+	}
+
+	var next *ProjectCursor
+	if int64(len(resp)) > q.PageSize {
+		resp = resp[:q.PageSize]
+		last := resp[len(resp)-1]
+		next = &ProjectCursor{
+			SortBy: sortBy,
+			Order:  order,
+			Value:  last.sortValue(sortBy),
+			Id:     last.Id,
+		}
+	}
+	return resp, total, next, nil
+}
 
-	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `category` = ? and `delete_time` IS NULL order by `create_time` desc limit ?,?",
-		projectsRows, m.table)
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userID, category, offset, pageSize)
+// where 列表查询条件，不包含分页
+func (q *ProjectListQuery) where() (string, []any) {
+	conds := []string{"`user_id` = ?", "`delete_time` IS NULL"}
+	args := []any{q.UserID}
+	if q.Category != "" {
+		conds = append(conds, "`category` = ?")
+		args = append(args, q.Category)
+	}
+	if len(q.Statuses) > 0 {
+		conds = append(conds, fmt.Sprintf("`status` in (%s)", strings.TrimSuffix(strings.Repeat("?,", len(q.Statuses)), ",")))
+		for _, status := range q.Statuses {
+			args = append(args, status)
+		}
+	}
+	// 标签保存为JSON数组，早期数据可能不是合法的JSON，按空数组处理
+	for _, tag := range q.Tags {
+		data, _ := json.Marshal(tag)
+		conds = append(conds, "JSON_CONTAINS(IF(JSON_VALID(`tags`), `tags`, '[]'), ?)")
+		args = append(args, string(data))
+	}
+	if q.Keyword != "" {
+		like := "%" + escapeLike(q.Keyword) + "%"
+		conds = append(conds, "(`title` like ? or `description` like ?)")
+		args = append(args, like, like)
+	}
+	return strings.Join(conds, " and "), args
+}
+
+// sortValue 项目在排序字段上的值，用于生成游标
+func (p *Projects) sortValue(sortBy string) string {
+	switch sortBy {
+	case ProjectSortLastActivity:
+		if p.LastActivityAt.Valid {
+			return p.LastActivityAt.Time.Format(projectCursorTimeLayout)
+		}
+		return p.CreateTime.Format(projectCursorTimeLayout)
+	case ProjectSortProgress:
+		return strconv.FormatInt(p.Progress, 10)
+	default:
+		return p.CreateTime.Format(projectCursorTimeLayout)
+	}
+}
+
+// GetTags 解析标签JSON
+func (p *Projects) GetTags() ([]string, error) {
+	return unmarshalStrings(p.Tags)
+}
+
+// SetTags 设置标签JSON
+func (p *Projects) SetTags(tags []string) error {
+	data, err := marshalField(tags)
+	if err != nil {
+		return err
+	}
+	p.Tags = data
+	return nil
+}
+
+// escapeLike 转义like查询中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// Search 在用户未删除项目的标题和描述中全文检索
+func (m *customProjectsModel) Search(ctx context.Context, userID int64, keyword string, limit int64) ([]*SearchMatch, error) {
+	var resp []*SearchMatch
+	query := fmt.Sprintf("select `project_id` as `entity_id`, `project_id`, `title` as `project_title`, `title`, coalesce(`description`, '') as `content`, `create_time`, "+
+		"match(`title`, `description`) against (? in natural language mode) as `score` "+
+		"from %s where `user_id` = ? and `delete_time` IS NULL and match(`title`, `description`) against (? in natural language mode) "+
+		"order by `score` desc limit ?", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keyword, userID, keyword, limit)
 	switch err {
 	case nil:
 		return resp, nil
@@ -67,21 +359,3 @@ func (m *customProjectsModel) FindByCategory(ctx context.Context, userID int64,
 		return nil, err
 	}
 }
-
-func (m *customProjectsModel) UpdateProgress(ctx context.Context, projectID int64, progress int32) error {
-	projectsProjectIdKey := fmt.Sprintf("%s%v", cacheProjectsProjectIdPrefix, projectID)
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set `progress` = ? where `project_id` = ?", m.table)
-		return conn.ExecCtx(ctx, query, progress, projectID)
-	}, projectsProjectIdKey)
-	return err
-}
-
-func (m *customProjectsModel) UpdateLastActivity(ctx context.Context, projectID int64) error {
-	projectsProjectIdKey := fmt.Sprintf("%s%v", cacheProjectsProjectIdPrefix, projectID)
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set `last_activity_at` = now() where `project_id` = ?", m.table)
-		return conn.ExecCtx(ctx, query, projectID)
-	}, projectsProjectIdKey)
-	return err
-}
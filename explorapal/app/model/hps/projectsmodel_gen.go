@@ -55,10 +55,13 @@ type (
 		Title          string         `db:"title"`            // 项目标题
 		Description    sql.NullString `db:"description"`      // 项目描述
 		Category       string         `db:"category"`         // 项目类别
-		Status         string         `db:"status"`           // 状态：active,completed,paused
+		Status         string         `db:"status"`           // 状态：active,paused,completed,archived
 		Progress       int64          `db:"progress"`         // 进度百分比(0-100)
+		ReachedStages  string         `db:"reached_stages"`   // 已达成的探索阶段，逗号分隔：observe,question,express,achievement
 		Tags           sql.NullString `db:"tags"`             // 标签JSON数组
 		LastActivityAt sql.NullTime   `db:"last_activity_at"` // 最后活动时间
+		AssignmentId   sql.NullInt64  `db:"assignment_id"`    // 布置该项目的作业ID
+		TemplateId     sql.NullInt64  `db:"template_id"`      // 创建项目使用的模板ID
 	}
 )
 
@@ -147,8 +150,8 @@ func (m *defaultProjectsModel) Insert(ctx context.Context, data *Projects) (sql.
 	projectsProjectCodeKey := fmt.Sprintf("%s%v", cacheProjectsProjectCodePrefix, data.ProjectCode)
 	projectsProjectIdKey := fmt.Sprintf("%s%v", cacheProjectsProjectIdPrefix, data.ProjectId)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, projectsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.DeleteTime, data.ProjectId, data.ProjectCode, data.UserId, data.Title, data.Description, data.Category, data.Status, data.Progress, data.Tags, data.LastActivityAt)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, projectsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.DeleteTime, data.ProjectId, data.ProjectCode, data.UserId, data.Title, data.Description, data.Category, data.Status, data.Progress, data.ReachedStages, data.Tags, data.LastActivityAt, data.AssignmentId, data.TemplateId)
 	}, projectsIdKey, projectsProjectCodeKey, projectsProjectIdKey)
 	return ret, err
 }
@@ -164,7 +167,7 @@ func (m *defaultProjectsModel) Update(ctx context.Context, newData *Projects) er
 	projectsProjectIdKey := fmt.Sprintf("%s%v", cacheProjectsProjectIdPrefix, data.ProjectId)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, projectsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.ProjectId, newData.ProjectCode, newData.UserId, newData.Title, newData.Description, newData.Category, newData.Status, newData.Progress, newData.Tags, newData.LastActivityAt, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.ProjectId, newData.ProjectCode, newData.UserId, newData.Title, newData.Description, newData.Category, newData.Status, newData.Progress, newData.ReachedStages, newData.Tags, newData.LastActivityAt, newData.AssignmentId, newData.TemplateId, newData.Id)
 	}, projectsIdKey, projectsProjectCodeKey, projectsProjectIdKey)
 	return err
 }
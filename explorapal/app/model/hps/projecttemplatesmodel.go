@@ -0,0 +1,82 @@
+package hps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// TemplateStatusActive 可以使用的模板
+const TemplateStatusActive = "active"
+
+var _ ProjectTemplatesModel = (*customProjectTemplatesModel)(nil)
+
+type (
+	// ProjectTemplatesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customProjectTemplatesModel.
+	ProjectTemplatesModel interface {
+		projectTemplatesModel
+		FindActive(ctx context.Context, category string) ([]*ProjectTemplates, error)
+	}
+
+	customProjectTemplatesModel struct {
+		*defaultProjectTemplatesModel
+	}
+)
+
+// NewProjectTemplatesModel returns a model for the database table.
+func NewProjectTemplatesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ProjectTemplatesModel {
+	return &customProjectTemplatesModel{
+		defaultProjectTemplatesModel: newProjectTemplatesModel(conn, c, opts...),
+	}
+}
+
+// TemplateMilestone 模板中的里程碑，使用模板创建项目时复制为项目里程碑
+type TemplateMilestone struct {
+	Stage       string `json:"stage"`          // 探索阶段：observe,question,express,achievement
+	Kind        string `json:"kind,omitempty"` // 阶段内的具体类型，为空时不限
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Target      int64  `json:"target"` // 需要完成的数量
+}
+
+// FindActive 查询可以使用的模板，category为空时查询全部类别
+func (m *customProjectTemplatesModel) FindActive(ctx context.Context, category string) ([]*ProjectTemplates, error) {
+	var resp []*ProjectTemplates
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `delete_time` IS NULL", projectTemplatesRows, m.table)
+	args := []any{TemplateStatusActive}
+	if category != "" {
+		query += " and `category` = ?"
+		args = append(args, category)
+	}
+	query += " order by `sort` asc, `id` asc"
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// GetMilestones 解析里程碑JSON
+func (t *ProjectTemplates) GetMilestones() ([]TemplateMilestone, error) {
+	var milestones []TemplateMilestone
+	err := json.Unmarshal([]byte(t.Milestones), &milestones)
+	return milestones, err
+}
+
+// GetStarterPrompts 解析开场引导语JSON
+func (t *ProjectTemplates) GetStarterPrompts() ([]string, error) {
+	return unmarshalStrings(t.StarterPrompts)
+}
+
+// GetSuggestedTags 解析推荐标签JSON
+func (t *ProjectTemplates) GetSuggestedTags() ([]string, error) {
+	return unmarshalStrings(t.SuggestedTags)
+}
@@ -1,6 +1,10 @@
 package hps
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
@@ -12,6 +16,11 @@ type (
 	// and implement the added methods in customQuestionsModel.
 	QuestionsModel interface {
 		questionsModel
+		CountAnswered(ctx context.Context, userID int64, from, to time.Time) (int64, error)
+		CountByProjects(ctx context.Context, projectIDs []int64) ([]*ProjectCount, error)
+		CountByProjectType(ctx context.Context, projectID int64) ([]*GroupCount, error)
+		FindByProject(ctx context.Context, projectID, limit int64) ([]*Questions, error)
+		Search(ctx context.Context, userID int64, keyword string, limit int64) ([]*SearchMatch, error)
 	}
 
 	customQuestionsModel struct {
@@ -25,3 +34,217 @@ func NewQuestionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Optio
 		defaultQuestionsModel: newQuestionsModel(conn, c, opts...),
 	}
 }
+
+// CountAnswered 统计用户在时间段内得到回答的问题数，以回答写入时的更新时间为准
+func (m *customQuestionsModel) CountAnswered(ctx context.Context, userID int64, from, to time.Time) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `user_id` = ? and (`ai_answer` IS NOT NULL or `user_response` IS NOT NULL) "+
+		"and `update_time` >= ? and `update_time` < ? and `delete_time` IS NULL", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, userID, from, to)
+	return count, err
+}
+
+// CountByProjects 按项目统计得到回答的问题
+func (m *customQuestionsModel) CountByProjects(ctx context.Context, projectIDs []int64) ([]*ProjectCount, error) {
+	if len(projectIDs) == 0 {
+		return nil, ErrNotFound
+	}
+	var resp []*ProjectCount
+	placeholders, args := inArgs(projectIDs)
+	query := fmt.Sprintf("select `project_id`, count(*) as `total` from %s "+
+		"where `project_id` in (%s) and (`ai_answer` IS NOT NULL or `user_response` IS NOT NULL) and `delete_time` IS NULL group by `project_id`", m.table, placeholders)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// CountByProjectType 按类型统计项目中得到回答的问题
+func (m *customQuestionsModel) CountByProjectType(ctx context.Context, projectID int64) ([]*GroupCount, error) {
+	var resp []*GroupCount
+	query := fmt.Sprintf("select `type` as `name`, count(*) as `total` from %s "+
+		"where `project_id` = ? and (`ai_answer` IS NOT NULL or `user_response` IS NOT NULL) and `delete_time` IS NULL group by `name`", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, projectID)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByProject 查询项目最近的问题，按创建时间倒序
+func (m *customQuestionsModel) FindByProject(ctx context.Context, projectID, limit int64) ([]*Questions, error) {
+	var resp []*Questions
+	query := fmt.Sprintf("select %s from %s where `project_id` = ? and `delete_time` IS NULL order by `create_time` desc limit ?", questionsRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, projectID, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// Search 在用户问题的内容、AI回答和孩子的回答中全文检索，不包含已删除项目中的问题
+func (m *customQuestionsModel) Search(ctx context.Context, userID int64, keyword string, limit int64) ([]*SearchMatch, error) {
+	var resp []*SearchMatch
+	query := fmt.Sprintf("select q.`question_id` as `entity_id`, q.`project_id`, p.`title` as `project_title`, q.`content` as `title`, "+
+		"concat_ws(' ', q.`ai_answer`, q.`user_response`) as `content`, q.`create_time`, "+
+		"match(q.`content`, q.`ai_answer`, q.`user_response`) against (? in natural language mode) as `score` "+
+		"from %s q join `projects` p on p.`project_id` = q.`project_id` and p.`delete_time` IS NULL "+
+		"where q.`user_id` = ? and q.`delete_time` IS NULL "+
+		"and match(q.`content`, q.`ai_answer`, q.`user_response`) against (? in natural language mode) "+
+		"order by `score` desc limit ?", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, keyword, userID, keyword, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// GetHints 解析提示JSON
+func (q *Questions) GetHints() ([]string, error) {
+	return unmarshalStrings(q.Hints)
+}
+
+// SetHints 设置提示JSON
+func (q *Questions) SetHints(hints []string) error {
+	data, err := marshalField(hints)
+	if err != nil {
+		return err
+	}
+	q.Hints = data
+	return nil
+}
+
+// GetKeyPoints 解析关键要点JSON
+func (q *Questions) GetKeyPoints() ([]string, error) {
+	return unmarshalStrings(q.KeyPoints)
+}
+
+// SetKeyPoints 设置关键要点JSON
+func (q *Questions) SetKeyPoints(points []string) error {
+	data, err := marshalField(points)
+	if err != nil {
+		return err
+	}
+	q.KeyPoints = data
+	return nil
+}
+
+// GetExamples 解析举例说明JSON
+func (q *Questions) GetExamples() ([]string, error) {
+	return unmarshalStrings(q.Examples)
+}
+
+// SetExamples 设置举例说明JSON
+func (q *Questions) SetExamples(examples []string) error {
+	data, err := marshalField(examples)
+	if err != nil {
+		return err
+	}
+	q.Examples = data
+	return nil
+}
+
+// GetAnalogies 解析类比JSON
+func (q *Questions) GetAnalogies() ([]string, error) {
+	return unmarshalStrings(q.Analogies)
+}
+
+// SetAnalogies 设置类比JSON
+func (q *Questions) SetAnalogies(analogies []string) error {
+	data, err := marshalField(analogies)
+	if err != nil {
+		return err
+	}
+	q.Analogies = data
+	return nil
+}
+
+// GetVisualAids 解析视觉辅助建议JSON
+func (q *Questions) GetVisualAids() ([]string, error) {
+	return unmarshalStrings(q.VisualAids)
+}
+
+// SetVisualAids 设置视觉辅助建议JSON
+func (q *Questions) SetVisualAids(aids []string) error {
+	data, err := marshalField(aids)
+	if err != nil {
+		return err
+	}
+	q.VisualAids = data
+	return nil
+}
+
+// GetFollowUpQuestions 解析后续问题建议JSON
+func (q *Questions) GetFollowUpQuestions() ([]string, error) {
+	return unmarshalStrings(q.FollowUpQuestions)
+}
+
+// SetFollowUpQuestions 设置后续问题建议JSON
+func (q *Questions) SetFollowUpQuestions(questions []string) error {
+	data, err := marshalField(questions)
+	if err != nil {
+		return err
+	}
+	q.FollowUpQuestions = data
+	return nil
+}
+
+// GetThinkingPrompts 解析思考提示JSON
+func (q *Questions) GetThinkingPrompts() ([]string, error) {
+	return unmarshalStrings(q.ThinkingPrompts)
+}
+
+// SetThinkingPrompts 设置思考提示JSON
+func (q *Questions) SetThinkingPrompts(prompts []string) error {
+	data, err := marshalField(prompts)
+	if err != nil {
+		return err
+	}
+	q.ThinkingPrompts = data
+	return nil
+}
+
+// Activity 活动结构
+type Activity struct {
+	Type        string   `json:"type"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Materials   []string `json:"materials"`
+	Steps       []string `json:"steps"`
+	Duration    int32    `json:"duration"`
+	Difficulty  string   `json:"difficulty"`
+}
+
+// GetActivities 解析建议活动JSON
+func (q *Questions) GetActivities() ([]Activity, error) {
+	values := []Activity{}
+	err := unmarshalField(q.Activities, &values)
+	return values, err
+}
+
+// SetActivities 设置建议活动JSON
+func (q *Questions) SetActivities(activities []Activity) error {
+	data, err := marshalField(activities)
+	if err != nil {
+		return err
+	}
+	q.Activities = data
+	return nil
+}
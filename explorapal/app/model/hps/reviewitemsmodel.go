@@ -0,0 +1,134 @@
+package hps
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 人工审核状态：审核记录使用 pending,claimed,approved,rejected；来源记录的review_status使用 none,pending,approved,rejected
+const (
+	ReviewStatusNone     = "none"
+	ReviewStatusPending  = "pending"
+	ReviewStatusClaimed  = "claimed"
+	ReviewStatusApproved = "approved"
+	ReviewStatusRejected = "rejected"
+)
+
+var _ ReviewItemsModel = (*customReviewItemsModel)(nil)
+
+type (
+	// ReviewItemsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customReviewItemsModel.
+	ReviewItemsModel interface {
+		reviewItemsModel
+		FindList(ctx context.Context, filter *ReviewItemFilter, page, pageSize int64) ([]*ReviewItems, error)
+		Count(ctx context.Context, filter *ReviewItemFilter) (int64, error)
+		UpdateIfStatus(ctx context.Context, newData *ReviewItems, status string) (bool, error)
+		CountUndecided(ctx context.Context, sourceTable string, sourceID int64) (int64, error)
+	}
+
+	customReviewItemsModel struct {
+		*defaultReviewItemsModel
+	}
+
+	// ReviewItemFilter 审核队列查询条件，零值表示不限
+	ReviewItemFilter struct {
+		Status      string
+		SourceTable string
+		ReviewerId  int64
+	}
+)
+
+// NewReviewItemsModel returns a model for the database table.
+func NewReviewItemsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ReviewItemsModel {
+	return &customReviewItemsModel{
+		defaultReviewItemsModel: newReviewItemsModel(conn, c, opts...),
+	}
+}
+
+// FindList 按条件分页查询审核队列，先进先审
+func (m *customReviewItemsModel) FindList(ctx context.Context, filter *ReviewItemFilter, page, pageSize int64) ([]*ReviewItems, error) {
+	var resp []*ReviewItems
+	where, args := filter.where()
+	offset := (page - 1) * pageSize
+
+	query := fmt.Sprintf("select %s from %s where %s order by `create_time` asc, `id` asc limit ?,?", reviewItemsRows, m.table, where)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, append(args, offset, pageSize)...)
+	switch err {
+	case nil:
+		return resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// Count 统计符合条件的审核记录数量
+func (m *customReviewItemsModel) Count(ctx context.Context, filter *ReviewItemFilter) (int64, error) {
+	var count int64
+	where, args := filter.where()
+
+	query := fmt.Sprintf("select count(*) from %s where %s", m.table, where)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
+	switch err {
+	case nil:
+		return count, nil
+	case sqlx.ErrNotFound:
+		return 0, nil
+	default:
+		return 0, err
+	}
+}
+
+// UpdateIfStatus 仅当审核记录仍处于status状态时更新，用于认领和审核的并发控制；返回是否更新成功
+func (m *customReviewItemsModel) UpdateIfStatus(ctx context.Context, newData *ReviewItems, status string) (bool, error) {
+	reviewItemsIdKey := fmt.Sprintf("%s%v", cacheReviewItemsIdPrefix, newData.Id)
+	reviewItemsReviewIdKey := fmt.Sprintf("%s%v", cacheReviewItemsReviewIdPrefix, newData.ReviewId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ? and `status` = ?", m.table, reviewItemsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.ReviewId, newData.ProjectId, newData.UserId, newData.SourceTable, newData.SourceId, newData.SourceField, newData.ContentType, newData.ContentRef, newData.Content, newData.RiskLevel, newData.RiskDetails, newData.Status, newData.ReviewerId, newData.ClaimTime, newData.EditedContent, newData.ReviewNote, newData.DecideTime, newData.Id, status)
+	}, reviewItemsIdKey, reviewItemsReviewIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// CountUndecided 统计来源记录还没有审核结论的审核记录数
+func (m *customReviewItemsModel) CountUndecided(ctx context.Context, sourceTable string, sourceID int64) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `source_table` = ? and `source_id` = ? and `status` in (?, ?) and `delete_time` IS NULL", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, sourceTable, sourceID, ReviewStatusPending, ReviewStatusClaimed)
+	return count, err
+}
+
+func (f *ReviewItemFilter) where() (string, []interface{}) {
+	conds := []string{"`delete_time` IS NULL"}
+	var args []interface{}
+	if f == nil {
+		return conds[0], args
+	}
+	if f.Status != "" {
+		conds = append(conds, "`status` = ?")
+		args = append(args, f.Status)
+	}
+	if f.SourceTable != "" {
+		conds = append(conds, "`source_table` = ?")
+		args = append(args, f.SourceTable)
+	}
+	if f.ReviewerId > 0 {
+		conds = append(conds, "`reviewer_id` = ?")
+		args = append(args, f.ReviewerId)
+	}
+	return strings.Join(conds, " and "), args
+}
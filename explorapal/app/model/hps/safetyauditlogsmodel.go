@@ -0,0 +1,27 @@
+package hps
+
+import (
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ SafetyAuditLogsModel = (*customSafetyAuditLogsModel)(nil)
+
+type (
+	// SafetyAuditLogsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customSafetyAuditLogsModel.
+	SafetyAuditLogsModel interface {
+		safetyAuditLogsModel
+	}
+
+	customSafetyAuditLogsModel struct {
+		*defaultSafetyAuditLogsModel
+	}
+)
+
+// NewSafetyAuditLogsModel returns a model for the database table.
+func NewSafetyAuditLogsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) SafetyAuditLogsModel {
+	return &customSafetyAuditLogsModel{
+		defaultSafetyAuditLogsModel: newSafetyAuditLogsModel(conn, c, opts...),
+	}
+}
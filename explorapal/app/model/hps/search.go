@@ -0,0 +1,14 @@
+package hps
+
+import "time"
+
+// SearchMatch 全文检索命中的记录，按相关度排序
+type SearchMatch struct {
+	EntityId     int64     `db:"entity_id"`     // 命中记录的ID
+	ProjectId    int64     `db:"project_id"`    // 所属项目ID
+	ProjectTitle string    `db:"project_title"` // 所属项目标题
+	Title        string    `db:"title"`         // 记录标题
+	Content      string    `db:"content"`       // 用于生成摘要的正文
+	CreateTime   time.Time `db:"create_time"`   // 创建时间
+	Score        float64   `db:"score"`         // 相关度
+}
@@ -0,0 +1,30 @@
+package hps
+
+import "strings"
+
+// GroupCount 分组统计结果
+type GroupCount struct {
+	Name  string `db:"name"`  // 分组名称
+	Total int64  `db:"total"` // 数量
+}
+
+// HourCount 按小时统计的数量
+type HourCount struct {
+	Hour  int64 `db:"hour"`  // 小时(0-23)
+	Total int64 `db:"total"` // 数量
+}
+
+// ProjectCount 按项目统计的数量
+type ProjectCount struct {
+	ProjectId int64 `db:"project_id"` // 项目ID
+	Total     int64 `db:"total"`      // 数量
+}
+
+// inArgs 生成in查询的占位符和参数
+func inArgs(ids []int64) (string, []any) {
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	return strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","), args
+}
@@ -0,0 +1,119 @@
+package hps
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/syncx"
+)
+
+// UnitOfWork 把多个数据表的写入放在同一个事务中执行
+// 事务中的模型与普通模型的方法相同，所有读写都在事务中执行；读取不经过缓存，
+// 需要删除的缓存键在事务提交后才删除，回滚时不删除
+type UnitOfWork struct {
+	conn  sqlx.SqlConn
+	cache cache.Cache
+}
+
+// NewUnitOfWork 创建事务执行器，缓存配置需与模型使用的一致
+func NewUnitOfWork(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *UnitOfWork {
+	return &UnitOfWork{
+		conn:  conn,
+		cache: cache.New(c, syncx.NewSingleFlight(), cache.NewStat("uow"), sql.ErrNoRows, opts...),
+	}
+}
+
+// Do 在事务中执行fn，fn返回错误时回滚；fn中只能使用tx中的模型，事务外的模型不在事务中
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, tx *Models) error) error {
+	txc := &txCache{}
+	err := u.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		conn := sqlc.NewConnWithCache(sqlx.NewSqlConnFromSession(session), txc)
+		return fn(ctx, newModels(conn))
+	})
+	if err != nil {
+		return err
+	}
+
+	// 事务已经提交，删除缓存失败时只会短暂读到旧数据，不返回错误
+	if keys := txc.pending(); len(keys) > 0 {
+		if err := u.cache.DelCtx(ctx, keys...); err != nil {
+			logx.WithContext(ctx).Errorf("删除事务缓存失败: %v, keys: %v", err, keys)
+		}
+	}
+	return nil
+}
+
+// txCache 事务中使用的缓存
+// 读取直接查询数据库，避免读到事务外的旧缓存，也不把未提交的数据写入缓存；删除只记录缓存键，提交后统一删除
+type txCache struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (c *txCache) pending() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.keys
+}
+
+func (c *txCache) Del(keys ...string) error {
+	return c.DelCtx(context.Background(), keys...)
+}
+
+func (c *txCache) DelCtx(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keys = append(c.keys, keys...)
+	return nil
+}
+
+func (c *txCache) Get(key string, val any) error {
+	return c.GetCtx(context.Background(), key, val)
+}
+
+func (c *txCache) GetCtx(context.Context, string, any) error {
+	return sql.ErrNoRows
+}
+
+func (c *txCache) IsNotFound(err error) bool {
+	return errors.Is(err, sql.ErrNoRows)
+}
+
+func (c *txCache) Set(key string, val any) error {
+	return nil
+}
+
+func (c *txCache) SetCtx(context.Context, string, any) error {
+	return nil
+}
+
+func (c *txCache) SetWithExpire(string, any, time.Duration) error {
+	return nil
+}
+
+func (c *txCache) SetWithExpireCtx(context.Context, string, any, time.Duration) error {
+	return nil
+}
+
+func (c *txCache) Take(val any, key string, query func(val any) error) error {
+	return query(val)
+}
+
+func (c *txCache) TakeCtx(_ context.Context, val any, _ string, query func(val any) error) error {
+	return query(val)
+}
+
+func (c *txCache) TakeWithExpire(val any, key string, query func(val any, expire time.Duration) error) error {
+	return query(val, 0)
+}
+
+func (c *txCache) TakeWithExpireCtx(_ context.Context, val any, _ string, query func(val any, expire time.Duration) error) error {
+	return query(val, 0)
+}
@@ -1,10 +1,21 @@
 package hps
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
+// 用户状态
+const (
+	UserStatusActive   = "active"
+	UserStatusInactive = "inactive"
+)
+
 var _ UsersModel = (*customUsersModel)(nil)
 
 type (
@@ -12,6 +23,11 @@ type (
 	// and implement the added methods in customUsersModel.
 	UsersModel interface {
 		usersModel
+		FindOneByUsername(ctx context.Context, username string) (*Users, error)
+		FindOneByPhone(ctx context.Context, phone string) (*Users, error)
+		FindOneByEmail(ctx context.Context, email string) (*Users, error)
+		SoftDelete(ctx context.Context, data *Users) error
+		UpdateLastLogin(ctx context.Context, data *Users, loginAt time.Time) error
 	}
 
 	customUsersModel struct {
@@ -25,3 +41,55 @@ func NewUsersModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) U
 		defaultUsersModel: newUsersModel(conn, c, opts...),
 	}
 }
+
+// FindOneByUsername 按用户名查询未删除的用户
+func (m *customUsersModel) FindOneByUsername(ctx context.Context, username string) (*Users, error) {
+	return m.findOneBy(ctx, "username", username)
+}
+
+// FindOneByPhone 按手机号查询未删除的用户
+func (m *customUsersModel) FindOneByPhone(ctx context.Context, phone string) (*Users, error) {
+	return m.findOneBy(ctx, "phone", phone)
+}
+
+// FindOneByEmail 按邮箱查询未删除的用户
+func (m *customUsersModel) FindOneByEmail(ctx context.Context, email string) (*Users, error) {
+	return m.findOneBy(ctx, "email", email)
+}
+
+// SoftDelete 软删除用户，记录删除时间，按用户名、手机号和邮箱都不再能查到
+func (m *customUsersModel) SoftDelete(ctx context.Context, data *Users) error {
+	usersIdKey := fmt.Sprintf("%s%v", cacheUsersIdPrefix, data.Id)
+	usersUserIdKey := fmt.Sprintf("%s%v", cacheUsersUserIdPrefix, data.UserId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set `delete_time` = ? where `id` = ? and `delete_time` is null", m.table)
+		return conn.ExecCtx(ctx, query, time.Now(), data.Id)
+	}, usersIdKey, usersUserIdKey)
+	return err
+}
+
+// UpdateLastLogin 记录最后登录时间，只更新该字段，避免覆盖并发修改的资料
+func (m *customUsersModel) UpdateLastLogin(ctx context.Context, data *Users, loginAt time.Time) error {
+	usersIdKey := fmt.Sprintf("%s%v", cacheUsersIdPrefix, data.Id)
+	usersUserIdKey := fmt.Sprintf("%s%v", cacheUsersUserIdPrefix, data.UserId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set `last_login_at` = ? where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, loginAt, data.Id)
+	}, usersIdKey, usersUserIdKey)
+	return err
+}
+
+// findOneBy 按非唯一索引字段查询未删除的用户，不走缓存
+func (m *customUsersModel) findOneBy(ctx context.Context, field, value string) (*Users, error) {
+	var resp Users
+	query := fmt.Sprintf("select %s from %s where `%s` = ? and `delete_time` is null order by `id` desc limit 1", usersRows, m.table, field)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, value)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
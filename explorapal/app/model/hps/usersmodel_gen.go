@@ -43,20 +43,23 @@ type (
 	}
 
 	Users struct {
-		Id          uint64         `db:"id"`            // 主键ID
-		CreateTime  time.Time      `db:"create_time"`   // 创建时间
-		UpdateTime  time.Time      `db:"update_time"`   // 更新时间
-		DeleteTime  sql.NullTime   `db:"delete_time"`   // 删除时间
-		UserId      int64          `db:"user_id"`       // 用户ID
-		Username    sql.NullString `db:"username"`      // 用户名
-		Nickname    sql.NullString `db:"nickname"`      // 昵称
-		Avatar      sql.NullString `db:"avatar"`        // 头像URL
-		Age         sql.NullInt64  `db:"age"`           // 年龄
-		Gender      sql.NullString `db:"gender"`        // 性别：male,female,other
-		Phone       sql.NullString `db:"phone"`         // 手机号
-		Email       sql.NullString `db:"email"`         // 邮箱
-		Status      string         `db:"status"`        // 状态：active,inactive
-		LastLoginAt sql.NullTime   `db:"last_login_at"` // 最后登录时间
+		Id           uint64         `db:"id"`            // 主键ID
+		CreateTime   time.Time      `db:"create_time"`   // 创建时间
+		UpdateTime   time.Time      `db:"update_time"`   // 更新时间
+		DeleteTime   sql.NullTime   `db:"delete_time"`   // 删除时间
+		UserId       int64          `db:"user_id"`       // 用户ID
+		Username     sql.NullString `db:"username"`      // 用户名
+		Nickname     sql.NullString `db:"nickname"`      // 昵称
+		Avatar       sql.NullString `db:"avatar"`        // 头像URL
+		Age          sql.NullInt64  `db:"age"`           // 年龄
+		Gender       sql.NullString `db:"gender"`        // 性别：male,female,other
+		Language     string         `db:"language"`      // 偏好语言：zh-CN,en-US
+		Phone        sql.NullString `db:"phone"`         // 手机号
+		Email        sql.NullString `db:"email"`         // 邮箱
+		PasswordHash sql.NullString `db:"password_hash"` // 登录密码哈希
+		Role         string         `db:"role"`          // 角色：child,parent,teacher
+		Status       string         `db:"status"`        // 状态：active,inactive
+		LastLoginAt  sql.NullTime   `db:"last_login_at"` // 最后登录时间
 	}
 )
 
@@ -123,8 +126,8 @@ func (m *defaultUsersModel) Insert(ctx context.Context, data *Users) (sql.Result
 	usersIdKey := fmt.Sprintf("%s%v", cacheUsersIdPrefix, data.Id)
 	usersUserIdKey := fmt.Sprintf("%s%v", cacheUsersUserIdPrefix, data.UserId)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, usersRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.DeleteTime, data.UserId, data.Username, data.Nickname, data.Avatar, data.Age, data.Gender, data.Phone, data.Email, data.Status, data.LastLoginAt)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, usersRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.DeleteTime, data.UserId, data.Username, data.Nickname, data.Avatar, data.Age, data.Gender, data.Language, data.Phone, data.Email, data.PasswordHash, data.Role, data.Status, data.LastLoginAt)
 	}, usersIdKey, usersUserIdKey)
 	return ret, err
 }
@@ -139,7 +142,7 @@ func (m *defaultUsersModel) Update(ctx context.Context, newData *Users) error {
 	usersUserIdKey := fmt.Sprintf("%s%v", cacheUsersUserIdPrefix, data.UserId)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, usersRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.UserId, newData.Username, newData.Nickname, newData.Avatar, newData.Age, newData.Gender, newData.Phone, newData.Email, newData.Status, newData.LastLoginAt, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.DeleteTime, newData.UserId, newData.Username, newData.Nickname, newData.Avatar, newData.Age, newData.Gender, newData.Language, newData.Phone, newData.Email, newData.PasswordHash, newData.Role, newData.Status, newData.LastLoginAt, newData.Id)
 	}, usersIdKey, usersUserIdKey)
 	return err
 }
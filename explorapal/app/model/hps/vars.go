@@ -1,5 +1,20 @@
 package hps
 
-import "github.com/zeromicro/go-zero/core/stores/sqlx"
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
 
 var ErrNotFound = sqlx.ErrNotFound
+
+// MySQL唯一键冲突的错误码
+const mysqlDuplicateEntry = 1062
+
+// isDuplicateKey 是否是违反唯一索引index的错误
+func isDuplicateKey(err error, index string) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry && strings.Contains(mysqlErr.Message, index)
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"explorapal/pkg/idgen"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	zrpc.RpcServerConf
+
+	// JWT配置，与API服务使用同一密钥校验透传的访问令牌
+	JwtAuth struct {
+		AccessSecret string
+	}
+
+	// 数据库配置
+	DBConfig struct {
+		DataSource string
+	}
+
+	// 缓存配置
+	Cache cache.CacheConf
+
+	// ID生成配置，同时运行的实例机器号不能重复
+	IDGen idgen.Config
+
+	// 文件存储配置，与API服务使用同一存储，用于恢复审核通过的隔离图片
+	Storage struct {
+		Root             string
+		BaseURL          string
+		QuarantinePrefix string `json:",default=quarantine"`
+	}
+}
@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/moderation/rpc/internal/svc"
+	"explorapal/app/moderation/rpc/moderation"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ApproveReviewItemLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewApproveReviewItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ApproveReviewItemLogic {
+	return &ApproveReviewItemLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 审核通过：有修改内容时写回来源记录，内容对孩子可见
+func (l *ApproveReviewItemLogic) ApproveReviewItem(in *moderation.ApproveReviewItemReq) (*moderation.ApproveReviewItemResp, error) {
+	item, err := findClaimedItem(l.ctx, l.svcCtx, in.ReviewId)
+	if err == nil {
+		err = decide(l.ctx, l.svcCtx, item, true, in.ReviewNote)
+	}
+	if err != nil {
+		status, msg, err := errorStatus(err, "审核通过失败")
+		if err != nil {
+			l.Logger.Errorf("审核通过失败: %v", err)
+		}
+		return &moderation.ApproveReviewItemResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &moderation.ApproveReviewItemResp{
+		Status: 200,
+		Msg:    "审核通过",
+		Item:   toReviewItem(item),
+	}, nil
+}
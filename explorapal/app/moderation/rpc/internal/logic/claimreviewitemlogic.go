@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/moderation/rpc/internal/svc"
+	"explorapal/app/moderation/rpc/moderation"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ClaimReviewItemLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewClaimReviewItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ClaimReviewItemLogic {
+	return &ClaimReviewItemLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 认领待审核的内容，同一条内容只能被一个审核人认领
+func (l *ClaimReviewItemLogic) ClaimReviewItem(in *moderation.ClaimReviewItemReq) (*moderation.ClaimReviewItemResp, error) {
+	item, err := l.claim(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "认领审核记录失败")
+		if err != nil {
+			l.Logger.Errorf("认领审核记录失败: %v", err)
+		}
+		return &moderation.ClaimReviewItemResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &moderation.ClaimReviewItemResp{
+		Status: 200,
+		Msg:    "认领成功",
+		Item:   toReviewItem(item),
+	}, nil
+}
+
+func (l *ClaimReviewItemLogic) claim(in *moderation.ClaimReviewItemReq) (*hps.ReviewItems, error) {
+	reviewerID, err := currentReviewer(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := l.svcCtx.ReviewItemModel.FindOneByReviewId(l.ctx, in.ReviewId)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, errReviewNotFound
+		}
+		return nil, err
+	}
+
+	switch item.Status {
+	case hps.ReviewStatusPending:
+	case hps.ReviewStatusClaimed:
+		// 重复认领视为成功
+		if item.ReviewerId.Int64 == reviewerID {
+			return item, nil
+		}
+		return nil, errClaimedByOther
+	default:
+		return nil, errStateChanged
+	}
+
+	item.Status = hps.ReviewStatusClaimed
+	item.ReviewerId = sql.NullInt64{Int64: reviewerID, Valid: true}
+	item.ClaimTime = sql.NullTime{Time: time.Now(), Valid: true}
+	updated, err := l.svcCtx.ReviewItemModel.UpdateIfStatus(l.ctx, item, hps.ReviewStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, errClaimedByOther
+	}
+	return item, nil
+}
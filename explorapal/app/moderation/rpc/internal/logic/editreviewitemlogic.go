@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/moderation/rpc/internal/svc"
+	"explorapal/app/moderation/rpc/moderation"
+	moderationpkg "explorapal/pkg/moderation"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EditReviewItemLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewEditReviewItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EditReviewItemLogic {
+	return &EditReviewItemLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 修改待审核的内容，修改结果在审核通过时写回来源记录
+func (l *EditReviewItemLogic) EditReviewItem(in *moderation.EditReviewItemReq) (*moderation.EditReviewItemResp, error) {
+	if strings.TrimSpace(in.EditedContent) == "" {
+		return &moderation.EditReviewItemResp{
+			Status: 400,
+			Msg:    "修改后的内容不能为空",
+		}, nil
+	}
+
+	item, err := l.edit(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "修改审核内容失败")
+		if err != nil {
+			l.Logger.Errorf("修改审核内容失败: %v", err)
+		}
+		return &moderation.EditReviewItemResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &moderation.EditReviewItemResp{
+		Status: 200,
+		Msg:    "修改成功",
+		Item:   toReviewItem(item),
+	}, nil
+}
+
+func (l *EditReviewItemLogic) edit(in *moderation.EditReviewItemReq) (*hps.ReviewItems, error) {
+	item, err := findClaimedItem(l.ctx, l.svcCtx, in.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	if !moderationpkg.Editable(item.SourceTable, item.SourceField) {
+		return nil, errNotEditable
+	}
+
+	item.EditedContent = sql.NullString{String: in.EditedContent, Valid: true}
+	if in.ReviewNote != "" {
+		item.ReviewNote = sql.NullString{String: in.ReviewNote, Valid: true}
+	}
+	updated, err := l.svcCtx.ReviewItemModel.UpdateIfStatus(l.ctx, item, hps.ReviewStatusClaimed)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, errStateChanged
+	}
+	return item, nil
+}
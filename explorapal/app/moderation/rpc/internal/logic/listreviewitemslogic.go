@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/moderation/rpc/internal/svc"
+	"explorapal/app/moderation/rpc/moderation"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListReviewItemsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListReviewItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListReviewItemsLogic {
+	return &ListReviewItemsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 默认只查看待认领的内容，先进先审
+func (l *ListReviewItemsLogic) ListReviewItems(in *moderation.ListReviewItemsReq) (*moderation.ListReviewItemsResp, error) {
+	reviewerID, err := currentReviewer(l.ctx)
+	if err != nil {
+		status, msg, err := errorStatus(err, "查询审核队列失败")
+		return &moderation.ListReviewItemsResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	filter := &hps.ReviewItemFilter{
+		Status:      in.Status,
+		SourceTable: in.SourceTable,
+	}
+	if in.Mine {
+		filter.ReviewerId = reviewerID
+	}
+	if filter.Status == "" {
+		filter.Status = hps.ReviewStatusPending
+	}
+
+	items, err := l.svcCtx.ReviewItemModel.FindList(l.ctx, filter, page, pageSize)
+	if err != nil && err != hps.ErrNotFound {
+		l.Logger.Errorf("查询审核队列失败: %v", err)
+		return &moderation.ListReviewItemsResp{
+			Status: 500,
+			Msg:    "查询审核队列失败",
+		}, err
+	}
+	total, err := l.svcCtx.ReviewItemModel.Count(l.ctx, filter)
+	if err != nil {
+		l.Logger.Errorf("统计审核队列失败: %v", err)
+		return &moderation.ListReviewItemsResp{
+			Status: 500,
+			Msg:    "统计审核队列失败",
+		}, err
+	}
+
+	list := make([]*moderation.ReviewItem, 0, len(items))
+	for _, item := range items {
+		list = append(list, toReviewItem(item))
+	}
+
+	return &moderation.ListReviewItemsResp{
+		Status:   200,
+		Msg:      "查询审核队列成功",
+		List:     list,
+		Total:    total,
+		PageSize: pageSize,
+		Page:     page,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/moderation/rpc/internal/svc"
+	"explorapal/app/moderation/rpc/moderation"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RejectReviewItemLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRejectReviewItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RejectReviewItemLogic {
+	return &RejectReviewItemLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 审核驳回：内容继续对孩子隐藏
+func (l *RejectReviewItemLogic) RejectReviewItem(in *moderation.RejectReviewItemReq) (*moderation.RejectReviewItemResp, error) {
+	item, err := findClaimedItem(l.ctx, l.svcCtx, in.ReviewId)
+	if err == nil {
+		err = decide(l.ctx, l.svcCtx, item, false, in.ReviewNote)
+	}
+	if err != nil {
+		status, msg, err := errorStatus(err, "审核驳回失败")
+		if err != nil {
+			l.Logger.Errorf("审核驳回失败: %v", err)
+		}
+		return &moderation.RejectReviewItemResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &moderation.RejectReviewItemResp{
+		Status: 200,
+		Msg:    "审核驳回",
+		Item:   toReviewItem(item),
+	}, nil
+}
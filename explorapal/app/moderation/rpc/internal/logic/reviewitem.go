@@ -0,0 +1,136 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/moderation/rpc/internal/svc"
+	"explorapal/app/moderation/rpc/moderation"
+	"explorapal/pkg/auth"
+	moderationpkg "explorapal/pkg/moderation"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+// reviewError 审核流程中的业务错误，转换为响应中的状态码和提示
+type reviewError struct {
+	status int32
+	msg    string
+}
+
+func (e *reviewError) Error() string {
+	return e.msg
+}
+
+var (
+	errNotReviewer    = &reviewError{status: 403, msg: "只有审核人员可以处理审核队列"}
+	errReviewNotFound = &reviewError{status: 404, msg: "审核记录不存在"}
+	errNotClaimed     = &reviewError{status: 409, msg: "请先认领该审核记录"}
+	errClaimedByOther = &reviewError{status: 403, msg: "该审核记录已被其他审核人认领"}
+	errStateChanged   = &reviewError{status: 409, msg: "审核记录状态已变化，请刷新后重试"}
+	errNotEditable    = &reviewError{status: 400, msg: "该内容不支持修改，只能通过或驳回"}
+)
+
+// errorStatus 返回错误对应的状态码和提示；非业务错误返回500
+func errorStatus(err error, fallback string) (int32, string, error) {
+	var re *reviewError
+	if errors.As(err, &re) {
+		return re.status, re.msg, nil
+	}
+	if errors.Is(err, auth.ErrTokenInvalid) {
+		return 401, err.Error(), nil
+	}
+	return 500, fallback, err
+}
+
+// currentReviewer 返回调用方的用户ID，调用方必须是审核人员或管理员
+func currentReviewer(ctx context.Context) (int64, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return 0, auth.ErrTokenInvalid
+	}
+	if identity.Role != auth.RoleReviewer && identity.Role != auth.RoleAdmin {
+		return 0, errNotReviewer
+	}
+	return identity.UserID, nil
+}
+
+// findClaimedItem 查询调用方已认领的审核记录
+func findClaimedItem(ctx context.Context, svcCtx *svc.ServiceContext, reviewID int64) (*hps.ReviewItems, error) {
+	reviewerID, err := currentReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	item, err := svcCtx.ReviewItemModel.FindOneByReviewId(ctx, reviewID)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, errReviewNotFound
+		}
+		return nil, err
+	}
+	if item.Status != hps.ReviewStatusClaimed {
+		return nil, errNotClaimed
+	}
+	if item.ReviewerId.Int64 != reviewerID {
+		return nil, errClaimedByOther
+	}
+	return item, nil
+}
+
+// decide 审核通过或驳回：在同一个事务中先按认领状态更新审核记录，再写回来源记录
+// 审核记录已被其他审核人处理时在写回之前中止，来源记录不会被两个结论先后修改
+func decide(ctx context.Context, svcCtx *svc.ServiceContext, item *hps.ReviewItems, approved bool, note string) error {
+	item.Status = hps.ReviewStatusRejected
+	if approved {
+		item.Status = hps.ReviewStatusApproved
+	}
+	if note != "" {
+		item.ReviewNote = sql.NullString{String: note, Valid: true}
+	}
+	item.DecideTime = sql.NullTime{Time: time.Now(), Valid: true}
+
+	return svcCtx.UnitOfWork.Do(ctx, func(ctx context.Context, tx *hps.Models) error {
+		updated, err := tx.ReviewItems.UpdateIfStatus(ctx, item, hps.ReviewStatusClaimed)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return errStateChanged
+		}
+		return svcCtx.Sources.ApplyTx(ctx, tx, item, approved)
+	})
+}
+
+func toReviewItem(item *hps.ReviewItems) *moderation.ReviewItem {
+	return &moderation.ReviewItem{
+		ReviewId:      item.ReviewId,
+		ProjectId:     item.ProjectId,
+		UserId:        item.UserId,
+		SourceTable:   item.SourceTable,
+		SourceId:      item.SourceId,
+		SourceField:   item.SourceField,
+		ContentType:   item.ContentType,
+		ContentRef:    item.ContentRef.String,
+		Content:       item.Content.String,
+		RiskLevel:     item.RiskLevel,
+		RiskDetails:   item.RiskDetails.String,
+		Status:        item.Status,
+		ReviewerId:    item.ReviewerId.Int64,
+		EditedContent: item.EditedContent.String,
+		ReviewNote:    item.ReviewNote.String,
+		Editable:      moderationpkg.Editable(item.SourceTable, item.SourceField),
+		CreateTime:    item.CreateTime.Format(timeLayout),
+		ClaimTime:     formatNullTime(item.ClaimTime),
+		DecideTime:    formatNullTime(item.DecideTime),
+	}
+}
+
+func formatNullTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(timeLayout)
+}
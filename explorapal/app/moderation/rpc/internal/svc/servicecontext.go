@@ -0,0 +1,61 @@
+package svc
+
+import (
+	"explorapal/app/model/hps"
+	"explorapal/app/moderation/rpc/internal/config"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/conceptgraph"
+	"explorapal/pkg/moderation"
+	"explorapal/pkg/storage"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type ServiceContext struct {
+	Config config.Config
+
+	// 数据库模型
+	ReviewItemModel hps.ReviewItemsModel
+
+	// 审核记录状态与来源记录写回的事务
+	UnitOfWork *hps.UnitOfWork
+
+	// 审核结论写回来源记录
+	Sources *moderation.Sources
+
+	// 访问令牌校验
+	Auth *auth.Issuer
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	conn := sqlx.NewMysql(c.DBConfig.DataSource)
+	reviewItemModel := hps.NewReviewItemsModel(conn, c.Cache)
+
+	return &ServiceContext{
+		Config: c,
+
+		ReviewItemModel: reviewItemModel,
+
+		UnitOfWork: hps.NewUnitOfWork(conn, c.Cache),
+
+		Sources: &moderation.Sources{
+			Expressions:  hps.NewExpressionsModel(conn, c.Cache),
+			Achievements: hps.NewAchievementsModel(conn, c.Cache),
+			Observations: hps.NewObservationsModel(conn, c.Cache),
+			ReviewItems:  reviewItemModel,
+			Storage: storage.NewStorage(&storage.Config{
+				Root:             c.Storage.Root,
+				BaseURL:          c.Storage.BaseURL,
+				QuarantinePrefix: c.Storage.QuarantinePrefix,
+			}),
+			Concepts: conceptgraph.NewGraph(&conceptgraph.Models{
+				Concepts: hps.NewConceptsModel(conn, c.Cache),
+				Edges:    hps.NewConceptEdgesModel(conn, c.Cache),
+				Mentions: hps.NewConceptMentionsModel(conn, c.Cache),
+				Projects: hps.NewProjectsModel(conn, c.Cache),
+			}),
+		},
+
+		Auth: auth.NewIssuer(&auth.Config{AccessSecret: c.JwtAuth.AccessSecret}),
+	}
+}
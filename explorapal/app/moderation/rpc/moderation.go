@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"explorapal/app/moderation/rpc/internal/config"
+	"explorapal/app/moderation/rpc/internal/server"
+	"explorapal/app/moderation/rpc/internal/svc"
+	"explorapal/app/moderation/rpc/moderation"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/idgen"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+var configFile = flag.String("f", "etc/moderation.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	idgen.MustSetup(c.IDGen)
+	ctx := svc.NewServiceContext(c)
+
+	// 审核服务面向大人，需要看到原始内容，不接入内容安全拦截器
+	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
+		moderation.RegisterModerationServiceServer(grpcServer, server.NewModerationServiceServer(ctx))
+
+		if c.Mode == service.DevMode || c.Mode == service.TestMode {
+			reflection.Register(grpcServer)
+		}
+	})
+	defer s.Stop()
+
+	// 身份认证：只有审核人员和管理员可以处理审核队列，角色在业务逻辑中校验
+	s.AddUnaryInterceptors(auth.UnaryServerInterceptor(ctx.Auth))
+
+	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
+	s.Start()
+}
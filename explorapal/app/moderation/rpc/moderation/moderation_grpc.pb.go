@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: app/moderation/rpc/moderation.proto
+
+package moderation
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ModerationService_ListReviewItems_FullMethodName   = "/moderation.ModerationService/ListReviewItems"
+	ModerationService_ClaimReviewItem_FullMethodName   = "/moderation.ModerationService/ClaimReviewItem"
+	ModerationService_ApproveReviewItem_FullMethodName = "/moderation.ModerationService/ApproveReviewItem"
+	ModerationService_RejectReviewItem_FullMethodName  = "/moderation.ModerationService/RejectReviewItem"
+	ModerationService_EditReviewItem_FullMethodName    = "/moderation.ModerationService/EditReviewItem"
+)
+
+// ModerationServiceClient is the client API for ModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ModerationServiceClient interface {
+	ListReviewItems(ctx context.Context, in *ListReviewItemsReq, opts ...grpc.CallOption) (*ListReviewItemsResp, error)
+	ClaimReviewItem(ctx context.Context, in *ClaimReviewItemReq, opts ...grpc.CallOption) (*ClaimReviewItemResp, error)
+	ApproveReviewItem(ctx context.Context, in *ApproveReviewItemReq, opts ...grpc.CallOption) (*ApproveReviewItemResp, error)
+	RejectReviewItem(ctx context.Context, in *RejectReviewItemReq, opts ...grpc.CallOption) (*RejectReviewItemResp, error)
+	EditReviewItem(ctx context.Context, in *EditReviewItemReq, opts ...grpc.CallOption) (*EditReviewItemResp, error)
+}
+
+type moderationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewModerationServiceClient(cc grpc.ClientConnInterface) ModerationServiceClient {
+	return &moderationServiceClient{cc}
+}
+
+func (c *moderationServiceClient) ListReviewItems(ctx context.Context, in *ListReviewItemsReq, opts ...grpc.CallOption) (*ListReviewItemsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewItemsResp)
+	err := c.cc.Invoke(ctx, ModerationService_ListReviewItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ClaimReviewItem(ctx context.Context, in *ClaimReviewItemReq, opts ...grpc.CallOption) (*ClaimReviewItemResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimReviewItemResp)
+	err := c.cc.Invoke(ctx, ModerationService_ClaimReviewItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ApproveReviewItem(ctx context.Context, in *ApproveReviewItemReq, opts ...grpc.CallOption) (*ApproveReviewItemResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveReviewItemResp)
+	err := c.cc.Invoke(ctx, ModerationService_ApproveReviewItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) RejectReviewItem(ctx context.Context, in *RejectReviewItemReq, opts ...grpc.CallOption) (*RejectReviewItemResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectReviewItemResp)
+	err := c.cc.Invoke(ctx, ModerationService_RejectReviewItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) EditReviewItem(ctx context.Context, in *EditReviewItemReq, opts ...grpc.CallOption) (*EditReviewItemResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditReviewItemResp)
+	err := c.cc.Invoke(ctx, ModerationService_EditReviewItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModerationServiceServer is the server API for ModerationService service.
+// All implementations must embed UnimplementedModerationServiceServer
+// for forward compatibility.
+type ModerationServiceServer interface {
+	ListReviewItems(context.Context, *ListReviewItemsReq) (*ListReviewItemsResp, error)
+	ClaimReviewItem(context.Context, *ClaimReviewItemReq) (*ClaimReviewItemResp, error)
+	ApproveReviewItem(context.Context, *ApproveReviewItemReq) (*ApproveReviewItemResp, error)
+	RejectReviewItem(context.Context, *RejectReviewItemReq) (*RejectReviewItemResp, error)
+	EditReviewItem(context.Context, *EditReviewItemReq) (*EditReviewItemResp, error)
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+// UnimplementedModerationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedModerationServiceServer struct{}
+
+func (UnimplementedModerationServiceServer) ListReviewItems(context.Context, *ListReviewItemsReq) (*ListReviewItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewItems not implemented")
+}
+func (UnimplementedModerationServiceServer) ClaimReviewItem(context.Context, *ClaimReviewItemReq) (*ClaimReviewItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReviewItem not implemented")
+}
+func (UnimplementedModerationServiceServer) ApproveReviewItem(context.Context, *ApproveReviewItemReq) (*ApproveReviewItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReviewItem not implemented")
+}
+func (UnimplementedModerationServiceServer) RejectReviewItem(context.Context, *RejectReviewItemReq) (*RejectReviewItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectReviewItem not implemented")
+}
+func (UnimplementedModerationServiceServer) EditReviewItem(context.Context, *EditReviewItemReq) (*EditReviewItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditReviewItem not implemented")
+}
+func (UnimplementedModerationServiceServer) mustEmbedUnimplementedModerationServiceServer() {}
+func (UnimplementedModerationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ModerationServiceServer will
+// result in compilation errors.
+type UnsafeModerationServiceServer interface {
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+func RegisterModerationServiceServer(s grpc.ServiceRegistrar, srv ModerationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedModerationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ModerationService_ServiceDesc, srv)
+}
+
+func _ModerationService_ListReviewItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListReviewItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListReviewItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListReviewItems(ctx, req.(*ListReviewItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ClaimReviewItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimReviewItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ClaimReviewItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ClaimReviewItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ClaimReviewItem(ctx, req.(*ClaimReviewItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ApproveReviewItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReviewItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ApproveReviewItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ApproveReviewItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ApproveReviewItem(ctx, req.(*ApproveReviewItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_RejectReviewItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectReviewItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).RejectReviewItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_RejectReviewItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).RejectReviewItem(ctx, req.(*RejectReviewItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_EditReviewItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditReviewItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).EditReviewItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_EditReviewItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).EditReviewItem(ctx, req.(*EditReviewItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ModerationService_ServiceDesc is the grpc.ServiceDesc for ModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moderation.ModerationService",
+	HandlerType: (*ModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListReviewItems",
+			Handler:    _ModerationService_ListReviewItems_Handler,
+		},
+		{
+			MethodName: "ClaimReviewItem",
+			Handler:    _ModerationService_ClaimReviewItem_Handler,
+		},
+		{
+			MethodName: "ApproveReviewItem",
+			Handler:    _ModerationService_ApproveReviewItem_Handler,
+		},
+		{
+			MethodName: "RejectReviewItem",
+			Handler:    _ModerationService_RejectReviewItem_Handler,
+		},
+		{
+			MethodName: "EditReviewItem",
+			Handler:    _ModerationService_EditReviewItem_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "app/moderation/rpc/moderation.proto",
+}
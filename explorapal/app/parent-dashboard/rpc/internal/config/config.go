@@ -0,0 +1,37 @@
+package config
+
+import (
+	"explorapal/pkg/idgen"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	zrpc.RpcServerConf
+
+	// JWT配置，与API服务使用同一密钥校验透传的访问令牌
+	JwtAuth struct {
+		AccessSecret string
+	}
+
+	// 数据库配置
+	DBConfig struct {
+		DataSource string
+	}
+
+	// 缓存配置
+	Cache cache.CacheConf
+
+	// ID生成配置，同时运行的实例机器号不能重复
+	IDGen idgen.Config
+
+	// 阿里云DashScope配置，用于生成学习亮点
+	DashScope struct {
+		APIKey      string
+		BaseURL     string
+		Timeout     int
+		MaxTokens   int
+		Temperature float32
+	}
+}
@@ -0,0 +1,107 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"explorapal/app/parent-dashboard/rpc/internal/svc"
+	"explorapal/app/parent-dashboard/rpc/parentdashboard"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/dashboard"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const dateLayout = "2006-01-02"
+
+// 汇总的业务错误对应的状态码
+var errorStatuses = map[error]int32{
+	auth.ErrTokenInvalid:        401,
+	dashboard.ErrNotParent:      403,
+	dashboard.ErrChildNotLinked: 404,
+	dashboard.ErrInvalidPeriod:  400,
+}
+
+type GetChildSummaryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetChildSummaryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetChildSummaryLogic {
+	return &GetChildSummaryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取孩子在时间段内的探索汇总，只有已关联该孩子的家长可以查看
+func (l *GetChildSummaryLogic) GetChildSummary(in *parentdashboard.GetChildSummaryReq) (*parentdashboard.GetChildSummaryResp, error) {
+	summary, err := l.summarize(in)
+	if err != nil {
+		if status, ok := errorStatuses[err]; ok {
+			return &parentdashboard.GetChildSummaryResp{
+				Status: status,
+				Msg:    err.Error(),
+			}, nil
+		}
+		l.Logger.Errorf("获取孩子探索汇总失败: %v", err)
+		return &parentdashboard.GetChildSummaryResp{
+			Status: 500,
+			Msg:    "获取孩子探索汇总失败",
+		}, err
+	}
+
+	return &parentdashboard.GetChildSummaryResp{
+		Status:  200,
+		Msg:     "获取孩子探索汇总成功",
+		Summary: toChildSummary(summary),
+	}, nil
+}
+
+func (l *GetChildSummaryLogic) summarize(in *parentdashboard.GetChildSummaryReq) (*dashboard.Summary, error) {
+	period, err := dashboard.ParsePeriod(in.StartDate, in.EndDate, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return l.svcCtx.Dashboard.Summarize(l.ctx, in.ChildId, period)
+}
+
+func toChildSummary(summary *dashboard.Summary) *parentdashboard.ChildSummary {
+	return &parentdashboard.ChildSummary{
+		ChildId:           summary.ChildID,
+		Nickname:          summary.Nickname,
+		StartDate:         summary.Period.From.Format(dateLayout),
+		EndDate:           summary.Period.To.AddDate(0, 0, -1).Format(dateLayout),
+		ProjectsStarted:   summary.ProjectsStarted,
+		ProjectsCompleted: summary.ProjectsCompleted,
+		Observations:      toCategoryCounts(summary.Observations),
+		ObservationTotal:  summary.ObservationTotal,
+		QuestionsAnswered: summary.QuestionsAnswered,
+		Expressions:       toCategoryCounts(summary.Expressions),
+		ExpressionTotal:   summary.ExpressionTotal,
+		Achievements:      toCategoryCounts(summary.Achievements),
+		AchievementTotal:  summary.AchievementTotal,
+		TimeOfDay: &parentdashboard.TimeOfDay{
+			Hours:     summary.TimeOfDay.Hours,
+			Morning:   summary.TimeOfDay.Morning,
+			Afternoon: summary.TimeOfDay.Afternoon,
+			Evening:   summary.TimeOfDay.Evening,
+			Night:     summary.TimeOfDay.Night,
+			Peak:      summary.TimeOfDay.Peak,
+		},
+		Highlights: summary.Highlights,
+	}
+}
+
+func toCategoryCounts(counts []dashboard.Count) []*parentdashboard.CategoryCount {
+	result := make([]*parentdashboard.CategoryCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, &parentdashboard.CategoryCount{
+			Name:  c.Name,
+			Total: c.Total,
+		})
+	}
+	return result
+}
@@ -0,0 +1,48 @@
+package svc
+
+import (
+	"explorapal/app/model/hps"
+	"explorapal/app/parent-dashboard/rpc/internal/config"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/dashboard"
+	"explorapal/third/openai"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type ServiceContext struct {
+	Config config.Config
+
+	// 访问令牌校验
+	Auth *auth.Issuer
+
+	// 孩子探索汇总
+	Dashboard *dashboard.Builder
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	conn := sqlx.NewMysql(c.DBConfig.DataSource)
+
+	return &ServiceContext{
+		Config: c,
+
+		Auth: auth.NewIssuer(&auth.Config{AccessSecret: c.JwtAuth.AccessSecret}),
+
+		Dashboard: dashboard.NewBuilder(&dashboard.Models{
+			Users:             hps.NewUsersModel(conn, c.Cache),
+			GuardianLinks:     hps.NewGuardianLinksModel(conn, c.Cache),
+			Projects:          hps.NewProjectsModel(conn, c.Cache),
+			Observations:      hps.NewObservationsModel(conn, c.Cache),
+			Questions:         hps.NewQuestionsModel(conn, c.Cache),
+			Expressions:       hps.NewExpressionsModel(conn, c.Cache),
+			Achievements:      hps.NewAchievementsModel(conn, c.Cache),
+			ProjectActivities: hps.NewProjectActivitiesModel(conn, c.Cache),
+		}, openai.NewClient(&openai.Config{
+			APIKey:      c.DashScope.APIKey,
+			BaseURL:     c.DashScope.BaseURL,
+			Timeout:     c.DashScope.Timeout,
+			MaxTokens:   c.DashScope.MaxTokens,
+			Temperature: c.DashScope.Temperature,
+		})),
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"explorapal/app/parent-dashboard/rpc/internal/config"
+	"explorapal/app/parent-dashboard/rpc/internal/server"
+	"explorapal/app/parent-dashboard/rpc/internal/svc"
+	"explorapal/app/parent-dashboard/rpc/parentdashboard"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/idgen"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+var configFile = flag.String("f", "etc/parentdashboard.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	idgen.MustSetup(c.IDGen)
+	ctx := svc.NewServiceContext(c)
+
+	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
+		parentdashboard.RegisterParentDashboardServiceServer(grpcServer, server.NewParentDashboardServiceServer(ctx))
+
+		if c.Mode == service.DevMode || c.Mode == service.TestMode {
+			reflection.Register(grpcServer)
+		}
+	})
+	defer s.Stop()
+
+	// 身份认证：只有已关联孩子的家长可以查看汇总，具体关联在业务逻辑中校验
+	s.AddUnaryInterceptors(auth.UnaryServerInterceptor(ctx.Auth))
+
+	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
+	s.Start()
+}
@@ -0,0 +1,585 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: app/parent-dashboard/rpc/parent-dashboard.proto
+
+package parentdashboard
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CategoryCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryCount) Reset() {
+	*x = CategoryCount{}
+	mi := &file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryCount) ProtoMessage() {}
+
+func (x *CategoryCount) ProtoReflect() protoreflect.Message {
+	mi := &file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryCount.ProtoReflect.Descriptor instead.
+func (*CategoryCount) Descriptor() ([]byte, []int) {
+	return file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CategoryCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryCount) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 活动的时间分布：hours为每小时的活动次数，下标为小时；peak为活动最多的时段
+type TimeOfDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         []int64                `protobuf:"varint,1,rep,packed,name=hours,proto3" json:"hours,omitempty"`
+	Morning       int64                  `protobuf:"varint,2,opt,name=morning,proto3" json:"morning,omitempty"`
+	Afternoon     int64                  `protobuf:"varint,3,opt,name=afternoon,proto3" json:"afternoon,omitempty"`
+	Evening       int64                  `protobuf:"varint,4,opt,name=evening,proto3" json:"evening,omitempty"`
+	Night         int64                  `protobuf:"varint,5,opt,name=night,proto3" json:"night,omitempty"`
+	Peak          string                 `protobuf:"bytes,6,opt,name=peak,proto3" json:"peak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeOfDay) Reset() {
+	*x = TimeOfDay{}
+	mi := &file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeOfDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeOfDay) ProtoMessage() {}
+
+func (x *TimeOfDay) ProtoReflect() protoreflect.Message {
+	mi := &file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeOfDay.ProtoReflect.Descriptor instead.
+func (*TimeOfDay) Descriptor() ([]byte, []int) {
+	return file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimeOfDay) GetHours() []int64 {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *TimeOfDay) GetMorning() int64 {
+	if x != nil {
+		return x.Morning
+	}
+	return 0
+}
+
+func (x *TimeOfDay) GetAfternoon() int64 {
+	if x != nil {
+		return x.Afternoon
+	}
+	return 0
+}
+
+func (x *TimeOfDay) GetEvening() int64 {
+	if x != nil {
+		return x.Evening
+	}
+	return 0
+}
+
+func (x *TimeOfDay) GetNight() int64 {
+	if x != nil {
+		return x.Night
+	}
+	return 0
+}
+
+func (x *TimeOfDay) GetPeak() string {
+	if x != nil {
+		return x.Peak
+	}
+	return ""
+}
+
+type ChildSummary struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ChildId           int64                  `protobuf:"varint,1,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	Nickname          string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	StartDate         string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	ProjectsStarted   int64                  `protobuf:"varint,5,opt,name=projects_started,json=projectsStarted,proto3" json:"projects_started,omitempty"`
+	ProjectsCompleted int64                  `protobuf:"varint,6,opt,name=projects_completed,json=projectsCompleted,proto3" json:"projects_completed,omitempty"`
+	Observations      []*CategoryCount       `protobuf:"bytes,7,rep,name=observations,proto3" json:"observations,omitempty"`
+	ObservationTotal  int64                  `protobuf:"varint,8,opt,name=observation_total,json=observationTotal,proto3" json:"observation_total,omitempty"`
+	QuestionsAnswered int64                  `protobuf:"varint,9,opt,name=questions_answered,json=questionsAnswered,proto3" json:"questions_answered,omitempty"`
+	Expressions       []*CategoryCount       `protobuf:"bytes,10,rep,name=expressions,proto3" json:"expressions,omitempty"`
+	ExpressionTotal   int64                  `protobuf:"varint,11,opt,name=expression_total,json=expressionTotal,proto3" json:"expression_total,omitempty"`
+	Achievements      []*CategoryCount       `protobuf:"bytes,12,rep,name=achievements,proto3" json:"achievements,omitempty"`
+	AchievementTotal  int64                  `protobuf:"varint,13,opt,name=achievement_total,json=achievementTotal,proto3" json:"achievement_total,omitempty"`
+	TimeOfDay         *TimeOfDay             `protobuf:"bytes,14,opt,name=time_of_day,json=timeOfDay,proto3" json:"time_of_day,omitempty"`
+	Highlights        []string               `protobuf:"bytes,15,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChildSummary) Reset() {
+	*x = ChildSummary{}
+	mi := &file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChildSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildSummary) ProtoMessage() {}
+
+func (x *ChildSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildSummary.ProtoReflect.Descriptor instead.
+func (*ChildSummary) Descriptor() ([]byte, []int) {
+	return file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChildSummary) GetChildId() int64 {
+	if x != nil {
+		return x.ChildId
+	}
+	return 0
+}
+
+func (x *ChildSummary) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *ChildSummary) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ChildSummary) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ChildSummary) GetProjectsStarted() int64 {
+	if x != nil {
+		return x.ProjectsStarted
+	}
+	return 0
+}
+
+func (x *ChildSummary) GetProjectsCompleted() int64 {
+	if x != nil {
+		return x.ProjectsCompleted
+	}
+	return 0
+}
+
+func (x *ChildSummary) GetObservations() []*CategoryCount {
+	if x != nil {
+		return x.Observations
+	}
+	return nil
+}
+
+func (x *ChildSummary) GetObservationTotal() int64 {
+	if x != nil {
+		return x.ObservationTotal
+	}
+	return 0
+}
+
+func (x *ChildSummary) GetQuestionsAnswered() int64 {
+	if x != nil {
+		return x.QuestionsAnswered
+	}
+	return 0
+}
+
+func (x *ChildSummary) GetExpressions() []*CategoryCount {
+	if x != nil {
+		return x.Expressions
+	}
+	return nil
+}
+
+func (x *ChildSummary) GetExpressionTotal() int64 {
+	if x != nil {
+		return x.ExpressionTotal
+	}
+	return 0
+}
+
+func (x *ChildSummary) GetAchievements() []*CategoryCount {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+func (x *ChildSummary) GetAchievementTotal() int64 {
+	if x != nil {
+		return x.AchievementTotal
+	}
+	return 0
+}
+
+func (x *ChildSummary) GetTimeOfDay() *TimeOfDay {
+	if x != nil {
+		return x.TimeOfDay
+	}
+	return nil
+}
+
+func (x *ChildSummary) GetHighlights() []string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// 起止日期格式为YYYY-MM-DD，结束日期包含当天；都留空时为最近7天
+type GetChildSummaryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChildId       int64                  `protobuf:"varint,1,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChildSummaryReq) Reset() {
+	*x = GetChildSummaryReq{}
+	mi := &file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChildSummaryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildSummaryReq) ProtoMessage() {}
+
+func (x *GetChildSummaryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildSummaryReq.ProtoReflect.Descriptor instead.
+func (*GetChildSummaryReq) Descriptor() ([]byte, []int) {
+	return file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetChildSummaryReq) GetChildId() int64 {
+	if x != nil {
+		return x.ChildId
+	}
+	return 0
+}
+
+func (x *GetChildSummaryReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetChildSummaryReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetChildSummaryResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Summary       *ChildSummary          `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChildSummaryResp) Reset() {
+	*x = GetChildSummaryResp{}
+	mi := &file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChildSummaryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildSummaryResp) ProtoMessage() {}
+
+func (x *GetChildSummaryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildSummaryResp.ProtoReflect.Descriptor instead.
+func (*GetChildSummaryResp) Descriptor() ([]byte, []int) {
+	return file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetChildSummaryResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetChildSummaryResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetChildSummaryResp) GetSummary() *ChildSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+var File_app_parent_dashboard_rpc_parent_dashboard_proto protoreflect.FileDescriptor
+
+var file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDesc = string([]byte{
+	0x0a, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x2d, 0x64, 0x61, 0x73,
+	0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x2d, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x22, 0x39, 0x0a, 0x0d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x9d, 0x01,
+	0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x68,
+	0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x6e, 0x6f, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x6e, 0x6f, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x61,
+	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x61, 0x6b, 0x22, 0xb3, 0x05,
+	0x0a, 0x0c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0c, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x0c, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6f, 0x66, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66,
+	0x44, 0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x22, 0x69, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x78,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x37, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x32, 0x76, 0x0a, 0x16, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x64, 0x61,
+	0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x13, 0x5a, 0x11, 0x2e, 0x2f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x64, 0x61, 0x73, 0x68,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescOnce sync.Once
+	file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescData []byte
+)
+
+func file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescGZIP() []byte {
+	file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescOnce.Do(func() {
+		file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDesc), len(file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDesc)))
+	})
+	return file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDescData
+}
+
+var file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_app_parent_dashboard_rpc_parent_dashboard_proto_goTypes = []any{
+	(*CategoryCount)(nil),       // 0: parentdashboard.CategoryCount
+	(*TimeOfDay)(nil),           // 1: parentdashboard.TimeOfDay
+	(*ChildSummary)(nil),        // 2: parentdashboard.ChildSummary
+	(*GetChildSummaryReq)(nil),  // 3: parentdashboard.GetChildSummaryReq
+	(*GetChildSummaryResp)(nil), // 4: parentdashboard.GetChildSummaryResp
+}
+var file_app_parent_dashboard_rpc_parent_dashboard_proto_depIdxs = []int32{
+	0, // 0: parentdashboard.ChildSummary.observations:type_name -> parentdashboard.CategoryCount
+	0, // 1: parentdashboard.ChildSummary.expressions:type_name -> parentdashboard.CategoryCount
+	0, // 2: parentdashboard.ChildSummary.achievements:type_name -> parentdashboard.CategoryCount
+	1, // 3: parentdashboard.ChildSummary.time_of_day:type_name -> parentdashboard.TimeOfDay
+	2, // 4: parentdashboard.GetChildSummaryResp.summary:type_name -> parentdashboard.ChildSummary
+	3, // 5: parentdashboard.ParentDashboardService.GetChildSummary:input_type -> parentdashboard.GetChildSummaryReq
+	4, // 6: parentdashboard.ParentDashboardService.GetChildSummary:output_type -> parentdashboard.GetChildSummaryResp
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_app_parent_dashboard_rpc_parent_dashboard_proto_init() }
+func file_app_parent_dashboard_rpc_parent_dashboard_proto_init() {
+	if File_app_parent_dashboard_rpc_parent_dashboard_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDesc), len(file_app_parent_dashboard_rpc_parent_dashboard_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_app_parent_dashboard_rpc_parent_dashboard_proto_goTypes,
+		DependencyIndexes: file_app_parent_dashboard_rpc_parent_dashboard_proto_depIdxs,
+		MessageInfos:      file_app_parent_dashboard_rpc_parent_dashboard_proto_msgTypes,
+	}.Build()
+	File_app_parent_dashboard_rpc_parent_dashboard_proto = out.File
+	file_app_parent_dashboard_rpc_parent_dashboard_proto_goTypes = nil
+	file_app_parent_dashboard_rpc_parent_dashboard_proto_depIdxs = nil
+}
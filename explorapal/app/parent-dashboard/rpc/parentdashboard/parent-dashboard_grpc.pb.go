@@ -0,0 +1,122 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: app/parent-dashboard/rpc/parent-dashboard.proto
+
+package parentdashboard
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ParentDashboardService_GetChildSummary_FullMethodName = "/parentdashboard.ParentDashboardService/GetChildSummary"
+)
+
+// ParentDashboardServiceClient is the client API for ParentDashboardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ParentDashboardServiceClient interface {
+	GetChildSummary(ctx context.Context, in *GetChildSummaryReq, opts ...grpc.CallOption) (*GetChildSummaryResp, error)
+}
+
+type parentDashboardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewParentDashboardServiceClient(cc grpc.ClientConnInterface) ParentDashboardServiceClient {
+	return &parentDashboardServiceClient{cc}
+}
+
+func (c *parentDashboardServiceClient) GetChildSummary(ctx context.Context, in *GetChildSummaryReq, opts ...grpc.CallOption) (*GetChildSummaryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChildSummaryResp)
+	err := c.cc.Invoke(ctx, ParentDashboardService_GetChildSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ParentDashboardServiceServer is the server API for ParentDashboardService service.
+// All implementations must embed UnimplementedParentDashboardServiceServer
+// for forward compatibility.
+type ParentDashboardServiceServer interface {
+	GetChildSummary(context.Context, *GetChildSummaryReq) (*GetChildSummaryResp, error)
+	mustEmbedUnimplementedParentDashboardServiceServer()
+}
+
+// UnimplementedParentDashboardServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedParentDashboardServiceServer struct{}
+
+func (UnimplementedParentDashboardServiceServer) GetChildSummary(context.Context, *GetChildSummaryReq) (*GetChildSummaryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChildSummary not implemented")
+}
+func (UnimplementedParentDashboardServiceServer) mustEmbedUnimplementedParentDashboardServiceServer() {
+}
+func (UnimplementedParentDashboardServiceServer) testEmbeddedByValue() {}
+
+// UnsafeParentDashboardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ParentDashboardServiceServer will
+// result in compilation errors.
+type UnsafeParentDashboardServiceServer interface {
+	mustEmbedUnimplementedParentDashboardServiceServer()
+}
+
+func RegisterParentDashboardServiceServer(s grpc.ServiceRegistrar, srv ParentDashboardServiceServer) {
+	// If the following call pancis, it indicates UnimplementedParentDashboardServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ParentDashboardService_ServiceDesc, srv)
+}
+
+func _ParentDashboardService_GetChildSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChildSummaryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParentDashboardServiceServer).GetChildSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParentDashboardService_GetChildSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParentDashboardServiceServer).GetChildSummary(ctx, req.(*GetChildSummaryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ParentDashboardService_ServiceDesc is the grpc.ServiceDesc for ParentDashboardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ParentDashboardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "parentdashboard.ParentDashboardService",
+	HandlerType: (*ParentDashboardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChildSummary",
+			Handler:    _ParentDashboardService_GetChildSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "app/parent-dashboard/rpc/parent-dashboard.proto",
+}
@@ -2,17 +2,22 @@ package logic
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"time"
 
 	"explorapal/app/model/hps"
 	"explorapal/app/project-management/rpc/internal/svc"
 	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/idgen"
 
 	"github.com/zeromicro/go-zero/core/logx"
-	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
+var errTemplateNotFound = errors.New("项目模板不存在")
+
 type CreateProjectLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -28,29 +33,87 @@ func NewCreateProjectLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Cre
 }
 
 func (l *CreateProjectLogic) CreateProject(in *projectmanagement.CreateProjectReq) (*projectmanagement.CreateProjectResp, error) {
-	// 生成项目ID和项目编码
-	projectID := time.Now().UnixNano()
-	projectCode := l.generateProjectCode(projectID)
+	userID := auth.UserID(l.ctx)
+
+	// 使用模板时，未填写的标题、类别、描述和标签取模板的设置
+	var template *hps.ProjectTemplates
+	var milestones []hps.TemplateMilestone
+	var prompts []string
+	if in.TemplateId != 0 {
+		var err error
+		template, milestones, prompts, err = l.applyTemplate(in)
+		if err == errTemplateNotFound {
+			return &projectmanagement.CreateProjectResp{
+				Status: 404,
+				Msg:    err.Error(),
+			}, nil
+		}
+		if err != nil {
+			l.Logger.Errorf("获取项目模板失败: %v", err)
+			return &projectmanagement.CreateProjectResp{
+				Status: 500,
+				Msg:    "获取项目模板失败",
+			}, err
+		}
+	}
+	if in.Title == "" || in.Category == "" {
+		return &projectmanagement.CreateProjectResp{
+			Status: 400,
+			Msg:    "项目标题和类别不能为空",
+		}, nil
+	}
 
 	// 创建项目记录
+	projectID := idgen.Next()
 	project := &hps.Projects{
-		ProjectID:   projectID,
-		ProjectCode: projectCode,
-		UserID:      in.UserId,
+		ProjectId:   projectID,
+		ProjectCode: idgen.ProjectCode(),
+		UserId:      userID,
 		Title:       in.Title,
-		Description: in.Description,
+		Description: sql.NullString{String: in.Description, Valid: in.Description != ""},
 		Category:    in.Category,
 		Status:      "active",
 		Progress:    0,
 	}
+	if template != nil {
+		project.TemplateId = sql.NullInt64{Int64: template.TemplateId, Valid: true}
+	}
 
 	// 设置标签
 	if len(in.Tags) > 0 {
-		project.SetTags(in.Tags)
+		tags, _ := json.Marshal(in.Tags)
+		project.Tags = sql.NullString{String: string(tags), Valid: true}
+	}
+
+	// 记录活动
+	description := fmt.Sprintf("创建了项目：%s", in.Title)
+	if template != nil {
+		description = fmt.Sprintf("使用模板「%s」创建了项目：%s", template.Title, in.Title)
+	}
+	activity := &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   projectID,
+		UserId:      userID,
+		Type:        hps.ActivityTypeCreateProject,
+		Description: description,
 	}
 
-	// 插入数据库
-	_, err := l.svcCtx.ProjectModel.Insert(l.ctx, project)
+	// 项目、任务清单和创建活动在同一个事务中写入
+	var copied []*hps.ProjectMilestones
+	err := l.svcCtx.UnitOfWork.Do(l.ctx, func(ctx context.Context, tx *hps.Models) error {
+		if _, err := tx.Projects.InsertWithCode(ctx, project, idgen.ProjectCode); err != nil {
+			return fmt.Errorf("保存项目失败: %w", err)
+		}
+		// 复制模板的里程碑作为任务清单
+		var err error
+		if copied, err = copyMilestones(ctx, tx.ProjectMilestones, projectID, milestones); err != nil {
+			return err
+		}
+		if _, err := tx.ProjectActivities.Insert(ctx, activity); err != nil {
+			return fmt.Errorf("记录项目活动失败: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
 		l.Logger.Errorf("创建项目失败: %v", err)
 		return &projectmanagement.CreateProjectResp{
@@ -59,32 +122,52 @@ func (l *CreateProjectLogic) CreateProject(in *projectmanagement.CreateProjectRe
 		}, err
 	}
 
-	// 记录活动
-	activity := &hps.ProjectActivities{
-		ActivityID:  time.Now().UnixNano(),
-		ProjectID:   projectID,
-		UserID:      in.UserId,
-		Type:        "create_project",
-		Description: fmt.Sprintf("创建了项目：%s", in.Title),
+	l.Logger.Infof("用户 %d 创建项目成功: %s", userID, project.ProjectCode)
+
+	resp := &projectmanagement.CreateProjectResp{
+		Status:         200,
+		Msg:            "创建项目成功",
+		ProjectId:      projectID,
+		ProjectCode:    project.ProjectCode,
+		StarterPrompts: prompts,
 	}
-	_, err = l.svcCtx.ProjectActivityModel.Insert(l.ctx, activity)
-	if err != nil {
-		l.Logger.Errorf("记录项目活动失败: %v", err)
-		// 不影响主要流程，只记录错误
+	for _, m := range copied {
+		resp.Milestones = append(resp.Milestones, toProjectMilestone(m))
 	}
-
-	l.Logger.Infof("用户 %d 创建项目成功: %s", in.UserId, projectCode)
-
-	return &projectmanagement.CreateProjectResp{
-		Status:      200,
-		Msg:         "创建项目成功",
-		ProjectId:   projectID,
-		ProjectCode: projectCode,
-	}, nil
+	return resp, nil
 }
 
-// 生成项目编码
-func (l *CreateProjectLogic) generateProjectCode(projectID int64) string {
-	timestamp := time.Now().Format("20060102")
-	return fmt.Sprintf("EXP-%s-%d", timestamp, projectID%100000)
+// applyTemplate 查询可用的模板并补全请求中未填写的字段，返回模板、里程碑和开场引导语
+func (l *CreateProjectLogic) applyTemplate(in *projectmanagement.CreateProjectReq) (*hps.ProjectTemplates, []hps.TemplateMilestone, []string, error) {
+	template, err := l.svcCtx.ProjectTemplateModel.FindOneByTemplateId(l.ctx, in.TemplateId)
+	if err == hps.ErrNotFound || (err == nil && (template.DeleteTime.Valid || template.Status != hps.TemplateStatusActive)) {
+		return nil, nil, nil, errTemplateNotFound
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	milestones, err := template.GetMilestones()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("解析模板里程碑失败: %w", err)
+	}
+	prompts, err := template.GetStarterPrompts()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("解析模板引导语失败: %w", err)
+	}
+	if len(in.Tags) == 0 {
+		if in.Tags, err = template.GetSuggestedTags(); err != nil {
+			return nil, nil, nil, fmt.Errorf("解析模板推荐标签失败: %w", err)
+		}
+	}
+	if in.Title == "" {
+		in.Title = template.Title
+	}
+	if in.Category == "" {
+		in.Category = template.Category
+	}
+	if in.Description == "" {
+		in.Description = template.Description.String
+	}
+	return template, milestones, prompts, nil
 }
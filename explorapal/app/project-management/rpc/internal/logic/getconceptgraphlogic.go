@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/project-management/rpc/internal/svc"
+	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetConceptGraphLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetConceptGraphLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetConceptGraphLogic {
+	return &GetConceptGraphLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 导出概念图的节点和边，供前端可视化
+func (l *GetConceptGraphLogic) GetConceptGraph(in *projectmanagement.GetConceptGraphReq) (*projectmanagement.GetConceptGraphResp, error) {
+	userID := auth.UserID(l.ctx)
+	if in.ProjectId != 0 {
+		_, err := l.svcCtx.ProjectModel.FindOneOwned(l.ctx, in.ProjectId, userID)
+		if err == hps.ErrNotFound {
+			return &projectmanagement.GetConceptGraphResp{
+				Status: 404,
+				Msg:    "项目不存在",
+			}, nil
+		}
+		if err != nil {
+			l.Logger.Errorf("查询项目失败: %v", err)
+			return &projectmanagement.GetConceptGraphResp{
+				Status: 500,
+				Msg:    "获取概念图失败",
+			}, err
+		}
+	}
+
+	graph, err := l.svcCtx.Concepts.Export(l.ctx, userID, in.ProjectId)
+	if err != nil {
+		l.Logger.Errorf("获取概念图失败: %v", err)
+		return &projectmanagement.GetConceptGraphResp{
+			Status: 500,
+			Msg:    "获取概念图失败",
+		}, err
+	}
+	data, err := json.Marshal(graph)
+	if err != nil {
+		l.Logger.Errorf("序列化概念图失败: %v", err)
+		return &projectmanagement.GetConceptGraphResp{
+			Status: 500,
+			Msg:    "获取概念图失败",
+		}, err
+	}
+
+	return &projectmanagement.GetConceptGraphResp{
+		Status: 200,
+		Msg:    "获取概念图成功",
+		Graph:  string(data),
+	}, nil
+}
@@ -3,10 +3,23 @@ package logic
 import (
 	"context"
 
+	"explorapal/app/model/hps"
 	"explorapal/app/project-management/rpc/internal/svc"
 	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/moderation"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/mr"
+)
+
+// 项目详情中各类记录的最大数量
+const (
+	detailObservationLimit = 50
+	detailQuestionLimit    = 50
+	detailExpressionLimit  = 50
+	detailAchievementLimit = 20
+	detailActivityLimit    = 30
 )
 
 type GetProjectDetailLogic struct {
@@ -23,9 +36,16 @@ func NewGetProjectDetailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 	}
 }
 
+// 查询项目详情，并发加载观察、问题、表达、成果、活动和里程碑
 func (l *GetProjectDetailLogic) GetProjectDetail(in *projectmanagement.GetProjectDetailReq) (*projectmanagement.GetProjectDetailResp, error) {
-	// 获取项目基本信息
-	project, err := l.svcCtx.ProjectModel.FindOne(l.ctx, in.ProjectId)
+	// 只能查看自己的项目，不属于调用方的项目按不存在处理
+	project, err := l.svcCtx.ProjectModel.FindOneOwned(l.ctx, in.ProjectId, auth.UserID(l.ctx))
+	if err == hps.ErrNotFound {
+		return &projectmanagement.GetProjectDetailResp{
+			Status: 404,
+			Msg:    "项目不存在",
+		}, nil
+	}
 	if err != nil {
 		l.Logger.Errorf("获取项目详情失败: %v", err)
 		return &projectmanagement.GetProjectDetailResp{
@@ -34,26 +54,177 @@ func (l *GetProjectDetailLogic) GetProjectDetail(in *projectmanagement.GetProjec
 		}, err
 	}
 
-	// 转换项目信息
-	tags, _ := project.GetTags()
-	projectDetail := &projectmanagement.ProjectDetail{
-		ProjectId:     project.ProjectID,
-		ProjectCode:   project.ProjectCode,
-		Title:         project.Title,
-		Description:   project.Description,
-		Category:      project.Category,
-		Status:        project.Status,
-		Progress:      project.Progress,
-		CreateTime:    project.CreateTime.Format("2006-01-02 15:04:05"),
-		UpdateTime:    project.UpdateTime.Format("2006-01-02 15:04:05"),
-		LastActivity:  project.LastActivityAt.Time.Format("2006-01-02 15:04:05"),
-		Tags:          tags,
-		// 暂时简化，其他字段后续实现
-	}
-
-	return &projectmanagement.GetProjectDetailResp{
-		Status:  200,
-		Msg:     "获取项目详情成功",
-		Project: projectDetail,
-	}, nil
+	var (
+		observations []*hps.Observations
+		questions    []*hps.Questions
+		expressions  []*hps.Expressions
+		achievements []*hps.Achievements
+		activities   []*hps.ProjectActivities
+		milestones   []*hps.ProjectMilestones
+	)
+	err = mr.Finish(func() (err error) {
+		observations, err = l.svcCtx.ObservationModel.FindByProject(l.ctx, project.ProjectId, detailObservationLimit)
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		questions, err = l.svcCtx.QuestionModel.FindByProject(l.ctx, project.ProjectId, detailQuestionLimit)
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		expressions, err = l.svcCtx.ExpressionModel.FindByProject(l.ctx, project.ProjectId, detailExpressionLimit)
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		achievements, err = l.svcCtx.AchievementModel.FindByProject(l.ctx, project.ProjectId, detailAchievementLimit)
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		activities, err = l.svcCtx.ProjectActivityModel.FindByProject(l.ctx, project.ProjectId, detailActivityLimit)
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		milestones, err = l.svcCtx.ProjectMilestoneModel.FindByProject(l.ctx, project.ProjectId)
+		return ignoreNotFound(err)
+	})
+	if err != nil {
+		l.Logger.Errorf("获取项目记录失败: %v", err)
+		return &projectmanagement.GetProjectDetailResp{
+			Status: 500,
+			Msg:    "获取项目详情失败",
+		}, err
+	}
+
+	info := toProjectInfo(project)
+	detail := &projectmanagement.ProjectDetail{
+		ProjectId:    info.ProjectId,
+		ProjectCode:  info.ProjectCode,
+		Title:        info.Title,
+		Description:  info.Description,
+		Category:     info.Category,
+		Status:       info.Status,
+		Progress:     info.Progress,
+		CreateTime:   info.CreateTime,
+		UpdateTime:   info.UpdateTime,
+		LastActivity: info.LastActivity,
+		Tags:         info.Tags,
+		Observations: make([]*projectmanagement.ObservationInfo, 0, len(observations)),
+		Questions:    make([]*projectmanagement.QuestionInfo, 0, len(questions)),
+		Expressions:  make([]*projectmanagement.ExpressionInfo, 0, len(expressions)),
+	}
+	for _, o := range observations {
+		detail.Observations = append(detail.Observations, toObservationInfo(o))
+	}
+	for _, q := range questions {
+		detail.Questions = append(detail.Questions, toQuestionInfo(q))
+	}
+	for _, e := range expressions {
+		detail.Expressions = append(detail.Expressions, toExpressionInfo(e))
+	}
+
+	resp := &projectmanagement.GetProjectDetailResp{
+		Status:       200,
+		Msg:          "获取项目详情成功",
+		Project:      detail,
+		Activities:   make([]*projectmanagement.ProjectActivity, 0, len(activities)),
+		Achievements: make([]*projectmanagement.ProjectAchievement, 0, len(achievements)),
+		Milestones:   make([]*projectmanagement.ProjectMilestone, 0, len(milestones)),
+		NextActions:  toStatusActions(project.Status),
+	}
+	for _, a := range activities {
+		resp.Activities = append(resp.Activities, toProjectActivity(a))
+	}
+	for _, a := range achievements {
+		// 驳回的成果不展示给孩子
+		if a.ReviewStatus == hps.ReviewStatusRejected {
+			continue
+		}
+		resp.Achievements = append(resp.Achievements, toProjectAchievement(a))
+	}
+	for _, m := range milestones {
+		resp.Milestones = append(resp.Milestones, toProjectMilestone(m))
+	}
+	return resp, nil
+}
+
+// toObservationInfo 被拦截的图片在隔离区中，不返回地址；图片或识别结果等待审核时返回提示语，驳回后不返回识别内容
+func toObservationInfo(o *hps.Observations) *projectmanagement.ObservationInfo {
+	info := &projectmanagement.ObservationInfo{
+		ObservationId: o.ObservationId,
+		ImageUrl:      o.ImageUrl,
+		Recognition:   o.ObjectName.String,
+		Status:        o.Status,
+		CreateTime:    o.CreateTime.Format("2006-01-02 15:04:05"),
+	}
+	switch o.Status {
+	case hps.ObservationStatusBlocked:
+		info.ImageUrl = ""
+		info.Recognition = moderation.WaitingMessage
+	case hps.ObservationStatusRejected:
+		info.ImageUrl = ""
+		info.Recognition = ""
+	}
+	// 识别结果等待审核或被驳回时图片仍然可见
+	switch o.ReviewStatus {
+	case hps.ReviewStatusPending:
+		info.Recognition = moderation.WaitingMessage
+	case hps.ReviewStatusRejected:
+		info.Recognition = ""
+	}
+	return info
+}
+
+func toQuestionInfo(q *hps.Questions) *projectmanagement.QuestionInfo {
+	return &projectmanagement.QuestionInfo{
+		QuestionId:   q.QuestionId,
+		Question:     q.Content,
+		Answer:       q.AiAnswer.String,
+		UserResponse: q.UserResponse.String,
+		CreateTime:   q.CreateTime.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// toExpressionInfo 润色笔记等待审核时替换为提示语，驳回时不返回
+func toExpressionInfo(e *hps.Expressions) *projectmanagement.ExpressionInfo {
+	info := &projectmanagement.ExpressionInfo{
+		ExpressionId: e.ExpressionId,
+		Content:      e.RawContent,
+		Type:         e.Type,
+		PolishedNote: e.PolishedFormatted.String,
+		CreateTime:   e.CreateTime.Format("2006-01-02 15:04:05"),
+	}
+	switch e.ReviewStatus {
+	case hps.ReviewStatusPending:
+		info.PolishedNote = moderation.WaitingMessage
+	case hps.ReviewStatusRejected:
+		info.PolishedNote = ""
+	}
+	return info
+}
+
+func toProjectActivity(a *hps.ProjectActivities) *projectmanagement.ProjectActivity {
+	return &projectmanagement.ProjectActivity{
+		ActivityId:  a.ActivityId,
+		Type:        a.Type,
+		Description: a.Description,
+		CreateTime:  a.CreateTime.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// toProjectAchievement 等待审核的成果只返回标题和提示语
+func toProjectAchievement(a *hps.Achievements) *projectmanagement.ProjectAchievement {
+	achievement := &projectmanagement.ProjectAchievement{
+		AchievementId: a.AchievementId,
+		Type:          a.Type,
+		Title:         a.Title,
+		Content:       a.Content,
+		Url:           a.Url.String,
+		CreateTime:    a.CreateTime.Format("2006-01-02 15:04:05"),
+	}
+	if a.ReviewStatus == hps.ReviewStatusPending {
+		achievement.Content = moderation.WaitingMessage
+		achievement.Url = ""
+	}
+	return achievement
+}
+
+func ignoreNotFound(err error) error {
+	if err == hps.ErrNotFound {
+		return nil
+	}
+	return err
 }
@@ -2,13 +2,35 @@ package logic
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"unicode/utf8"
 
+	"explorapal/app/model/hps"
 	"explorapal/app/project-management/rpc/internal/svc"
 	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/projectstatus"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+const (
+	defaultPageSize  = 10
+	maxPageSize      = 100
+	maxKeywordLength = 50
+)
+
+var (
+	errInvalidSort    = errors.New("排序方式不正确")
+	errKeywordTooLong = errors.New("搜索关键词不能超过50个字")
+)
+
+// 不指定状态时列出的项目，已归档的项目需要按状态筛选查看
+var listedStatuses = []string{projectstatus.Active, projectstatus.Paused, projectstatus.Completed}
+
 type GetProjectListLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -23,83 +45,138 @@ func NewGetProjectListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ge
 	}
 }
 
+// 按类别、状态、标签和关键词筛选自己的项目，支持页码分页和游标分页
 func (l *GetProjectListLogic) GetProjectList(in *projectmanagement.GetProjectListReq) (*projectmanagement.GetProjectListResp, error) {
-	var projects []*projectmanagement.ProjectInfo
-	var total int64
+	query, err := l.buildQuery(in)
+	if err != nil {
+		return &projectmanagement.GetProjectListResp{
+			Status: 400,
+			Msg:    err.Error(),
+		}, nil
+	}
 
-	// 根据筛选条件获取项目列表
-	if in.Category != "" {
-		// 按类别筛选
-		projectList, err := l.svcCtx.ProjectModel.FindByCategory(l.ctx, in.UserId, in.Category, in.Page, in.PageSize)
-		if err != nil {
-			l.Logger.Errorf("按类别获取项目列表失败: %v", err)
-			return &projectmanagement.GetProjectListResp{
-				Status: 500,
-				Msg:    "获取项目列表失败",
-			}, err
-		}
+	projects, total, next, err := l.svcCtx.ProjectModel.FindList(l.ctx, query)
+	if err == hps.ErrInvalidCursor {
+		return &projectmanagement.GetProjectListResp{
+			Status: 400,
+			Msg:    err.Error(),
+		}, nil
+	}
+	if err != nil {
+		l.Logger.Errorf("获取项目列表失败: %v", err)
+		return &projectmanagement.GetProjectListResp{
+			Status: 500,
+			Msg:    "获取项目列表失败",
+		}, err
+	}
+
+	list := make([]*projectmanagement.ProjectInfo, 0, len(projects))
+	for _, p := range projects {
+		list = append(list, toProjectInfo(p))
+	}
+
+	return &projectmanagement.GetProjectListResp{
+		Status:     200,
+		Msg:        "获取项目列表成功",
+		List:       list,
+		Total:      total,
+		PageSize:   query.PageSize,
+		Page:       query.Page,
+		NextCursor: encodeCursor(next),
+	}, nil
+}
+
+// buildQuery 校验筛选、排序和分页参数
+func (l *GetProjectListLogic) buildQuery(in *projectmanagement.GetProjectListReq) (*hps.ProjectListQuery, error) {
+	query := &hps.ProjectListQuery{
+		UserID:   auth.UserID(l.ctx),
+		Category: strings.TrimSpace(in.Category),
+		Statuses: listedStatuses,
+		Keyword:  strings.TrimSpace(in.Keyword),
+		Page:     max(in.Page, 1),
+		PageSize: in.PageSize,
+	}
+	if query.PageSize <= 0 {
+		query.PageSize = defaultPageSize
+	}
+	query.PageSize = min(query.PageSize, maxPageSize)
 
-		// 转换数据结构
-		for _, p := range projectList {
-			tags, _ := p.GetTags()
-			projectInfo := &projectmanagement.ProjectInfo{
-				ProjectId:     p.ProjectID,
-				ProjectCode:   p.ProjectCode,
-				Title:         p.Title,
-				Description:   p.Description,
-				Category:      p.Category,
-				Status:        p.Status,
-				Progress:      p.Progress,
-				CreateTime:    p.CreateTime.Format("2006-01-02 15:04:05"),
-				UpdateTime:    p.UpdateTime.Format("2006-01-02 15:04:05"),
-				LastActivity:  p.LastActivityAt.Time.Format("2006-01-02 15:04:05"),
-				Tags:          tags,
-			}
-			projects = append(projects, projectInfo)
+	if in.Status != "" {
+		if !projectstatus.Valid(in.Status) {
+			return nil, projectstatus.ErrInvalidStatus
 		}
+		query.Statuses = []string{in.Status}
+	}
+	for _, tag := range in.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			query.Tags = append(query.Tags, tag)
+		}
+	}
+	if utf8.RuneCountInString(query.Keyword) > maxKeywordLength {
+		return nil, errKeywordTooLong
+	}
+
+	switch in.SortBy {
+	case "", hps.ProjectSortCreateTime, hps.ProjectSortLastActivity, hps.ProjectSortProgress:
+		query.SortBy = in.SortBy
+	default:
+		return nil, errInvalidSort
+	}
+	switch in.SortOrder {
+	case "", "desc":
+	case "asc":
+		query.Asc = true
+	default:
+		return nil, errInvalidSort
+	}
 
-		// 这里简化处理，实际应该查询总数
-		total = int64(len(projects))
-	} else {
-		// 获取所有项目
-		projectList, err := l.svcCtx.ProjectModel.FindByUserID(l.ctx, in.UserId, in.Page, in.PageSize)
+	if in.Cursor != "" {
+		cursor, err := decodeCursor(in.Cursor)
 		if err != nil {
-			l.Logger.Errorf("获取项目列表失败: %v", err)
-			return &projectmanagement.GetProjectListResp{
-				Status: 500,
-				Msg:    "获取项目列表失败",
-			}, err
+			return nil, err
 		}
+		query.Cursor = cursor
+	}
+	return query, nil
+}
 
-		// 转换数据结构
-		for _, p := range projectList {
-			tags, _ := p.GetTags()
-			projectInfo := &projectmanagement.ProjectInfo{
-				ProjectId:     p.ProjectID,
-				ProjectCode:   p.ProjectCode,
-				Title:         p.Title,
-				Description:   p.Description,
-				Category:      p.Category,
-				Status:        p.Status,
-				Progress:      p.Progress,
-				CreateTime:    p.CreateTime.Format("2006-01-02 15:04:05"),
-				UpdateTime:    p.UpdateTime.Format("2006-01-02 15:04:05"),
-				LastActivity:  p.LastActivityAt.Time.Format("2006-01-02 15:04:05"),
-				Tags:          tags,
-			}
-			projects = append(projects, projectInfo)
-		}
+// encodeCursor 游标编码为不透明的字符串返回给调用方
+func encodeCursor(cursor *hps.ProjectCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
 
-		// 这里简化处理，实际应该查询总数
-		total = int64(len(projects))
+func decodeCursor(s string) (*hps.ProjectCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, hps.ErrInvalidCursor
+	}
+	var cursor hps.ProjectCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.SortBy == "" || cursor.Order == "" || cursor.Value == "" || cursor.Id == 0 {
+		return nil, hps.ErrInvalidCursor
 	}
+	return &cursor, nil
+}
 
-	return &projectmanagement.GetProjectListResp{
-		Status:   200,
-		Msg:      "获取项目列表成功",
-		List:     projects,
-		Total:    total,
-		PageSize: in.PageSize,
-		Page:     in.Page,
-	}, nil
+func toProjectInfo(p *hps.Projects) *projectmanagement.ProjectInfo {
+	tags, _ := p.GetTags()
+	info := &projectmanagement.ProjectInfo{
+		ProjectId:   p.ProjectId,
+		ProjectCode: p.ProjectCode,
+		Title:       p.Title,
+		Description: p.Description.String,
+		Category:    p.Category,
+		Status:      p.Status,
+		Progress:    int32(p.Progress),
+		CreateTime:  p.CreateTime.Format("2006-01-02 15:04:05"),
+		UpdateTime:  p.UpdateTime.Format("2006-01-02 15:04:05"),
+		Tags:        tags,
+	}
+	if p.LastActivityAt.Valid {
+		info.LastActivity = p.LastActivityAt.Time.Format("2006-01-02 15:04:05")
+	}
+	return info
 }
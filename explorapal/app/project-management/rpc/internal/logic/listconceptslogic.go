@@ -0,0 +1,134 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/project-management/rpc/internal/svc"
+	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/conceptgraph"
+	"explorapal/pkg/guardian"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 概念列表的默认数量和最大数量
+const (
+	defaultConceptLimit = 50
+	maxConceptLimit     = 200
+)
+
+var errChildNotLinked = errors.New("只有已关联该孩子的家长可以查看")
+
+type ListConceptsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListConceptsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListConceptsLogic {
+	return &ListConceptsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询孩子探索过的概念，按提到的次数从多到少排列；家长可以查询已关联孩子的概念
+func (l *ListConceptsLogic) ListConcepts(in *projectmanagement.ListConceptsReq) (*projectmanagement.ListConceptsResp, error) {
+	switch in.Kind {
+	case "", hps.ConceptKindConcept, hps.ConceptKindCategory, hps.ConceptKindSpecies:
+	default:
+		return &projectmanagement.ListConceptsResp{
+			Status: 400,
+			Msg:    "概念类型不正确",
+		}, nil
+	}
+
+	childID, err := l.authorize(in.ChildId)
+	if err == errChildNotLinked {
+		return &projectmanagement.ListConceptsResp{
+			Status: 403,
+			Msg:    err.Error(),
+		}, nil
+	}
+	if err != nil {
+		l.Logger.Errorf("查询孩子关联失败: %v", err)
+		return &projectmanagement.ListConceptsResp{
+			Status: 500,
+			Msg:    "查询概念失败",
+		}, err
+	}
+
+	concepts, err := l.svcCtx.Concepts.Concepts(l.ctx, childID, in.Kind)
+	if err != nil {
+		l.Logger.Errorf("查询概念失败: %v", err)
+		return &projectmanagement.ListConceptsResp{
+			Status: 500,
+			Msg:    "查询概念失败",
+		}, err
+	}
+
+	resp := &projectmanagement.ListConceptsResp{
+		Status: 200,
+		Msg:    "查询概念成功",
+		Total:  int64(len(concepts)),
+	}
+	limit := conceptLimit(in.Limit)
+	if len(concepts) > limit {
+		concepts = concepts[:limit]
+	}
+	resp.Concepts = make([]*projectmanagement.ConceptInfo, 0, len(concepts))
+	for _, c := range concepts {
+		resp.Concepts = append(resp.Concepts, toConceptInfo(c))
+	}
+	return resp, nil
+}
+
+// authorize 返回要查询的孩子：未指定孩子时为调用方自己，指定其他孩子时调用方必须是已关联该孩子的家长
+func (l *ListConceptsLogic) authorize(childID int64) (int64, error) {
+	identity, ok := auth.FromContext(l.ctx)
+	if !ok {
+		return 0, auth.ErrTokenInvalid
+	}
+	if childID == 0 || childID == identity.UserID {
+		return identity.UserID, nil
+	}
+	if identity.Role != auth.RoleParent {
+		return 0, errChildNotLinked
+	}
+	if _, err := guardian.Relation(l.ctx, l.svcCtx.GuardianLinkModel, identity.UserID, childID); err != nil {
+		if err == guardian.ErrNotLinked {
+			return 0, errChildNotLinked
+		}
+		return 0, err
+	}
+	return childID, nil
+}
+
+func conceptLimit(limit int64) int {
+	if limit <= 0 {
+		return defaultConceptLimit
+	}
+	return int(min(limit, maxConceptLimit))
+}
+
+func toConceptInfo(s *conceptgraph.ConceptSummary) *projectmanagement.ConceptInfo {
+	info := &projectmanagement.ConceptInfo{
+		ConceptId:    s.Concept.ConceptId,
+		Name:         s.Concept.Name,
+		Kind:         s.Concept.Kind,
+		MentionCount: s.Mentions,
+		LastSeenTime: s.LastSeenAt.Format("2006-01-02 15:04:05"),
+		Projects:     make([]*projectmanagement.ConceptProject, 0, len(s.Projects)),
+	}
+	for _, p := range s.Projects {
+		info.Projects = append(info.Projects, &projectmanagement.ConceptProject{
+			ProjectId: p.ProjectId,
+			Title:     p.Title,
+		})
+	}
+	return info
+}
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/project-management/rpc/internal/svc"
+	"explorapal/app/project-management/rpc/projectmanagement"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListTemplatesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListTemplatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListTemplatesLogic {
+	return &ListTemplatesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取项目模板库，可按类别筛选
+func (l *ListTemplatesLogic) ListTemplates(in *projectmanagement.ListTemplatesReq) (*projectmanagement.ListTemplatesResp, error) {
+	templates, err := l.svcCtx.ProjectTemplateModel.FindActive(l.ctx, in.Category)
+	if err != nil && err != hps.ErrNotFound {
+		l.Logger.Errorf("获取项目模板失败: %v", err)
+		return &projectmanagement.ListTemplatesResp{
+			Status: 500,
+			Msg:    "获取项目模板失败",
+		}, err
+	}
+
+	list := make([]*projectmanagement.ProjectTemplate, 0, len(templates))
+	for _, t := range templates {
+		template, err := toProjectTemplate(t)
+		if err != nil {
+			// 配置有误的模板不展示，不影响其他模板
+			l.Logger.Errorf("%v", err)
+			continue
+		}
+		list = append(list, template)
+	}
+
+	return &projectmanagement.ListTemplatesResp{
+		Status:    200,
+		Msg:       "获取项目模板成功",
+		Templates: list,
+	}, nil
+}
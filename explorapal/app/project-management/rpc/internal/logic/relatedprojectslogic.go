@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/project-management/rpc/internal/svc"
+	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RelatedProjectsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRelatedProjectsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RelatedProjectsLogic {
+	return &RelatedProjectsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 在自己的项目中查找内容相近的项目
+func (l *RelatedProjectsLogic) RelatedProjects(in *projectmanagement.RelatedProjectsReq) (*projectmanagement.RelatedProjectsResp, error) {
+	userID := auth.UserID(l.ctx)
+	project, err := l.svcCtx.ProjectModel.FindOneOwned(l.ctx, in.ProjectId, userID)
+	if err == hps.ErrNotFound {
+		return &projectmanagement.RelatedProjectsResp{
+			Status: 404,
+			Msg:    "项目不存在",
+		}, nil
+	}
+	if err != nil {
+		l.Logger.Errorf("查询项目失败: %v", err)
+		return &projectmanagement.RelatedProjectsResp{
+			Status: 500,
+			Msg:    "查询相关项目失败",
+		}, err
+	}
+
+	related, err := l.svcCtx.Embeddings.RelatedProjects(l.ctx, userID, project.ProjectId, recommendLimit(in.Limit))
+	if err != nil {
+		l.Logger.Errorf("查询相关项目失败: %v", err)
+		return &projectmanagement.RelatedProjectsResp{
+			Status: 500,
+			Msg:    "查询相关项目失败",
+		}, err
+	}
+
+	resp := &projectmanagement.RelatedProjectsResp{
+		Status:   200,
+		Msg:      "查询相关项目成功",
+		Projects: make([]*projectmanagement.RelatedProject, 0, len(related)),
+	}
+	for _, r := range related {
+		resp.Projects = append(resp.Projects, &projectmanagement.RelatedProject{
+			Project: toProjectInfo(r.Project),
+			Score:   r.Score,
+		})
+	}
+	return resp, nil
+}
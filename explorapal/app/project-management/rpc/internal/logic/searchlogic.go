@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"explorapal/app/project-management/rpc/internal/svc"
+	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/search"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SearchLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSearchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchLogic {
+	return &SearchLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 在自己的项目、观察、问题和表达中搜索，结果按类型分组并带高亮摘要
+func (l *SearchLogic) Search(in *projectmanagement.SearchReq) (*projectmanagement.SearchResp, error) {
+	groups, err := l.svcCtx.Searcher.Search(l.ctx, &search.Query{
+		UserID:  auth.UserID(l.ctx),
+		Keyword: in.Keyword,
+		Types:   in.Types,
+		Limit:   in.Limit,
+	})
+	if errors.Is(err, search.ErrEmptyKeyword) || errors.Is(err, search.ErrKeywordTooLong) || errors.Is(err, search.ErrInvalidType) {
+		return &projectmanagement.SearchResp{
+			Status: 400,
+			Msg:    err.Error(),
+		}, nil
+	}
+	if err != nil {
+		l.Logger.Errorf("搜索失败: %v", err)
+		return &projectmanagement.SearchResp{
+			Status: 500,
+			Msg:    "搜索失败",
+		}, err
+	}
+
+	resp := &projectmanagement.SearchResp{
+		Status: 200,
+		Msg:    "搜索成功",
+		Groups: make([]*projectmanagement.SearchGroup, 0, len(groups)),
+	}
+	for _, g := range groups {
+		group := &projectmanagement.SearchGroup{
+			Type: g.Type,
+			Hits: make([]*projectmanagement.SearchHit, 0, len(g.Results)),
+		}
+		for _, r := range g.Results {
+			group.Hits = append(group.Hits, &projectmanagement.SearchHit{
+				Type:         r.Type,
+				Id:           r.ID,
+				ProjectId:    r.ProjectID,
+				ProjectTitle: r.ProjectTitle,
+				Title:        r.Title,
+				Snippet:      r.Snippet,
+				Score:        r.Score,
+				CreateTime:   r.CreateTime.Format("2006-01-02 15:04:05"),
+			})
+		}
+		resp.Groups = append(resp.Groups, group)
+		resp.Total += int64(len(group.Hits))
+	}
+	return resp, nil
+}
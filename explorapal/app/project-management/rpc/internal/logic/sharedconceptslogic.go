@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/project-management/rpc/internal/svc"
+	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SharedConceptsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSharedConceptsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SharedConceptsLogic {
+	return &SharedConceptsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询在两个及以上项目中都出现过的概念，出现的项目越多越靠前
+func (l *SharedConceptsLogic) SharedConcepts(in *projectmanagement.SharedConceptsReq) (*projectmanagement.SharedConceptsResp, error) {
+	userID := auth.UserID(l.ctx)
+	if len(in.ProjectIds) == 1 {
+		return &projectmanagement.SharedConceptsResp{
+			Status: 400,
+			Msg:    "请至少选择两个项目",
+		}, nil
+	}
+	// 指定的项目都必须属于调用方
+	for _, projectID := range in.ProjectIds {
+		_, err := l.svcCtx.ProjectModel.FindOneOwned(l.ctx, projectID, userID)
+		if err == hps.ErrNotFound {
+			return &projectmanagement.SharedConceptsResp{
+				Status: 404,
+				Msg:    "项目不存在",
+			}, nil
+		}
+		if err != nil {
+			l.Logger.Errorf("查询项目失败: %v", err)
+			return &projectmanagement.SharedConceptsResp{
+				Status: 500,
+				Msg:    "查询共同概念失败",
+			}, err
+		}
+	}
+
+	concepts, err := l.svcCtx.Concepts.SharedConcepts(l.ctx, userID, in.ProjectIds)
+	if err != nil {
+		l.Logger.Errorf("查询共同概念失败: %v", err)
+		return &projectmanagement.SharedConceptsResp{
+			Status: 500,
+			Msg:    "查询共同概念失败",
+		}, err
+	}
+
+	limit := conceptLimit(in.Limit)
+	if len(concepts) > limit {
+		concepts = concepts[:limit]
+	}
+	resp := &projectmanagement.SharedConceptsResp{
+		Status:   200,
+		Msg:      "查询共同概念成功",
+		Concepts: make([]*projectmanagement.ConceptInfo, 0, len(concepts)),
+	}
+	for _, c := range concepts {
+		resp.Concepts = append(resp.Concepts, toConceptInfo(c))
+	}
+	return resp, nil
+}
@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/project-management/rpc/internal/svc"
+	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 相似推荐的默认数量和最大数量
+const (
+	defaultRecommendLimit = 5
+	maxRecommendLimit     = 20
+)
+
+type SimilarObservationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSimilarObservationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SimilarObservationsLogic {
+	return &SimilarObservationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 在自己的全部项目中查找与该观察相似的观察
+func (l *SimilarObservationsLogic) SimilarObservations(in *projectmanagement.SimilarObservationsReq) (*projectmanagement.SimilarObservationsResp, error) {
+	userID := auth.UserID(l.ctx)
+	observation, err := l.svcCtx.ObservationModel.FindOneByObservationId(l.ctx, in.ObservationId)
+	// 不属于调用方的观察按不存在处理
+	if err == hps.ErrNotFound || (err == nil && (observation.UserId != userID || observation.DeleteTime.Valid)) {
+		return &projectmanagement.SimilarObservationsResp{
+			Status: 404,
+			Msg:    "观察记录不存在",
+		}, nil
+	}
+	if err != nil {
+		l.Logger.Errorf("查询观察记录失败: %v", err)
+		return &projectmanagement.SimilarObservationsResp{
+			Status: 500,
+			Msg:    "查询相似观察失败",
+		}, err
+	}
+
+	similar, err := l.svcCtx.Embeddings.SimilarObservations(l.ctx, userID, observation.ObservationId, recommendLimit(in.Limit))
+	if err != nil {
+		l.Logger.Errorf("查询相似观察失败: %v", err)
+		return &projectmanagement.SimilarObservationsResp{
+			Status: 500,
+			Msg:    "查询相似观察失败",
+		}, err
+	}
+
+	resp := &projectmanagement.SimilarObservationsResp{
+		Status:       200,
+		Msg:          "查询相似观察成功",
+		Observations: make([]*projectmanagement.SimilarObservation, 0, len(similar)),
+	}
+	for _, s := range similar {
+		resp.Observations = append(resp.Observations, &projectmanagement.SimilarObservation{
+			ObservationId: s.Observation.ObservationId,
+			ProjectId:     s.Observation.ProjectId,
+			ProjectTitle:  s.ProjectTitle,
+			ImageUrl:      s.Observation.ImageUrl,
+			ObjectName:    s.Observation.ObjectName.String,
+			Description:   s.Observation.Description.String,
+			Score:         s.Score,
+			CreateTime:    s.Observation.CreateTime.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return resp, nil
+}
+
+func recommendLimit(limit int64) int {
+	if limit <= 0 {
+		return defaultRecommendLimit
+	}
+	return int(min(limit, maxRecommendLimit))
+}
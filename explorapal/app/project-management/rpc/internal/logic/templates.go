@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/idgen"
+)
+
+// copyMilestones 把模板的里程碑按顺序复制为项目的任务清单
+func copyMilestones(ctx context.Context, model hps.ProjectMilestonesModel, projectID int64, milestones []hps.TemplateMilestone) ([]*hps.ProjectMilestones, error) {
+	copied := make([]*hps.ProjectMilestones, 0, len(milestones))
+	for i, m := range milestones {
+		milestone := &hps.ProjectMilestones{
+			MilestoneId: idgen.Next(),
+			ProjectId:   projectID,
+			Seq:         int64(i + 1),
+			Stage:       m.Stage,
+			Kind:        sql.NullString{String: m.Kind, Valid: m.Kind != ""},
+			Title:       m.Title,
+			Description: sql.NullString{String: m.Description, Valid: m.Description != ""},
+			Target:      max(m.Target, 1),
+		}
+
+		if _, err := model.Insert(ctx, milestone); err != nil {
+			return copied, fmt.Errorf("复制项目里程碑失败: %w", err)
+		}
+		copied = append(copied, milestone)
+	}
+	return copied, nil
+}
+
+func toProjectTemplate(t *hps.ProjectTemplates) (*projectmanagement.ProjectTemplate, error) {
+	milestones, err := t.GetMilestones()
+	if err != nil {
+		return nil, fmt.Errorf("解析模板%d的里程碑失败: %w", t.TemplateId, err)
+	}
+	prompts, err := t.GetStarterPrompts()
+	if err != nil {
+		return nil, fmt.Errorf("解析模板%d的引导语失败: %w", t.TemplateId, err)
+	}
+	tags, err := t.GetSuggestedTags()
+	if err != nil {
+		return nil, fmt.Errorf("解析模板%d的推荐标签失败: %w", t.TemplateId, err)
+	}
+
+	result := &projectmanagement.ProjectTemplate{
+		TemplateId:     t.TemplateId,
+		Title:          t.Title,
+		Category:       t.Category,
+		Description:    t.Description.String,
+		StarterPrompts: prompts,
+		SuggestedTags:  tags,
+	}
+	for _, m := range milestones {
+		result.Milestones = append(result.Milestones, &projectmanagement.TemplateMilestone{
+			Stage:       m.Stage,
+			Kind:        m.Kind,
+			Title:       m.Title,
+			Description: m.Description,
+			Target:      m.Target,
+		})
+	}
+	return result, nil
+}
+
+func toProjectMilestone(m *hps.ProjectMilestones) *projectmanagement.ProjectMilestone {
+	result := &projectmanagement.ProjectMilestone{
+		MilestoneId: m.MilestoneId,
+		Seq:         m.Seq,
+		Stage:       m.Stage,
+		Kind:        m.Kind.String,
+		Title:       m.Title,
+		Description: m.Description.String,
+		Target:      m.Target,
+		Completed:   m.CompletedTime.Valid,
+	}
+	if m.CompletedTime.Valid {
+		result.CompletedTime = m.CompletedTime.Time.Format("2006-01-02 15:04:05")
+	}
+	return result
+}
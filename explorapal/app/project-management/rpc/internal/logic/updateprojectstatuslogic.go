@@ -2,9 +2,16 @@ package logic
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
 
+	"explorapal/app/model/hps"
 	"explorapal/app/project-management/rpc/internal/svc"
 	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/idgen"
+	"explorapal/pkg/projectstatus"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -23,9 +30,16 @@ func NewUpdateProjectStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext
 	}
 }
 
+// 按状态机变更项目状态，记录变更前后的状态，返回新状态和接下来可以执行的动作
 func (l *UpdateProjectStatusLogic) UpdateProjectStatus(in *projectmanagement.UpdateProjectStatusReq) (*projectmanagement.UpdateProjectStatusResp, error) {
-	// 获取现有项目
-	project, err := l.svcCtx.ProjectModel.FindOne(l.ctx, in.ProjectId)
+	// 只能修改自己的项目
+	project, err := l.svcCtx.ProjectModel.FindOneOwned(l.ctx, in.ProjectId, auth.UserID(l.ctx))
+	if err == hps.ErrNotFound {
+		return &projectmanagement.UpdateProjectStatusResp{
+			Status: 404,
+			Msg:    "项目不存在",
+		}, nil
+	}
 	if err != nil {
 		l.Logger.Errorf("获取项目失败: %v", err)
 		return &projectmanagement.UpdateProjectStatusResp{
@@ -34,9 +48,28 @@ func (l *UpdateProjectStatusLogic) UpdateProjectStatus(in *projectmanagement.Upd
 		}, err
 	}
 
-	// 更新状态
-	project.Status = in.Status
-	err = l.svcCtx.ProjectModel.Update(l.ctx, project)
+	transition, err := projectstatus.Find(project.Status, in.Status, in.Action)
+	if err != nil {
+		return &projectmanagement.UpdateProjectStatusResp{
+			Status:        400,
+			Msg:           err.Error(),
+			ProjectStatus: project.Status,
+			NextActions:   toStatusActions(project.Status),
+		}, nil
+	}
+
+	// 状态和变更活动在同一个事务中写入；只在状态没有被其他请求修改时更新，避免并发的变更越过状态机
+	var updated bool
+	err = l.svcCtx.UnitOfWork.Do(l.ctx, func(ctx context.Context, tx *hps.Models) error {
+		var err error
+		if updated, err = tx.Projects.UpdateStatus(ctx, project, transition.To); err != nil || !updated {
+			return err
+		}
+		if _, err := tx.ProjectActivities.Insert(ctx, statusActivity(project, transition)); err != nil {
+			return fmt.Errorf("记录项目活动失败: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
 		l.Logger.Errorf("更新项目状态失败: %v", err)
 		return &projectmanagement.UpdateProjectStatusResp{
@@ -44,9 +77,48 @@ func (l *UpdateProjectStatusLogic) UpdateProjectStatus(in *projectmanagement.Upd
 			Msg:    "更新项目状态失败",
 		}, err
 	}
+	if !updated {
+		return &projectmanagement.UpdateProjectStatusResp{
+			Status: 409,
+			Msg:    "项目状态已经变化，请刷新后再试",
+		}, nil
+	}
 
 	return &projectmanagement.UpdateProjectStatusResp{
-		Status: 200,
-		Msg:    "更新项目状态成功",
+		Status:        200,
+		Msg:           "更新项目状态成功",
+		ProjectStatus: transition.To,
+		NextActions:   toStatusActions(transition.To),
 	}, nil
 }
+
+// statusActivity 状态变更的项目活动
+func statusActivity(project *hps.Projects, transition projectstatus.Transition) *hps.ProjectActivities {
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"action":     transition.Action,
+		"old_status": transition.From,
+		"new_status": transition.To,
+	})
+	return &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   project.ProjectId,
+		UserId:      project.UserId,
+		Type:        hps.ActivityTypeChangeStatus,
+		Description: fmt.Sprintf("%s了项目：%s", transition.Name, project.Title),
+		Metadata:    sql.NullString{String: string(metadata), Valid: true},
+	}
+}
+
+// toStatusActions 项目在该状态下可以执行的动作
+func toStatusActions(status string) []*projectmanagement.StatusAction {
+	next := projectstatus.NextActions(status)
+	actions := make([]*projectmanagement.StatusAction, 0, len(next))
+	for _, t := range next {
+		actions = append(actions, &projectmanagement.StatusAction{
+			Action: t.Action,
+			Status: t.To,
+			Name:   t.Name,
+		})
+	}
+	return actions
+}
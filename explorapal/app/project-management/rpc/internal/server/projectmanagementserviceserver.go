@@ -42,3 +42,38 @@ func (s *ProjectManagementServiceServer) UpdateProjectStatus(ctx context.Context
 	l := logic.NewUpdateProjectStatusLogic(ctx, s.svcCtx)
 	return l.UpdateProjectStatus(in)
 }
+
+func (s *ProjectManagementServiceServer) ListTemplates(ctx context.Context, in *projectmanagement.ListTemplatesReq) (*projectmanagement.ListTemplatesResp, error) {
+	l := logic.NewListTemplatesLogic(ctx, s.svcCtx)
+	return l.ListTemplates(in)
+}
+
+func (s *ProjectManagementServiceServer) Search(ctx context.Context, in *projectmanagement.SearchReq) (*projectmanagement.SearchResp, error) {
+	l := logic.NewSearchLogic(ctx, s.svcCtx)
+	return l.Search(in)
+}
+
+func (s *ProjectManagementServiceServer) SimilarObservations(ctx context.Context, in *projectmanagement.SimilarObservationsReq) (*projectmanagement.SimilarObservationsResp, error) {
+	l := logic.NewSimilarObservationsLogic(ctx, s.svcCtx)
+	return l.SimilarObservations(in)
+}
+
+func (s *ProjectManagementServiceServer) RelatedProjects(ctx context.Context, in *projectmanagement.RelatedProjectsReq) (*projectmanagement.RelatedProjectsResp, error) {
+	l := logic.NewRelatedProjectsLogic(ctx, s.svcCtx)
+	return l.RelatedProjects(in)
+}
+
+func (s *ProjectManagementServiceServer) ListConcepts(ctx context.Context, in *projectmanagement.ListConceptsReq) (*projectmanagement.ListConceptsResp, error) {
+	l := logic.NewListConceptsLogic(ctx, s.svcCtx)
+	return l.ListConcepts(in)
+}
+
+func (s *ProjectManagementServiceServer) SharedConcepts(ctx context.Context, in *projectmanagement.SharedConceptsReq) (*projectmanagement.SharedConceptsResp, error) {
+	l := logic.NewSharedConceptsLogic(ctx, s.svcCtx)
+	return l.SharedConcepts(in)
+}
+
+func (s *ProjectManagementServiceServer) GetConceptGraph(ctx context.Context, in *projectmanagement.GetConceptGraphReq) (*projectmanagement.GetConceptGraphResp, error) {
+	l := logic.NewGetConceptGraphLogic(ctx, s.svcCtx)
+	return l.GetConceptGraph(in)
+}
@@ -3,6 +3,13 @@ package svc
 import (
 	"explorapal/app/model/hps"
 	"explorapal/app/project-management/rpc/internal/config"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/conceptgraph"
+	"explorapal/pkg/embedding"
+	"explorapal/pkg/safetyaudit"
+	"explorapal/pkg/search"
+	"explorapal/third/openai"
+	"explorapal/third/security"
 
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
@@ -11,17 +18,96 @@ type ServiceContext struct {
 	Config config.Config
 
 	// 数据库模型
-	ProjectModel         hps.ProjectsModel
-	ProjectActivityModel hps.ProjectActivitiesModel
+	ProjectModel          hps.ProjectsModel
+	ProjectActivityModel  hps.ProjectActivitiesModel
+	ProjectTemplateModel  hps.ProjectTemplatesModel
+	ProjectMilestoneModel hps.ProjectMilestonesModel
+	ObservationModel      hps.ObservationsModel
+	QuestionModel         hps.QuestionsModel
+	ExpressionModel       hps.ExpressionsModel
+	AchievementModel      hps.AchievementsModel
+	SafetyAuditLogModel   hps.SafetyAuditLogsModel
+	GuardianLinkModel     hps.GuardianLinksModel
+
+	// 多表写入的事务
+	UnitOfWork *hps.UnitOfWork
+
+	// 访问令牌校验
+	Auth *auth.Issuer
+
+	// 内容安全守卫
+	SafetyGuard *security.Guard
+
+	// 项目内容搜索
+	Searcher *search.Searcher
+
+	// 内容向量索引
+	Embeddings *embedding.Index
+
+	// 跨项目概念图
+	Concepts *conceptgraph.Graph
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	conn := sqlx.NewMysql(c.DBConfig.DataSource)
 
+	safetyAuditLogModel := hps.NewSafetyAuditLogsModel(conn, c.Cache)
+	projectModel := hps.NewProjectsModel(conn, c.Cache)
+	observationModel := hps.NewObservationsModel(conn, c.Cache)
+	questionModel := hps.NewQuestionsModel(conn, c.Cache)
+	expressionModel := hps.NewExpressionsModel(conn, c.Cache)
+	securityClient := security.NewSecurityClient(&security.Config{
+		BaseURL:         c.SecurityConfig.BaseURL,
+		APIKey:          c.SecurityConfig.APIKey,
+		Timeout:         c.SecurityConfig.Timeout,
+		BatchWorkers:    c.SecurityConfig.BatchWorkers,
+		BatchSize:       c.SecurityConfig.BatchSize,
+		LocalRules:      c.SecurityConfig.LocalRules,
+		FailurePolicies: c.SecurityConfig.FailurePolicies,
+	})
+
 	return &ServiceContext{
 		Config: c,
 
-		ProjectModel:         hps.NewProjectsModel(conn, c.Cache),
-		ProjectActivityModel: hps.NewProjectActivitiesModel(conn, c.Cache),
+		ProjectModel:          projectModel,
+		ProjectActivityModel:  hps.NewProjectActivitiesModel(conn, c.Cache),
+		ProjectTemplateModel:  hps.NewProjectTemplatesModel(conn, c.Cache),
+		ProjectMilestoneModel: hps.NewProjectMilestonesModel(conn, c.Cache),
+		ObservationModel:      observationModel,
+		QuestionModel:         questionModel,
+		ExpressionModel:       expressionModel,
+		AchievementModel:      hps.NewAchievementsModel(conn, c.Cache),
+		SafetyAuditLogModel:   safetyAuditLogModel,
+		GuardianLinkModel:     hps.NewGuardianLinksModel(conn, c.Cache),
+
+		UnitOfWork: hps.NewUnitOfWork(conn, c.Cache),
+
+		Auth: auth.NewIssuer(&auth.Config{AccessSecret: c.JwtAuth.AccessSecret}),
+
+		SafetyGuard: security.NewGuard(securityClient, safetyaudit.NewAuditor(safetyAuditLogModel)),
+
+		Searcher: search.NewSearcher(search.NewMySQLBackend(&search.Models{
+			Projects:     projectModel,
+			Observations: observationModel,
+			Questions:    questionModel,
+			Expressions:  expressionModel,
+		})),
+
+		Embeddings: embedding.NewIndex(&embedding.Models{
+			Embeddings:   hps.NewEmbeddingsModel(conn, c.Cache),
+			Projects:     projectModel,
+			Observations: observationModel,
+		}, embedding.NewProvider(c.Embedding, openai.NewClient(&openai.Config{
+			APIKey:  c.DashScope.APIKey,
+			BaseURL: c.DashScope.BaseURL,
+			Timeout: c.DashScope.Timeout,
+		}))),
+
+		Concepts: conceptgraph.NewGraph(&conceptgraph.Models{
+			Concepts: hps.NewConceptsModel(conn, c.Cache),
+			Edges:    hps.NewConceptEdgesModel(conn, c.Cache),
+			Mentions: hps.NewConceptMentionsModel(conn, c.Cache),
+			Projects: projectModel,
+		}),
 	}
 }
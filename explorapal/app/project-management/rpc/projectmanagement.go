@@ -8,6 +8,9 @@ import (
 	"explorapal/app/project-management/rpc/internal/server"
 	"explorapal/app/project-management/rpc/internal/svc"
 	"explorapal/app/project-management/rpc/projectmanagement"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/idgen"
+	"explorapal/third/security"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/service"
@@ -23,6 +26,7 @@ func main() {
 
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
+	idgen.MustSetup(c.IDGen)
 	ctx := svc.NewServiceContext(c)
 
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
@@ -34,6 +38,11 @@ func main() {
 	})
 	defer s.Stop()
 
+	// 身份认证：校验调用方透传的访问令牌，需在内容安全拦截器之前
+	s.AddUnaryInterceptors(auth.UnaryServerInterceptor(ctx.Auth))
+	// 内容安全：检查请求中的用户输入和响应中的返回内容
+	s.AddUnaryInterceptors(security.UnaryServerInterceptor(ctx.SafetyGuard, security.InputPolicy, security.OutputPolicy))
+
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
 }
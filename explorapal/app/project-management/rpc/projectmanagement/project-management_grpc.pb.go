@@ -23,6 +23,13 @@ const (
 	ProjectManagementService_GetProjectList_FullMethodName      = "/projectmanagement.ProjectManagementService/GetProjectList"
 	ProjectManagementService_GetProjectDetail_FullMethodName    = "/projectmanagement.ProjectManagementService/GetProjectDetail"
 	ProjectManagementService_UpdateProjectStatus_FullMethodName = "/projectmanagement.ProjectManagementService/UpdateProjectStatus"
+	ProjectManagementService_ListTemplates_FullMethodName       = "/projectmanagement.ProjectManagementService/ListTemplates"
+	ProjectManagementService_Search_FullMethodName              = "/projectmanagement.ProjectManagementService/Search"
+	ProjectManagementService_SimilarObservations_FullMethodName = "/projectmanagement.ProjectManagementService/SimilarObservations"
+	ProjectManagementService_RelatedProjects_FullMethodName     = "/projectmanagement.ProjectManagementService/RelatedProjects"
+	ProjectManagementService_ListConcepts_FullMethodName        = "/projectmanagement.ProjectManagementService/ListConcepts"
+	ProjectManagementService_SharedConcepts_FullMethodName      = "/projectmanagement.ProjectManagementService/SharedConcepts"
+	ProjectManagementService_GetConceptGraph_FullMethodName     = "/projectmanagement.ProjectManagementService/GetConceptGraph"
 )
 
 // ProjectManagementServiceClient is the client API for ProjectManagementService service.
@@ -33,6 +40,13 @@ type ProjectManagementServiceClient interface {
 	GetProjectList(ctx context.Context, in *GetProjectListReq, opts ...grpc.CallOption) (*GetProjectListResp, error)
 	GetProjectDetail(ctx context.Context, in *GetProjectDetailReq, opts ...grpc.CallOption) (*GetProjectDetailResp, error)
 	UpdateProjectStatus(ctx context.Context, in *UpdateProjectStatusReq, opts ...grpc.CallOption) (*UpdateProjectStatusResp, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesReq, opts ...grpc.CallOption) (*ListTemplatesResp, error)
+	Search(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (*SearchResp, error)
+	SimilarObservations(ctx context.Context, in *SimilarObservationsReq, opts ...grpc.CallOption) (*SimilarObservationsResp, error)
+	RelatedProjects(ctx context.Context, in *RelatedProjectsReq, opts ...grpc.CallOption) (*RelatedProjectsResp, error)
+	ListConcepts(ctx context.Context, in *ListConceptsReq, opts ...grpc.CallOption) (*ListConceptsResp, error)
+	SharedConcepts(ctx context.Context, in *SharedConceptsReq, opts ...grpc.CallOption) (*SharedConceptsResp, error)
+	GetConceptGraph(ctx context.Context, in *GetConceptGraphReq, opts ...grpc.CallOption) (*GetConceptGraphResp, error)
 }
 
 type projectManagementServiceClient struct {
@@ -83,6 +97,76 @@ func (c *projectManagementServiceClient) UpdateProjectStatus(ctx context.Context
 	return out, nil
 }
 
+func (c *projectManagementServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesReq, opts ...grpc.CallOption) (*ListTemplatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplatesResp)
+	err := c.cc.Invoke(ctx, ProjectManagementService_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectManagementServiceClient) Search(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (*SearchResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResp)
+	err := c.cc.Invoke(ctx, ProjectManagementService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectManagementServiceClient) SimilarObservations(ctx context.Context, in *SimilarObservationsReq, opts ...grpc.CallOption) (*SimilarObservationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimilarObservationsResp)
+	err := c.cc.Invoke(ctx, ProjectManagementService_SimilarObservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectManagementServiceClient) RelatedProjects(ctx context.Context, in *RelatedProjectsReq, opts ...grpc.CallOption) (*RelatedProjectsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RelatedProjectsResp)
+	err := c.cc.Invoke(ctx, ProjectManagementService_RelatedProjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectManagementServiceClient) ListConcepts(ctx context.Context, in *ListConceptsReq, opts ...grpc.CallOption) (*ListConceptsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConceptsResp)
+	err := c.cc.Invoke(ctx, ProjectManagementService_ListConcepts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectManagementServiceClient) SharedConcepts(ctx context.Context, in *SharedConceptsReq, opts ...grpc.CallOption) (*SharedConceptsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedConceptsResp)
+	err := c.cc.Invoke(ctx, ProjectManagementService_SharedConcepts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectManagementServiceClient) GetConceptGraph(ctx context.Context, in *GetConceptGraphReq, opts ...grpc.CallOption) (*GetConceptGraphResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConceptGraphResp)
+	err := c.cc.Invoke(ctx, ProjectManagementService_GetConceptGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectManagementServiceServer is the server API for ProjectManagementService service.
 // All implementations must embed UnimplementedProjectManagementServiceServer
 // for forward compatibility.
@@ -91,6 +175,13 @@ type ProjectManagementServiceServer interface {
 	GetProjectList(context.Context, *GetProjectListReq) (*GetProjectListResp, error)
 	GetProjectDetail(context.Context, *GetProjectDetailReq) (*GetProjectDetailResp, error)
 	UpdateProjectStatus(context.Context, *UpdateProjectStatusReq) (*UpdateProjectStatusResp, error)
+	ListTemplates(context.Context, *ListTemplatesReq) (*ListTemplatesResp, error)
+	Search(context.Context, *SearchReq) (*SearchResp, error)
+	SimilarObservations(context.Context, *SimilarObservationsReq) (*SimilarObservationsResp, error)
+	RelatedProjects(context.Context, *RelatedProjectsReq) (*RelatedProjectsResp, error)
+	ListConcepts(context.Context, *ListConceptsReq) (*ListConceptsResp, error)
+	SharedConcepts(context.Context, *SharedConceptsReq) (*SharedConceptsResp, error)
+	GetConceptGraph(context.Context, *GetConceptGraphReq) (*GetConceptGraphResp, error)
 	mustEmbedUnimplementedProjectManagementServiceServer()
 }
 
@@ -113,6 +204,27 @@ func (UnimplementedProjectManagementServiceServer) GetProjectDetail(context.Cont
 func (UnimplementedProjectManagementServiceServer) UpdateProjectStatus(context.Context, *UpdateProjectStatusReq) (*UpdateProjectStatusResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectStatus not implemented")
 }
+func (UnimplementedProjectManagementServiceServer) ListTemplates(context.Context, *ListTemplatesReq) (*ListTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedProjectManagementServiceServer) Search(context.Context, *SearchReq) (*SearchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedProjectManagementServiceServer) SimilarObservations(context.Context, *SimilarObservationsReq) (*SimilarObservationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimilarObservations not implemented")
+}
+func (UnimplementedProjectManagementServiceServer) RelatedProjects(context.Context, *RelatedProjectsReq) (*RelatedProjectsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelatedProjects not implemented")
+}
+func (UnimplementedProjectManagementServiceServer) ListConcepts(context.Context, *ListConceptsReq) (*ListConceptsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConcepts not implemented")
+}
+func (UnimplementedProjectManagementServiceServer) SharedConcepts(context.Context, *SharedConceptsReq) (*SharedConceptsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedConcepts not implemented")
+}
+func (UnimplementedProjectManagementServiceServer) GetConceptGraph(context.Context, *GetConceptGraphReq) (*GetConceptGraphResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConceptGraph not implemented")
+}
 func (UnimplementedProjectManagementServiceServer) mustEmbedUnimplementedProjectManagementServiceServer() {
 }
 func (UnimplementedProjectManagementServiceServer) testEmbeddedByValue() {}
@@ -207,6 +319,132 @@ func _ProjectManagementService_UpdateProjectStatus_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectManagementService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectManagementServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectManagementService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectManagementServiceServer).ListTemplates(ctx, req.(*ListTemplatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectManagementService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectManagementServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectManagementService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectManagementServiceServer).Search(ctx, req.(*SearchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectManagementService_SimilarObservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimilarObservationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectManagementServiceServer).SimilarObservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectManagementService_SimilarObservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectManagementServiceServer).SimilarObservations(ctx, req.(*SimilarObservationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectManagementService_RelatedProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelatedProjectsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectManagementServiceServer).RelatedProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectManagementService_RelatedProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectManagementServiceServer).RelatedProjects(ctx, req.(*RelatedProjectsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectManagementService_ListConcepts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConceptsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectManagementServiceServer).ListConcepts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectManagementService_ListConcepts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectManagementServiceServer).ListConcepts(ctx, req.(*ListConceptsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectManagementService_SharedConcepts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedConceptsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectManagementServiceServer).SharedConcepts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectManagementService_SharedConcepts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectManagementServiceServer).SharedConcepts(ctx, req.(*SharedConceptsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectManagementService_GetConceptGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConceptGraphReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectManagementServiceServer).GetConceptGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectManagementService_GetConceptGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectManagementServiceServer).GetConceptGraph(ctx, req.(*GetConceptGraphReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectManagementService_ServiceDesc is the grpc.ServiceDesc for ProjectManagementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -230,6 +468,34 @@ var ProjectManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProjectStatus",
 			Handler:    _ProjectManagementService_UpdateProjectStatus_Handler,
 		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _ProjectManagementService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _ProjectManagementService_Search_Handler,
+		},
+		{
+			MethodName: "SimilarObservations",
+			Handler:    _ProjectManagementService_SimilarObservations_Handler,
+		},
+		{
+			MethodName: "RelatedProjects",
+			Handler:    _ProjectManagementService_RelatedProjects_Handler,
+		},
+		{
+			MethodName: "ListConcepts",
+			Handler:    _ProjectManagementService_ListConcepts_Handler,
+		},
+		{
+			MethodName: "SharedConcepts",
+			Handler:    _ProjectManagementService_SharedConcepts_Handler,
+		},
+		{
+			MethodName: "GetConceptGraph",
+			Handler:    _ProjectManagementService_GetConceptGraph_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "app/project-management/rpc/project-management.proto",
@@ -14,19 +14,43 @@ import (
 )
 
 type (
+	ConceptInfo             = projectmanagement.ConceptInfo
+	ConceptProject          = projectmanagement.ConceptProject
 	CreateProjectReq        = projectmanagement.CreateProjectReq
 	CreateProjectResp       = projectmanagement.CreateProjectResp
 	ExpressionInfo          = projectmanagement.ExpressionInfo
+	GetConceptGraphReq      = projectmanagement.GetConceptGraphReq
+	GetConceptGraphResp     = projectmanagement.GetConceptGraphResp
 	GetProjectDetailReq     = projectmanagement.GetProjectDetailReq
 	GetProjectDetailResp    = projectmanagement.GetProjectDetailResp
 	GetProjectListReq       = projectmanagement.GetProjectListReq
 	GetProjectListResp      = projectmanagement.GetProjectListResp
+	ListConceptsReq         = projectmanagement.ListConceptsReq
+	ListConceptsResp        = projectmanagement.ListConceptsResp
+	ListTemplatesReq        = projectmanagement.ListTemplatesReq
+	ListTemplatesResp       = projectmanagement.ListTemplatesResp
 	ObservationInfo         = projectmanagement.ObservationInfo
 	ProjectAchievement      = projectmanagement.ProjectAchievement
 	ProjectActivity         = projectmanagement.ProjectActivity
 	ProjectDetail           = projectmanagement.ProjectDetail
 	ProjectInfo             = projectmanagement.ProjectInfo
+	ProjectMilestone        = projectmanagement.ProjectMilestone
+	ProjectTemplate         = projectmanagement.ProjectTemplate
 	QuestionInfo            = projectmanagement.QuestionInfo
+	RelatedProject          = projectmanagement.RelatedProject
+	RelatedProjectsReq      = projectmanagement.RelatedProjectsReq
+	RelatedProjectsResp     = projectmanagement.RelatedProjectsResp
+	SearchGroup             = projectmanagement.SearchGroup
+	SearchHit               = projectmanagement.SearchHit
+	SearchReq               = projectmanagement.SearchReq
+	SearchResp              = projectmanagement.SearchResp
+	SharedConceptsReq       = projectmanagement.SharedConceptsReq
+	SharedConceptsResp      = projectmanagement.SharedConceptsResp
+	SimilarObservation      = projectmanagement.SimilarObservation
+	SimilarObservationsReq  = projectmanagement.SimilarObservationsReq
+	SimilarObservationsResp = projectmanagement.SimilarObservationsResp
+	StatusAction            = projectmanagement.StatusAction
+	TemplateMilestone       = projectmanagement.TemplateMilestone
 	UpdateProjectStatusReq  = projectmanagement.UpdateProjectStatusReq
 	UpdateProjectStatusResp = projectmanagement.UpdateProjectStatusResp
 
@@ -35,6 +59,13 @@ type (
 		GetProjectList(ctx context.Context, in *GetProjectListReq, opts ...grpc.CallOption) (*GetProjectListResp, error)
 		GetProjectDetail(ctx context.Context, in *GetProjectDetailReq, opts ...grpc.CallOption) (*GetProjectDetailResp, error)
 		UpdateProjectStatus(ctx context.Context, in *UpdateProjectStatusReq, opts ...grpc.CallOption) (*UpdateProjectStatusResp, error)
+		ListTemplates(ctx context.Context, in *ListTemplatesReq, opts ...grpc.CallOption) (*ListTemplatesResp, error)
+		Search(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (*SearchResp, error)
+		SimilarObservations(ctx context.Context, in *SimilarObservationsReq, opts ...grpc.CallOption) (*SimilarObservationsResp, error)
+		RelatedProjects(ctx context.Context, in *RelatedProjectsReq, opts ...grpc.CallOption) (*RelatedProjectsResp, error)
+		ListConcepts(ctx context.Context, in *ListConceptsReq, opts ...grpc.CallOption) (*ListConceptsResp, error)
+		SharedConcepts(ctx context.Context, in *SharedConceptsReq, opts ...grpc.CallOption) (*SharedConceptsResp, error)
+		GetConceptGraph(ctx context.Context, in *GetConceptGraphReq, opts ...grpc.CallOption) (*GetConceptGraphResp, error)
 	}
 
 	defaultProjectManagementService struct {
@@ -67,3 +98,38 @@ func (m *defaultProjectManagementService) UpdateProjectStatus(ctx context.Contex
 	client := projectmanagement.NewProjectManagementServiceClient(m.cli.Conn())
 	return client.UpdateProjectStatus(ctx, in, opts...)
 }
+
+func (m *defaultProjectManagementService) ListTemplates(ctx context.Context, in *ListTemplatesReq, opts ...grpc.CallOption) (*ListTemplatesResp, error) {
+	client := projectmanagement.NewProjectManagementServiceClient(m.cli.Conn())
+	return client.ListTemplates(ctx, in, opts...)
+}
+
+func (m *defaultProjectManagementService) Search(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (*SearchResp, error) {
+	client := projectmanagement.NewProjectManagementServiceClient(m.cli.Conn())
+	return client.Search(ctx, in, opts...)
+}
+
+func (m *defaultProjectManagementService) SimilarObservations(ctx context.Context, in *SimilarObservationsReq, opts ...grpc.CallOption) (*SimilarObservationsResp, error) {
+	client := projectmanagement.NewProjectManagementServiceClient(m.cli.Conn())
+	return client.SimilarObservations(ctx, in, opts...)
+}
+
+func (m *defaultProjectManagementService) RelatedProjects(ctx context.Context, in *RelatedProjectsReq, opts ...grpc.CallOption) (*RelatedProjectsResp, error) {
+	client := projectmanagement.NewProjectManagementServiceClient(m.cli.Conn())
+	return client.RelatedProjects(ctx, in, opts...)
+}
+
+func (m *defaultProjectManagementService) ListConcepts(ctx context.Context, in *ListConceptsReq, opts ...grpc.CallOption) (*ListConceptsResp, error) {
+	client := projectmanagement.NewProjectManagementServiceClient(m.cli.Conn())
+	return client.ListConcepts(ctx, in, opts...)
+}
+
+func (m *defaultProjectManagementService) SharedConcepts(ctx context.Context, in *SharedConceptsReq, opts ...grpc.CallOption) (*SharedConceptsResp, error) {
+	client := projectmanagement.NewProjectManagementServiceClient(m.cli.Conn())
+	return client.SharedConcepts(ctx, in, opts...)
+}
+
+func (m *defaultProjectManagementService) GetConceptGraph(ctx context.Context, in *GetConceptGraphReq, opts ...grpc.CallOption) (*GetConceptGraphResp, error) {
+	client := projectmanagement.NewProjectManagementServiceClient(m.cli.Conn())
+	return client.GetConceptGraph(ctx, in, opts...)
+}
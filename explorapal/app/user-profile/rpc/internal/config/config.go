@@ -0,0 +1,47 @@
+package config
+
+import (
+	"explorapal/pkg/idgen"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	zrpc.RpcServerConf
+
+	// JWT配置，与API服务使用同一密钥校验透传的访问令牌
+	JwtAuth struct {
+		AccessSecret string
+	}
+
+	// 数据库配置
+	DBConfig struct {
+		DataSource string
+	}
+
+	// 缓存配置
+	Cache cache.CacheConf
+
+	// ID生成配置，同时运行的实例机器号不能重复
+	IDGen idgen.Config
+
+	// 家长同意配置
+	Consent struct {
+		TermsVersion string `json:",default=1.0"` // 当前条款版本，家长需要同意该版本
+	}
+
+	// 集团安全中心配置
+	SecurityConfig struct {
+		BaseURL      string
+		APIKey       string
+		Timeout      int
+		BatchWorkers int `json:",default=8"`  // 批量检查并发数
+		BatchSize    int `json:",default=50"` // 原生批量接口单次最多条数
+
+		// 本地规则与安全中心不可用时的处理策略
+		LocalRules      security.LocalRulesConfig `json:",optional"`
+		FailurePolicies []security.FailurePolicy  `json:",optional"`
+	}
+}
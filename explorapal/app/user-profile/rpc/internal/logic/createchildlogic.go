@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateChildLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateChildLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateChildLogic {
+	return &CreateChildLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 家长为孩子创建账号并自动关联；孩子账号只使用用户名登录，不填写手机号和邮箱
+func (l *CreateChildLogic) CreateChild(in *userprofile.CreateChildReq) (*userprofile.CreateChildResp, error) {
+	if in.Username == "" {
+		return &userprofile.CreateChildResp{
+			Status: 400,
+			Msg:    "请填写孩子的用户名",
+		}, nil
+	}
+
+	link, child, err := l.create(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "创建孩子账号失败")
+		if err != nil {
+			l.Logger.Errorf("创建孩子账号失败: %v", err)
+		}
+		return &userprofile.CreateChildResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &userprofile.CreateChildResp{
+		Status: 200,
+		Msg:    "创建孩子账号成功",
+		Link:   toChildLink(link, child, nil),
+	}, nil
+}
+
+func (l *CreateChildLogic) create(in *userprofile.CreateChildReq) (*hps.GuardianLinks, *hps.Users, error) {
+	parent, err := currentParent(l.ctx, l.svcCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	relation, err := normalizeRelation(parent.Role, in.Relation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	child, err := NewCreateUserLogic(l.ctx, l.svcCtx).create(&userprofile.CreateUserReq{
+		Username: in.Username,
+		Nickname: in.Nickname,
+		Avatar:   in.Avatar,
+		Age:      in.Age,
+		Gender:   in.Gender,
+		Language: in.Language,
+		Password: in.Password,
+		Role:     auth.RoleChild,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	link, err := insertLink(l.ctx, l.svcCtx, parent.UserId, child.UserId, relation)
+	if err != nil {
+		// 关联失败时删除刚创建的账号，避免留下没有家长的孩子账号
+		if deleteErr := l.svcCtx.UserModel.SoftDelete(l.ctx, child); deleteErr != nil {
+			l.Logger.Errorf("删除未关联的孩子账号失败: %v", deleteErr)
+		}
+		return nil, nil, err
+	}
+	return link, child, nil
+}
@@ -0,0 +1,101 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+	"explorapal/pkg/idgen"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateUserLogic {
+	return &CreateUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建用户，用户名、手机号和邮箱至少填写一项，且不能与其他用户重复；注册时无需登录，创建老师账号需要管理员的令牌
+func (l *CreateUserLogic) CreateUser(in *userprofile.CreateUserReq) (*userprofile.CreateUserResp, error) {
+	if in.Username == "" && in.Phone == "" && in.Email == "" {
+		return &userprofile.CreateUserResp{
+			Status: 400,
+			Msg:    "用户名、手机号和邮箱至少填写一项",
+		}, nil
+	}
+
+	user, err := l.create(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "创建用户失败")
+		if err != nil {
+			l.Logger.Errorf("创建用户失败: %v", err)
+		}
+		return &userprofile.CreateUserResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &userprofile.CreateUserResp{
+		Status: 200,
+		Msg:    "创建用户成功",
+		User:   toUserProfile(user),
+	}, nil
+}
+
+func (l *CreateUserLogic) create(in *userprofile.CreateUserReq) (*hps.Users, error) {
+	if err := validateAge(in.Age); err != nil {
+		return nil, err
+	}
+	if err := validateGender(in.Gender); err != nil {
+		return nil, err
+	}
+	language, err := normalizeLanguage(in.Language)
+	if err != nil {
+		return nil, err
+	}
+	role, err := normalizeRole(l.ctx, in.Role)
+	if err != nil {
+		return nil, err
+	}
+	passwordHash, err := hashPassword(in.Password)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUnique(l.ctx, l.svcCtx, 0, in.Username, in.Phone, in.Email); err != nil {
+		return nil, err
+	}
+
+	userID := idgen.Next()
+	_, err = l.svcCtx.UserModel.Insert(l.ctx, &hps.Users{
+		UserId:       userID,
+		Username:     nullString(in.Username),
+		Nickname:     nullString(in.Nickname),
+		Avatar:       nullString(in.Avatar),
+		Age:          sql.NullInt64{Int64: int64(in.Age), Valid: in.Age > 0},
+		Gender:       nullString(in.Gender),
+		Language:     language,
+		Phone:        nullString(in.Phone),
+		Email:        nullString(in.Email),
+		PasswordHash: passwordHash,
+		Role:         role,
+		Status:       hps.UserStatusActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 重新查询以带上数据库生成的创建时间
+	return l.svcCtx.UserModel.FindOneByUserId(l.ctx, userID)
+}
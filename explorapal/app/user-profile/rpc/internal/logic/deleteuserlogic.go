@@ -0,0 +1,47 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteUserLogic {
+	return &DeleteUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 软删除自己的账号，记录删除时间，用户名、手机号和邮箱释放给新用户使用
+func (l *DeleteUserLogic) DeleteUser(in *userprofile.DeleteUserReq) (*userprofile.DeleteUserResp, error) {
+	user, err := ownUser(l.ctx, l.svcCtx, in.UserId)
+	if err == nil {
+		err = l.svcCtx.UserModel.SoftDelete(l.ctx, user)
+	}
+	if err != nil {
+		status, msg, err := errorStatus(err, "删除用户失败")
+		if err != nil {
+			l.Logger.Errorf("删除用户失败: %v", err)
+		}
+		return &userprofile.DeleteUserResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &userprofile.DeleteUserResp{
+		Status: 200,
+		Msg:    "删除用户成功",
+	}, nil
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FindUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFindUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FindUserLogic {
+	return &FindUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 按用户名、手机号或邮箱查找用户，依次取第一个非空的条件
+func (l *FindUserLogic) FindUser(in *userprofile.FindUserReq) (*userprofile.FindUserResp, error) {
+	var (
+		user *hps.Users
+		err  error
+	)
+	switch {
+	case in.Username != "":
+		user, err = l.svcCtx.UserModel.FindOneByUsername(l.ctx, in.Username)
+	case in.Phone != "":
+		user, err = l.svcCtx.UserModel.FindOneByPhone(l.ctx, in.Phone)
+	case in.Email != "":
+		user, err = l.svcCtx.UserModel.FindOneByEmail(l.ctx, in.Email)
+	default:
+		return &userprofile.FindUserResp{
+			Status: 400,
+			Msg:    "请填写用户名、手机号或邮箱",
+		}, nil
+	}
+	if err == hps.ErrNotFound {
+		err = errUserNotFound
+	}
+	if err != nil {
+		status, msg, err := errorStatus(err, "查找用户失败")
+		if err != nil {
+			l.Logger.Errorf("查找用户失败: %v", err)
+		}
+		return &userprofile.FindUserResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &userprofile.FindUserResp{
+		Status: 200,
+		Msg:    "查找用户成功",
+		User:   toUserProfile(user),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetConsentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetConsentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetConsentLogic {
+	return &GetConsentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取孩子当前生效的同意；没有同意过或条款已更新时consent为空
+func (l *GetConsentLogic) GetConsent(in *userprofile.GetConsentReq) (*userprofile.GetConsentResp, error) {
+	consent, err := l.get(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "获取家长同意失败")
+		if err != nil {
+			l.Logger.Errorf("获取家长同意失败: %v", err)
+		}
+		return &userprofile.GetConsentResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &userprofile.GetConsentResp{
+		Status:  200,
+		Msg:     "获取家长同意成功",
+		Consent: toParentalConsent(consent),
+	}, nil
+}
+
+func (l *GetConsentLogic) get(in *userprofile.GetConsentReq) (*hps.ParentalConsents, error) {
+	caller, err := findUser(l.ctx, l.svcCtx, auth.UserID(l.ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	childID := in.ChildId
+	switch caller.Role {
+	case auth.RoleChild:
+		if childID == 0 {
+			childID = caller.UserId
+		}
+		if childID != caller.UserId {
+			return nil, errForbidden
+		}
+	case auth.RoleParent:
+		if _, _, err := linkedChild(l.ctx, l.svcCtx, caller.UserId, childID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errForbidden
+	}
+
+	consent, err := latestConsent(l.ctx, l.svcCtx, childID)
+	if err != nil || consent == nil {
+		return nil, err
+	}
+	if !l.svcCtx.Consent.IsCurrent(consent.TermsVersion) {
+		return nil, nil
+	}
+	return consent, nil
+}
@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserLogic {
+	return &GetUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取自己的用户资料，已删除的用户视为不存在
+func (l *GetUserLogic) GetUser(in *userprofile.GetUserReq) (*userprofile.GetUserResp, error) {
+	user, err := ownUser(l.ctx, l.svcCtx, in.UserId)
+	if err != nil {
+		status, msg, err := errorStatus(err, "获取用户失败")
+		if err != nil {
+			l.Logger.Errorf("获取用户失败: %v", err)
+		}
+		return &userprofile.GetUserResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &userprofile.GetUserResp{
+		Status: 200,
+		Msg:    "获取用户成功",
+		User:   toUserProfile(user),
+	}, nil
+}
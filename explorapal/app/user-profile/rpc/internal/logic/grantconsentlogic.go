@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+	"explorapal/pkg/idgen"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GrantConsentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGrantConsentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GrantConsentLogic {
+	return &GrantConsentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 记录家长对当前条款版本的同意及开启的功能；每次提交新增一条记录保留历史，最新一条生效
+func (l *GrantConsentLogic) GrantConsent(in *userprofile.GrantConsentReq) (*userprofile.GrantConsentResp, error) {
+	consent, err := l.grant(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "保存家长同意失败")
+		if err != nil {
+			l.Logger.Errorf("保存家长同意失败: %v", err)
+		}
+		return &userprofile.GrantConsentResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &userprofile.GrantConsentResp{
+		Status:  200,
+		Msg:     "保存家长同意成功",
+		Consent: toParentalConsent(consent),
+	}, nil
+}
+
+func (l *GrantConsentLogic) grant(in *userprofile.GrantConsentReq) (*hps.ParentalConsents, error) {
+	parent, err := currentParent(l.ctx, l.svcCtx)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := linkedChild(l.ctx, l.svcCtx, parent.UserId, in.ChildId); err != nil {
+		return nil, err
+	}
+	if in.TermsVersion == "" || !l.svcCtx.Consent.IsCurrent(in.TermsVersion) {
+		return nil, errTermsOutdated
+	}
+
+	consent := &hps.ParentalConsents{
+		ConsentId:      idgen.Next(),
+		ParentId:       parent.UserId,
+		ChildId:        in.ChildId,
+		TermsVersion:   in.TermsVersion,
+		VoiceRecording: boolToInt64(in.VoiceRecording),
+		PhotoUpload:    boolToInt64(in.PhotoUpload),
+		Sharing:        boolToInt64(in.Sharing),
+		ConsentTime:    time.Now(),
+	}
+	if _, err := l.svcCtx.ParentalConsentModel.Insert(l.ctx, consent); err != nil {
+		return nil, err
+	}
+	return consent, nil
+}
@@ -0,0 +1,147 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/idgen"
+)
+
+var (
+	errNotParent       = &userError{status: 403, msg: "只有家长账号可以管理孩子"}
+	errNotAdult        = &userError{status: 403, msg: "只有家长和老师账号可以关联孩子"}
+	errNotChild        = &userError{status: 400, msg: "只能关联孩子账号"}
+	errChildNotLinked  = &userError{status: 404, msg: "没有关联这个孩子"}
+	errAlreadyLinked   = &userError{status: 409, msg: "已经关联过这个孩子"}
+	errChildLogin      = &userError{status: 403, msg: "孩子的用户名或密码错误"}
+	errInvalidRelation = &userError{status: 400, msg: "关系只能是father、mother或guardian"}
+	errTermsOutdated   = &userError{status: 400, msg: "请先阅读并同意最新版本的条款"}
+)
+
+// currentParent 查询调用方的家长账号，非家长账号不能管理孩子
+func currentParent(ctx context.Context, svcCtx *svc.ServiceContext) (*hps.Users, error) {
+	parent, err := findUser(ctx, svcCtx, auth.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if parent.Role != auth.RoleParent {
+		return nil, errNotParent
+	}
+	return parent, nil
+}
+
+// currentAdult 查询调用方的家长或老师账号
+func currentAdult(ctx context.Context, svcCtx *svc.ServiceContext) (*hps.Users, error) {
+	adult, err := findUser(ctx, svcCtx, auth.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if adult.Role != auth.RoleParent && adult.Role != auth.RoleTeacher {
+		return nil, errNotAdult
+	}
+	return adult, nil
+}
+
+// linkedChild 查询家长已关联的孩子
+func linkedChild(ctx context.Context, svcCtx *svc.ServiceContext, parentID, childID int64) (*hps.GuardianLinks, *hps.Users, error) {
+	link, err := svcCtx.GuardianLinkModel.FindOneByParentIdChildId(ctx, parentID, childID)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, nil, errChildNotLinked
+		}
+		return nil, nil, err
+	}
+	if link.DeleteTime.Valid {
+		return nil, nil, errChildNotLinked
+	}
+	child, err := findUser(ctx, svcCtx, childID)
+	if err != nil {
+		if err == errUserNotFound {
+			return nil, nil, errChildNotLinked
+		}
+		return nil, nil, err
+	}
+	return link, child, nil
+}
+
+// normalizeRelation 校验大人与孩子的关系：老师固定为teacher，家长为空时为监护人
+func normalizeRelation(role, relation string) (string, error) {
+	if role == auth.RoleTeacher {
+		return hps.GuardianRelationTeacher, nil
+	}
+	switch relation {
+	case "":
+		return hps.GuardianRelationGuardian, nil
+	case hps.GuardianRelationFather, hps.GuardianRelationMother, hps.GuardianRelationGuardian:
+		return relation, nil
+	default:
+		return "", errInvalidRelation
+	}
+}
+
+// insertLink 关联家长与孩子，同一对家长和孩子只能关联一次
+func insertLink(ctx context.Context, svcCtx *svc.ServiceContext, parentID, childID int64, relation string) (*hps.GuardianLinks, error) {
+	_, err := svcCtx.GuardianLinkModel.FindOneByParentIdChildId(ctx, parentID, childID)
+	if err == nil {
+		return nil, errAlreadyLinked
+	}
+	if err != hps.ErrNotFound {
+		return nil, err
+	}
+
+	linkID := idgen.Next()
+	_, err = svcCtx.GuardianLinkModel.Insert(ctx, &hps.GuardianLinks{
+		LinkId:   linkID,
+		ParentId: parentID,
+		ChildId:  childID,
+		Relation: relation,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return svcCtx.GuardianLinkModel.FindOneByLinkId(ctx, linkID)
+}
+
+// latestConsent 查询孩子当前生效的同意，没有同意过时返回nil
+func latestConsent(ctx context.Context, svcCtx *svc.ServiceContext, childID int64) (*hps.ParentalConsents, error) {
+	consent, err := svcCtx.ParentalConsentModel.FindLatest(ctx, childID)
+	if err == hps.ErrNotFound {
+		return nil, nil
+	}
+	return consent, err
+}
+
+func toChildLink(link *hps.GuardianLinks, child *hps.Users, consent *hps.ParentalConsents) *userprofile.ChildLink {
+	return &userprofile.ChildLink{
+		Child:    toUserProfile(child),
+		Relation: link.Relation,
+		LinkTime: link.CreateTime.Format(timeLayout),
+		Consent:  toParentalConsent(consent),
+	}
+}
+
+func toParentalConsent(consent *hps.ParentalConsents) *userprofile.ParentalConsent {
+	if consent == nil {
+		return nil
+	}
+	return &userprofile.ParentalConsent{
+		ConsentId:      consent.ConsentId,
+		ParentId:       consent.ParentId,
+		ChildId:        consent.ChildId,
+		TermsVersion:   consent.TermsVersion,
+		VoiceRecording: consent.VoiceRecording == 1,
+		PhotoUpload:    consent.PhotoUpload == 1,
+		Sharing:        consent.Sharing == 1,
+		ConsentTime:    consent.ConsentTime.Format(timeLayout),
+	}
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+	"explorapal/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LinkChildLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLinkChildLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LinkChildLogic {
+	return &LinkChildLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 家长或老师关联已有的孩子账号，需要孩子的用户名和密码证明能管理该账号
+func (l *LinkChildLogic) LinkChild(in *userprofile.LinkChildReq) (*userprofile.LinkChildResp, error) {
+	link, child, err := l.link(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "关联孩子账号失败")
+		if err != nil {
+			l.Logger.Errorf("关联孩子账号失败: %v", err)
+		}
+		return &userprofile.LinkChildResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	consent, err := latestConsent(l.ctx, l.svcCtx, child.UserId)
+	if err != nil {
+		// 同意记录只用于展示，查询失败不影响关联结果
+		l.Logger.Errorf("查询家长同意失败: %v", err)
+	}
+
+	return &userprofile.LinkChildResp{
+		Status: 200,
+		Msg:    "关联孩子账号成功",
+		Link:   toChildLink(link, child, consent),
+	}, nil
+}
+
+func (l *LinkChildLogic) link(in *userprofile.LinkChildReq) (*hps.GuardianLinks, *hps.Users, error) {
+	adult, err := currentAdult(l.ctx, l.svcCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	relation, err := normalizeRelation(adult.Role, in.Relation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 账号不存在和密码错误使用同一提示，避免暴露账号是否存在
+	child, err := l.svcCtx.UserModel.FindOneByUsername(l.ctx, in.Username)
+	if err == hps.ErrNotFound {
+		return nil, nil, errChildLogin
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !child.PasswordHash.Valid || !auth.VerifyPassword(in.Password, child.PasswordHash.String) {
+		return nil, nil, errChildLogin
+	}
+	if child.Role != auth.RoleChild {
+		return nil, nil, errNotChild
+	}
+
+	link, err := insertLink(l.ctx, l.svcCtx, adult.UserId, child.UserId, relation)
+	if err != nil {
+		return nil, nil, err
+	}
+	return link, child, nil
+}
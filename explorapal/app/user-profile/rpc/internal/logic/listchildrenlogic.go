@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListChildrenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListChildrenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListChildrenLogic {
+	return &ListChildrenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取家长或老师关联的孩子及各自当前生效的同意，已删除的孩子账号不返回
+func (l *ListChildrenLogic) ListChildren(in *userprofile.ListChildrenReq) (*userprofile.ListChildrenResp, error) {
+	children, err := l.list()
+	if err != nil {
+		status, msg, err := errorStatus(err, "获取孩子列表失败")
+		if err != nil {
+			l.Logger.Errorf("获取孩子列表失败: %v", err)
+		}
+		return &userprofile.ListChildrenResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &userprofile.ListChildrenResp{
+		Status:   200,
+		Msg:      "获取孩子列表成功",
+		Children: children,
+	}, nil
+}
+
+func (l *ListChildrenLogic) list() ([]*userprofile.ChildLink, error) {
+	adult, err := currentAdult(l.ctx, l.svcCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := l.svcCtx.GuardianLinkModel.FindByParent(l.ctx, adult.UserId)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, err
+	}
+
+	children := make([]*userprofile.ChildLink, 0, len(links))
+	for _, link := range links {
+		child, err := findUser(l.ctx, l.svcCtx, link.ChildId)
+		if err == errUserNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		consent, err := latestConsent(l.ctx, l.svcCtx, child.UserId)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, toChildLink(link, child, consent))
+	}
+	return children, nil
+}
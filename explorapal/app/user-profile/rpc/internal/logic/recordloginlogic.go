@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RecordLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRecordLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecordLoginLogic {
+	return &RecordLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 记录自己的最后登录时间，停用的用户不允许登录
+func (l *RecordLoginLogic) RecordLogin(in *userprofile.RecordLoginReq) (*userprofile.RecordLoginResp, error) {
+	loginAt, err := l.record(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "记录登录失败")
+		if err != nil {
+			l.Logger.Errorf("记录登录失败: %v", err)
+		}
+		return &userprofile.RecordLoginResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &userprofile.RecordLoginResp{
+		Status:      200,
+		Msg:         "记录登录成功",
+		LastLoginAt: loginAt.Format(timeLayout),
+	}, nil
+}
+
+func (l *RecordLoginLogic) record(in *userprofile.RecordLoginReq) (time.Time, error) {
+	user, err := ownUser(l.ctx, l.svcCtx, in.UserId)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if user.Status != hps.UserStatusActive {
+		return time.Time{}, errUserInactive
+	}
+
+	loginAt := time.Now()
+	if err := l.svcCtx.UserModel.UpdateLastLogin(l.ctx, user, loginAt); err != nil {
+		return time.Time{}, err
+	}
+	return loginAt, nil
+}
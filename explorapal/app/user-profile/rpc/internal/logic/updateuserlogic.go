@@ -0,0 +1,108 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateUserLogic {
+	return &UpdateUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 更新用户资料，只更新请求中填写的字段
+func (l *UpdateUserLogic) UpdateUser(in *userprofile.UpdateUserReq) (*userprofile.UpdateUserResp, error) {
+	user, err := l.update(in)
+	if err != nil {
+		status, msg, err := errorStatus(err, "更新用户失败")
+		if err != nil {
+			l.Logger.Errorf("更新用户失败: %v", err)
+		}
+		return &userprofile.UpdateUserResp{
+			Status: status,
+			Msg:    msg,
+		}, err
+	}
+
+	return &userprofile.UpdateUserResp{
+		Status: 200,
+		Msg:    "更新用户成功",
+		User:   toUserProfile(user),
+	}, nil
+}
+
+func (l *UpdateUserLogic) update(in *userprofile.UpdateUserReq) (*hps.Users, error) {
+	user, err := ownUser(l.ctx, l.svcCtx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAge(in.Age); err != nil {
+		return nil, err
+	}
+	if err := validateGender(in.Gender); err != nil {
+		return nil, err
+	}
+	if err := checkUnique(l.ctx, l.svcCtx, user.UserId, "", in.Phone, in.Email); err != nil {
+		return nil, err
+	}
+
+	if in.Nickname != "" {
+		user.Nickname = nullString(in.Nickname)
+	}
+	if in.Avatar != "" {
+		user.Avatar = nullString(in.Avatar)
+	}
+	if in.Age > 0 {
+		user.Age = sql.NullInt64{Int64: int64(in.Age), Valid: true}
+	}
+	if in.Gender != "" {
+		user.Gender = nullString(in.Gender)
+	}
+	if in.Language != "" {
+		language, err := normalizeLanguage(in.Language)
+		if err != nil {
+			return nil, err
+		}
+		user.Language = language
+	}
+	if in.Phone != "" {
+		user.Phone = nullString(in.Phone)
+	}
+	if in.Email != "" {
+		user.Email = nullString(in.Email)
+	}
+	if in.Password != "" {
+		passwordHash, err := hashPassword(in.Password)
+		if err != nil {
+			return nil, err
+		}
+		user.PasswordHash = passwordHash
+	}
+	switch in.Status {
+	case "":
+	case hps.UserStatusActive, hps.UserStatusInactive:
+		user.Status = in.Status
+	default:
+		return nil, errInvalidStatus
+	}
+
+	if err := l.svcCtx.UserModel.Update(l.ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
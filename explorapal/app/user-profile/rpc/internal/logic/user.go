@@ -0,0 +1,195 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/langdetect"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+// 年龄上限，超出视为填写错误
+const maxAge = 120
+
+// userError 用户资料的业务错误，转换为响应中的状态码和提示
+type userError struct {
+	status int32
+	msg    string
+}
+
+func (e *userError) Error() string {
+	return e.msg
+}
+
+var (
+	errUserNotFound    = &userError{status: 404, msg: "用户不存在"}
+	errUserInactive    = &userError{status: 403, msg: "用户已停用"}
+	errUsernameExists  = &userError{status: 409, msg: "用户名已被使用"}
+	errPhoneExists     = &userError{status: 409, msg: "手机号已被使用"}
+	errEmailExists     = &userError{status: 409, msg: "邮箱已被使用"}
+	errInvalidAge      = &userError{status: 400, msg: "年龄不正确"}
+	errInvalidGender   = &userError{status: 400, msg: "性别只能是male、female或other"}
+	errInvalidLanguage = &userError{status: 400, msg: "不支持的语言"}
+	errInvalidStatus   = &userError{status: 400, msg: "状态只能是active或inactive"}
+	errInvalidRole     = &userError{status: 400, msg: "角色只能是child、parent或teacher"}
+	errTeacherByAdmin  = &userError{status: 403, msg: "老师账号需要由管理员创建"}
+	errWeakPassword    = &userError{status: 400, msg: "密码至少需要8位"}
+	errForbidden       = &userError{status: 403, msg: "只能访问自己的资料"}
+)
+
+// 密码最短长度
+const minPasswordLen = 8
+
+// errorStatus 返回错误对应的状态码和提示；非业务错误返回500
+func errorStatus(err error, fallback string) (int32, string, error) {
+	var ue *userError
+	if errors.As(err, &ue) {
+		return ue.status, ue.msg, nil
+	}
+	return 500, fallback, err
+}
+
+// ownUser 查询调用方自己的资料，未填写用户ID时取令牌中的用户
+func ownUser(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) (*hps.Users, error) {
+	caller := auth.UserID(ctx)
+	if userID == 0 {
+		userID = caller
+	}
+	if userID != caller {
+		return nil, errForbidden
+	}
+	return findUser(ctx, svcCtx, userID)
+}
+
+// findUser 查询未删除的用户
+func findUser(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) (*hps.Users, error) {
+	user, err := svcCtx.UserModel.FindOneByUserId(ctx, userID)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, errUserNotFound
+		}
+		return nil, err
+	}
+	if user.DeleteTime.Valid {
+		return nil, errUserNotFound
+	}
+	return user, nil
+}
+
+// checkUnique 检查用户名、手机号和邮箱没有被其他未删除的用户使用
+func checkUnique(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, username, phone, email string) error {
+	checks := []struct {
+		value string
+		find  func(context.Context, string) (*hps.Users, error)
+		err   error
+	}{
+		{username, svcCtx.UserModel.FindOneByUsername, errUsernameExists},
+		{phone, svcCtx.UserModel.FindOneByPhone, errPhoneExists},
+		{email, svcCtx.UserModel.FindOneByEmail, errEmailExists},
+	}
+	for _, c := range checks {
+		if c.value == "" {
+			continue
+		}
+		user, err := c.find(ctx, c.value)
+		if err == hps.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if user.UserId != userID {
+			return c.err
+		}
+	}
+	return nil
+}
+
+// normalizeLanguage 规范化语言代码，为空时使用中文
+func normalizeLanguage(language string) (string, error) {
+	if strings.TrimSpace(language) == "" {
+		return langdetect.LanguageChinese, nil
+	}
+	normalized := langdetect.Normalize(language)
+	if normalized == "" {
+		return "", errInvalidLanguage
+	}
+	return normalized, nil
+}
+
+func validateAge(age int32) error {
+	if age < 0 || age > maxAge {
+		return errInvalidAge
+	}
+	return nil
+}
+
+// hashPassword 校验密码长度并生成哈希
+func hashPassword(password string) (sql.NullString, error) {
+	if len(password) < minPasswordLen {
+		return sql.NullString{}, errWeakPassword
+	}
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: hash, Valid: true}, nil
+}
+
+// normalizeRole 校验角色，为空时为孩子；自行注册只能是孩子或家长，老师账号只能由管理员登录后创建
+func normalizeRole(ctx context.Context, role string) (string, error) {
+	switch role {
+	case "":
+		return auth.RoleChild, nil
+	case auth.RoleChild, auth.RoleParent:
+		return role, nil
+	case auth.RoleTeacher:
+		if identity, ok := auth.FromContext(ctx); ok && identity.Role == auth.RoleAdmin {
+			return role, nil
+		}
+		return "", errTeacherByAdmin
+	default:
+		return "", errInvalidRole
+	}
+}
+
+func validateGender(gender string) error {
+	switch gender {
+	case "", "male", "female", "other":
+		return nil
+	default:
+		return errInvalidGender
+	}
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func toUserProfile(user *hps.Users) *userprofile.UserProfile {
+	profile := &userprofile.UserProfile{
+		UserId:     user.UserId,
+		Username:   user.Username.String,
+		Nickname:   user.Nickname.String,
+		Avatar:     user.Avatar.String,
+		Age:        int32(user.Age.Int64),
+		Gender:     user.Gender.String,
+		Language:   user.Language,
+		Phone:      user.Phone.String,
+		Email:      user.Email.String,
+		Status:     user.Status,
+		Role:       user.Role,
+		CreateTime: user.CreateTime.Format(timeLayout),
+	}
+	if user.LastLoginAt.Valid {
+		profile.LastLoginAt = user.LastLoginAt.Time.Format(timeLayout)
+	}
+	return profile
+}
@@ -0,0 +1,62 @@
+package svc
+
+import (
+	"explorapal/app/model/hps"
+	"explorapal/app/user-profile/rpc/internal/config"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/consent"
+	"explorapal/pkg/safetyaudit"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type ServiceContext struct {
+	Config config.Config
+
+	// 数据库模型
+	UserModel            hps.UsersModel
+	GuardianLinkModel    hps.GuardianLinksModel
+	ParentalConsentModel hps.ParentalConsentsModel
+	SafetyAuditLogModel  hps.SafetyAuditLogsModel
+
+	// 访问令牌校验
+	Auth *auth.Issuer
+
+	// 家长同意校验
+	Consent *consent.Checker
+
+	// 内容安全守卫
+	SafetyGuard *security.Guard
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	conn := sqlx.NewMysql(c.DBConfig.DataSource)
+
+	safetyAuditLogModel := hps.NewSafetyAuditLogsModel(conn, c.Cache)
+	parentalConsentModel := hps.NewParentalConsentsModel(conn, c.Cache)
+	securityClient := security.NewSecurityClient(&security.Config{
+		BaseURL:         c.SecurityConfig.BaseURL,
+		APIKey:          c.SecurityConfig.APIKey,
+		Timeout:         c.SecurityConfig.Timeout,
+		BatchWorkers:    c.SecurityConfig.BatchWorkers,
+		BatchSize:       c.SecurityConfig.BatchSize,
+		LocalRules:      c.SecurityConfig.LocalRules,
+		FailurePolicies: c.SecurityConfig.FailurePolicies,
+	})
+
+	return &ServiceContext{
+		Config: c,
+
+		UserModel:            hps.NewUsersModel(conn, c.Cache),
+		GuardianLinkModel:    hps.NewGuardianLinksModel(conn, c.Cache),
+		ParentalConsentModel: parentalConsentModel,
+		SafetyAuditLogModel:  safetyAuditLogModel,
+
+		Auth: auth.NewIssuer(&auth.Config{AccessSecret: c.JwtAuth.AccessSecret}),
+
+		Consent: consent.NewChecker(parentalConsentModel, c.Consent.TermsVersion),
+
+		SafetyGuard: security.NewGuard(securityClient, safetyaudit.NewAuditor(safetyAuditLogModel)),
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"explorapal/app/user-profile/rpc/internal/config"
+	"explorapal/app/user-profile/rpc/internal/server"
+	"explorapal/app/user-profile/rpc/internal/svc"
+	"explorapal/app/user-profile/rpc/userprofile"
+	"explorapal/pkg/auth"
+	"explorapal/pkg/idgen"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+var configFile = flag.String("f", "etc/userprofile.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	idgen.MustSetup(c.IDGen)
+	ctx := svc.NewServiceContext(c)
+
+	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
+		userprofile.RegisterUserProfileServiceServer(grpcServer, server.NewUserProfileServiceServer(ctx))
+
+		if c.Mode == service.DevMode || c.Mode == service.TestMode {
+			reflection.Register(grpcServer)
+		}
+	})
+	defer s.Stop()
+
+	// 身份认证：除注册外都需要透传登录令牌
+	s.AddUnaryInterceptors(auth.UnaryServerInterceptor(ctx.Auth, userprofile.UserProfileService_CreateUser_FullMethodName))
+	// 内容安全：只检查孩子可见的昵称和头像，手机号、邮箱等资料原样返回
+	s.AddUnaryInterceptors(security.UnaryServerInterceptor(ctx.SafetyGuard, security.ProfilePolicy, security.ProfilePolicy))
+
+	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
+	s.Start()
+}
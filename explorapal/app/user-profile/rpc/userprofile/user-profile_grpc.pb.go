@@ -0,0 +1,501 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: app/user-profile/rpc/user-profile.proto
+
+package userprofile
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserProfileService_CreateUser_FullMethodName   = "/userprofile.UserProfileService/CreateUser"
+	UserProfileService_GetUser_FullMethodName      = "/userprofile.UserProfileService/GetUser"
+	UserProfileService_UpdateUser_FullMethodName   = "/userprofile.UserProfileService/UpdateUser"
+	UserProfileService_DeleteUser_FullMethodName   = "/userprofile.UserProfileService/DeleteUser"
+	UserProfileService_FindUser_FullMethodName     = "/userprofile.UserProfileService/FindUser"
+	UserProfileService_RecordLogin_FullMethodName  = "/userprofile.UserProfileService/RecordLogin"
+	UserProfileService_CreateChild_FullMethodName  = "/userprofile.UserProfileService/CreateChild"
+	UserProfileService_LinkChild_FullMethodName    = "/userprofile.UserProfileService/LinkChild"
+	UserProfileService_ListChildren_FullMethodName = "/userprofile.UserProfileService/ListChildren"
+	UserProfileService_GrantConsent_FullMethodName = "/userprofile.UserProfileService/GrantConsent"
+	UserProfileService_GetConsent_FullMethodName   = "/userprofile.UserProfileService/GetConsent"
+)
+
+// UserProfileServiceClient is the client API for UserProfileService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserProfileServiceClient interface {
+	CreateUser(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*CreateUserResp, error)
+	GetUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*GetUserResp, error)
+	UpdateUser(ctx context.Context, in *UpdateUserReq, opts ...grpc.CallOption) (*UpdateUserResp, error)
+	DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error)
+	FindUser(ctx context.Context, in *FindUserReq, opts ...grpc.CallOption) (*FindUserResp, error)
+	RecordLogin(ctx context.Context, in *RecordLoginReq, opts ...grpc.CallOption) (*RecordLoginResp, error)
+	CreateChild(ctx context.Context, in *CreateChildReq, opts ...grpc.CallOption) (*CreateChildResp, error)
+	LinkChild(ctx context.Context, in *LinkChildReq, opts ...grpc.CallOption) (*LinkChildResp, error)
+	ListChildren(ctx context.Context, in *ListChildrenReq, opts ...grpc.CallOption) (*ListChildrenResp, error)
+	GrantConsent(ctx context.Context, in *GrantConsentReq, opts ...grpc.CallOption) (*GrantConsentResp, error)
+	GetConsent(ctx context.Context, in *GetConsentReq, opts ...grpc.CallOption) (*GetConsentResp, error)
+}
+
+type userProfileServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserProfileServiceClient(cc grpc.ClientConnInterface) UserProfileServiceClient {
+	return &userProfileServiceClient{cc}
+}
+
+func (c *userProfileServiceClient) CreateUser(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*CreateUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserResp)
+	err := c.cc.Invoke(ctx, UserProfileService_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) GetUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*GetUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResp)
+	err := c.cc.Invoke(ctx, UserProfileService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) UpdateUser(ctx context.Context, in *UpdateUserReq, opts ...grpc.CallOption) (*UpdateUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserResp)
+	err := c.cc.Invoke(ctx, UserProfileService_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResp)
+	err := c.cc.Invoke(ctx, UserProfileService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) FindUser(ctx context.Context, in *FindUserReq, opts ...grpc.CallOption) (*FindUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindUserResp)
+	err := c.cc.Invoke(ctx, UserProfileService_FindUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) RecordLogin(ctx context.Context, in *RecordLoginReq, opts ...grpc.CallOption) (*RecordLoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordLoginResp)
+	err := c.cc.Invoke(ctx, UserProfileService_RecordLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) CreateChild(ctx context.Context, in *CreateChildReq, opts ...grpc.CallOption) (*CreateChildResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChildResp)
+	err := c.cc.Invoke(ctx, UserProfileService_CreateChild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) LinkChild(ctx context.Context, in *LinkChildReq, opts ...grpc.CallOption) (*LinkChildResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkChildResp)
+	err := c.cc.Invoke(ctx, UserProfileService_LinkChild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) ListChildren(ctx context.Context, in *ListChildrenReq, opts ...grpc.CallOption) (*ListChildrenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChildrenResp)
+	err := c.cc.Invoke(ctx, UserProfileService_ListChildren_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) GrantConsent(ctx context.Context, in *GrantConsentReq, opts ...grpc.CallOption) (*GrantConsentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantConsentResp)
+	err := c.cc.Invoke(ctx, UserProfileService_GrantConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) GetConsent(ctx context.Context, in *GetConsentReq, opts ...grpc.CallOption) (*GetConsentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConsentResp)
+	err := c.cc.Invoke(ctx, UserProfileService_GetConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserProfileServiceServer is the server API for UserProfileService service.
+// All implementations must embed UnimplementedUserProfileServiceServer
+// for forward compatibility.
+type UserProfileServiceServer interface {
+	CreateUser(context.Context, *CreateUserReq) (*CreateUserResp, error)
+	GetUser(context.Context, *GetUserReq) (*GetUserResp, error)
+	UpdateUser(context.Context, *UpdateUserReq) (*UpdateUserResp, error)
+	DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error)
+	FindUser(context.Context, *FindUserReq) (*FindUserResp, error)
+	RecordLogin(context.Context, *RecordLoginReq) (*RecordLoginResp, error)
+	CreateChild(context.Context, *CreateChildReq) (*CreateChildResp, error)
+	LinkChild(context.Context, *LinkChildReq) (*LinkChildResp, error)
+	ListChildren(context.Context, *ListChildrenReq) (*ListChildrenResp, error)
+	GrantConsent(context.Context, *GrantConsentReq) (*GrantConsentResp, error)
+	GetConsent(context.Context, *GetConsentReq) (*GetConsentResp, error)
+	mustEmbedUnimplementedUserProfileServiceServer()
+}
+
+// UnimplementedUserProfileServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserProfileServiceServer struct{}
+
+func (UnimplementedUserProfileServiceServer) CreateUser(context.Context, *CreateUserReq) (*CreateUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserProfileServiceServer) GetUser(context.Context, *GetUserReq) (*GetUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserProfileServiceServer) UpdateUser(context.Context, *UpdateUserReq) (*UpdateUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserProfileServiceServer) DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserProfileServiceServer) FindUser(context.Context, *FindUserReq) (*FindUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUser not implemented")
+}
+func (UnimplementedUserProfileServiceServer) RecordLogin(context.Context, *RecordLoginReq) (*RecordLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordLogin not implemented")
+}
+func (UnimplementedUserProfileServiceServer) CreateChild(context.Context, *CreateChildReq) (*CreateChildResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChild not implemented")
+}
+func (UnimplementedUserProfileServiceServer) LinkChild(context.Context, *LinkChildReq) (*LinkChildResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkChild not implemented")
+}
+func (UnimplementedUserProfileServiceServer) ListChildren(context.Context, *ListChildrenReq) (*ListChildrenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChildren not implemented")
+}
+func (UnimplementedUserProfileServiceServer) GrantConsent(context.Context, *GrantConsentReq) (*GrantConsentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantConsent not implemented")
+}
+func (UnimplementedUserProfileServiceServer) GetConsent(context.Context, *GetConsentReq) (*GetConsentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsent not implemented")
+}
+func (UnimplementedUserProfileServiceServer) mustEmbedUnimplementedUserProfileServiceServer() {}
+func (UnimplementedUserProfileServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeUserProfileServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserProfileServiceServer will
+// result in compilation errors.
+type UnsafeUserProfileServiceServer interface {
+	mustEmbedUnimplementedUserProfileServiceServer()
+}
+
+func RegisterUserProfileServiceServer(s grpc.ServiceRegistrar, srv UserProfileServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserProfileServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserProfileService_ServiceDesc, srv)
+}
+
+func _UserProfileService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).CreateUser(ctx, req.(*CreateUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).GetUser(ctx, req.(*GetUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).UpdateUser(ctx, req.(*UpdateUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).DeleteUser(ctx, req.(*DeleteUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_FindUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).FindUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_FindUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).FindUser(ctx, req.(*FindUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_RecordLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).RecordLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_RecordLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).RecordLogin(ctx, req.(*RecordLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_CreateChild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChildReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).CreateChild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_CreateChild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).CreateChild(ctx, req.(*CreateChildReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_LinkChild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkChildReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).LinkChild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_LinkChild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).LinkChild(ctx, req.(*LinkChildReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_ListChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildrenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).ListChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_ListChildren_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).ListChildren(ctx, req.(*ListChildrenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_GrantConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantConsentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).GrantConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_GrantConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).GrantConsent(ctx, req.(*GrantConsentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_GetConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).GetConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_GetConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).GetConsent(ctx, req.(*GetConsentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserProfileService_ServiceDesc is the grpc.ServiceDesc for UserProfileService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserProfileService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "userprofile.UserProfileService",
+	HandlerType: (*UserProfileServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserProfileService_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _UserProfileService_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserProfileService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserProfileService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "FindUser",
+			Handler:    _UserProfileService_FindUser_Handler,
+		},
+		{
+			MethodName: "RecordLogin",
+			Handler:    _UserProfileService_RecordLogin_Handler,
+		},
+		{
+			MethodName: "CreateChild",
+			Handler:    _UserProfileService_CreateChild_Handler,
+		},
+		{
+			MethodName: "LinkChild",
+			Handler:    _UserProfileService_LinkChild_Handler,
+		},
+		{
+			MethodName: "ListChildren",
+			Handler:    _UserProfileService_ListChildren_Handler,
+		},
+		{
+			MethodName: "GrantConsent",
+			Handler:    _UserProfileService_GrantConsent_Handler,
+		},
+		{
+			MethodName: "GetConsent",
+			Handler:    _UserProfileService_GetConsent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "app/user-profile/rpc/user-profile.proto",
+}
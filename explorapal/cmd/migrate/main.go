@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"explorapal/pkg/migrate"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// Config 与各服务的配置文件共用DBConfig，可以直接指定任一服务的配置文件
+type Config struct {
+	DBConfig struct {
+		DataSource string
+	}
+}
+
+var (
+	configFile  = flag.String("f", "", "读取DBConfig.DataSource的配置文件，如app/project-management/rpc/etc/project-management.yaml")
+	dataSource  = flag.String("dsn", "", "数据库连接串，优先于配置文件，需包含parseTime=true")
+	dir         = flag.String("dir", "database/migrations", "迁移文件目录")
+	dryRun      = flag.Bool("dry-run", false, "只输出将要执行的语句，不修改数据库")
+	lockTimeout = flag.Duration("lock-timeout", 30*time.Second, "等待其他实例释放迁移锁的时间")
+)
+
+const usage = `用法: migrate [选项] <命令>
+
+命令:
+  up [N]          执行未执行的迁移，N为执行数量，不指定时执行全部
+  down N          回滚最近执行的N个迁移
+  status          查看迁移执行状态
+  create NAME     创建新的迁移文件
+  force VERSION   不执行迁移，把数据库标记为已执行到VERSION并清除dirty状态，0表示清除全部记录
+
+选项:
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(context.Background(), flag.Arg(0), flag.Args()[1:]); err != nil {
+		if errors.Is(err, migrate.ErrNoChange) {
+			fmt.Println(err)
+			return
+		}
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, command string, args []string) error {
+	migrations, err := migrate.Load(os.DirFS(*dir))
+	if err != nil {
+		return err
+	}
+
+	// 创建迁移文件不需要连接数据库
+	if command == "create" {
+		if len(args) != 1 {
+			return errors.New("create需要指定迁移名称")
+		}
+		paths, err := migrate.Create(*dir, args[0], migrations, time.Now())
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			fmt.Println("已创建", path)
+		}
+		return nil
+	}
+
+	runner, err := newRunner(migrations)
+	if err != nil {
+		return err
+	}
+	switch command {
+	case "up":
+		n := 0
+		if len(args) > 0 {
+			if n, err = positive(args[0]); err != nil {
+				return err
+			}
+		}
+		return runner.Up(ctx, n)
+	case "down":
+		if len(args) != 1 {
+			return errors.New("down需要指定回滚数量")
+		}
+		n, err := positive(args[0])
+		if err != nil {
+			return err
+		}
+		return runner.Down(ctx, n)
+	case "status":
+		return printStatus(ctx, runner)
+	case "force":
+		if len(args) != 1 {
+			return errors.New("force需要指定版本")
+		}
+		version, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || version < 0 {
+			return fmt.Errorf("版本不正确: %s", args[0])
+		}
+		return runner.Force(ctx, version)
+	default:
+		return fmt.Errorf("未知命令: %s", command)
+	}
+}
+
+func newRunner(migrations []*migrate.Migration) (*migrate.Runner, error) {
+	dsn := *dataSource
+	if dsn == "" && *configFile != "" {
+		var c Config
+		if err := conf.Load(*configFile, &c); err != nil {
+			return nil, fmt.Errorf("读取配置文件失败: %w", err)
+		}
+		dsn = c.DBConfig.DataSource
+	}
+	if dsn == "" {
+		return nil, errors.New("请通过-dsn或-f指定数据库")
+	}
+
+	db, err := sqlx.NewMysql(dsn).RawDB()
+	if err != nil {
+		return nil, fmt.Errorf("连接数据库失败: %w", err)
+	}
+	return migrate.NewRunner(db, migrate.MySQL{}, migrations, migrate.Options{
+		DryRun:      *dryRun,
+		LockTimeout: *lockTimeout,
+		Out:         os.Stdout,
+	}), nil
+}
+
+func printStatus(ctx context.Context, runner *migrate.Runner) error {
+	statuses, err := runner.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "版本\t名称\t状态\t执行时间")
+	for _, s := range statuses {
+		state, appliedTime := "未执行", ""
+		if s.Applied {
+			state = "已执行"
+			appliedTime = s.AppliedTime.Format("2006-01-02 15:04:05")
+		}
+		switch {
+		case s.Dirty:
+			state = "dirty"
+		case s.Missing:
+			state += "（文件缺失）"
+		case s.Modified:
+			state += "（文件已修改）"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.Version, s.Name, state, appliedTime)
+	}
+	return w.Flush()
+}
+
+func positive(arg string) (int, error) {
+	n, err := strconv.Atoi(arg)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("数量不正确: %s", arg)
+	}
+	return n, nil
+}
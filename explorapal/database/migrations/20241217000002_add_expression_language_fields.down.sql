@@ -0,0 +1,7 @@
+-- 删除表达记录的语言检测与平行翻译字段
+ALTER TABLE `expressions`
+  DROP KEY `idx_language`,
+  DROP COLUMN `polished_translation`,
+  DROP COLUMN `translation_language`,
+  DROP COLUMN `mixed_language`,
+  MODIFY COLUMN `language` varchar(10) DEFAULT 'zh-CN' COMMENT '语言代码';
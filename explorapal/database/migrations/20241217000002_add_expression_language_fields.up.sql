@@ -0,0 +1,7 @@
+-- 表达记录增加语言检测与平行翻译字段
+ALTER TABLE `expressions`
+  MODIFY COLUMN `language` varchar(10) DEFAULT 'zh-CN' COMMENT '检测到的主要语言代码',
+  ADD COLUMN `mixed_language` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否中英混合表达' AFTER `language`,
+  ADD COLUMN `translation_language` varchar(10) DEFAULT NULL COMMENT '平行翻译的目标语言' AFTER `key_learnings`,
+  ADD COLUMN `polished_translation` text COMMENT '润色笔记的平行译文JSON' AFTER `translation_language`,
+  ADD KEY `idx_language` (`language`);
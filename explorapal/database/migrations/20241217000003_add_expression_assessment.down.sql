@@ -0,0 +1,8 @@
+-- 删除表达记录的发音与流利度评估字段
+ALTER TABLE `expressions`
+  DROP KEY `idx_user_assessment`,
+  DROP COLUMN `assessment_detail`,
+  DROP COLUMN `assessment_filler_count`,
+  DROP COLUMN `assessment_pace`,
+  DROP COLUMN `assessment_accuracy`,
+  DROP COLUMN `assessment_target`;
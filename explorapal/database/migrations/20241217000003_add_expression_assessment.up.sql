@@ -0,0 +1,8 @@
+-- 表达记录增加发音与流利度评估字段
+ALTER TABLE `expressions`
+  ADD COLUMN `assessment_target` varchar(200) DEFAULT NULL COMMENT '发音练习的目标句' AFTER `polished_translation`,
+  ADD COLUMN `assessment_accuracy` decimal(5,4) DEFAULT NULL COMMENT '单词准确率(0-1)' AFTER `assessment_target`,
+  ADD COLUMN `assessment_pace` decimal(6,2) DEFAULT NULL COMMENT '语速(每秒词数)' AFTER `assessment_accuracy`,
+  ADD COLUMN `assessment_filler_count` int DEFAULT NULL COMMENT '停顿词数量' AFTER `assessment_pace`,
+  ADD COLUMN `assessment_detail` text COMMENT '逐词评估与反馈JSON' AFTER `assessment_filler_count`,
+  ADD KEY `idx_user_assessment` (`user_id`, `assessment_accuracy`, `create_time`);
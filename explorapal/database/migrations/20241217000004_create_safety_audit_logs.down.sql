@@ -0,0 +1,2 @@
+-- 删除内容安全审计日志表
+DROP TABLE IF EXISTS `safety_audit_logs`;
@@ -0,0 +1,26 @@
+-- 创建内容安全审计日志表
+CREATE TABLE IF NOT EXISTS `safety_audit_logs` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `delete_time` datetime DEFAULT NULL COMMENT '删除时间',
+  `audit_id` bigint(20) NOT NULL COMMENT '审计记录ID',
+  `user_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '用户ID',
+  `session_id` varchar(64) DEFAULT NULL COMMENT '会话ID(链路追踪ID)',
+  `source` varchar(200) NOT NULL COMMENT '来源：API路由或RPC方法',
+  `direction` varchar(10) NOT NULL COMMENT '检查方向：input,output',
+  `field` varchar(100) DEFAULT NULL COMMENT '被检查的字段',
+  `content_type` varchar(20) NOT NULL COMMENT '内容类型：text,image_url,audio_url',
+  `content_hash` char(64) NOT NULL COMMENT '内容SHA256',
+  `content_excerpt` varchar(500) DEFAULT NULL COMMENT '内容摘录',
+  `risk_level` varchar(20) NOT NULL COMMENT '风险等级：low,medium,high,reject,error',
+  `action` varchar(20) NOT NULL COMMENT '处理动作：pass,filter,block',
+  `suggestion` varchar(500) DEFAULT NULL COMMENT '处理建议',
+  `details` text COMMENT '风险详情JSON',
+  `latency_ms` int(11) NOT NULL DEFAULT '0' COMMENT '检查耗时(毫秒)',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_audit_id` (`audit_id`),
+  KEY `idx_user_time` (`user_id`, `create_time`),
+  KEY `idx_action` (`action`),
+  KEY `idx_content_hash` (`content_hash`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='内容安全审计日志表';
@@ -0,0 +1,6 @@
+-- 删除观察记录的图片安全筛查字段
+ALTER TABLE `observations`
+  DROP KEY `idx_status`,
+  DROP COLUMN `screening_detail`,
+  DROP COLUMN `quarantine_key`,
+  DROP COLUMN `status`;
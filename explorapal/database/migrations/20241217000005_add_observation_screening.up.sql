@@ -0,0 +1,9 @@
+-- 观察记录增加图片安全筛查字段
+ALTER TABLE `observations`
+  ADD COLUMN `status` varchar(20) NOT NULL DEFAULT 'uploaded' COMMENT '状态：uploaded,recognized,blocked' AFTER `image_size`,
+  ADD COLUMN `quarantine_key` varchar(500) DEFAULT NULL COMMENT '隔离区存储路径' AFTER `status`,
+  ADD COLUMN `screening_detail` text COMMENT '图片安全筛查结果JSON' AFTER `quarantine_key`,
+  ADD KEY `idx_status` (`status`);
+
+-- 已经识别完成的观察记录标记为recognized，否则不计入项目进度
+UPDATE `observations` SET `status` = 'recognized' WHERE `object_name` IS NOT NULL AND `object_name` <> '';
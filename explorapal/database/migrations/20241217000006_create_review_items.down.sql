@@ -0,0 +1,6 @@
+-- 删除人工审核状态和人工审核队列表
+ALTER TABLE `achievements` DROP COLUMN `review_status`;
+
+ALTER TABLE `expressions` DROP COLUMN `review_status`;
+
+DROP TABLE IF EXISTS `review_items`;
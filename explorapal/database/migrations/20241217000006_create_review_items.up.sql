@@ -0,0 +1,36 @@
+-- 创建人工审核队列表
+CREATE TABLE IF NOT EXISTS `review_items` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `delete_time` datetime DEFAULT NULL COMMENT '删除时间',
+  `review_id` bigint(20) NOT NULL COMMENT '审核记录ID',
+  `project_id` bigint(20) NOT NULL COMMENT '项目ID',
+  `user_id` bigint(20) NOT NULL COMMENT '内容所属的孩子用户ID',
+  `source_table` varchar(50) NOT NULL COMMENT '来源表：expressions,achievements,observations',
+  `source_id` bigint(20) NOT NULL COMMENT '来源记录的业务ID',
+  `source_field` varchar(50) NOT NULL COMMENT '来源字段',
+  `content_type` varchar(20) NOT NULL COMMENT '内容类型：text,image_url',
+  `content_ref` varchar(500) DEFAULT NULL COMMENT '内容引用：文字内容的SHA256或隔离区存储路径',
+  `content` longtext COMMENT '待审核的文字内容',
+  `risk_level` varchar(20) NOT NULL COMMENT '风险等级：low,medium,high,reject,error',
+  `risk_details` text COMMENT '风险详情JSON',
+  `status` varchar(20) NOT NULL DEFAULT 'pending' COMMENT '审核状态：pending,claimed,approved,rejected',
+  `reviewer_id` bigint(20) DEFAULT NULL COMMENT '审核人ID',
+  `claim_time` datetime DEFAULT NULL COMMENT '认领时间',
+  `edited_content` longtext COMMENT '审核人修改后的内容',
+  `review_note` varchar(500) DEFAULT NULL COMMENT '审核意见',
+  `decide_time` datetime DEFAULT NULL COMMENT '审核完成时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_review_id` (`review_id`),
+  KEY `idx_status_time` (`status`, `create_time`),
+  KEY `idx_source` (`source_table`, `source_id`),
+  KEY `idx_reviewer_status` (`reviewer_id`, `status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='人工审核队列表';
+
+-- 表达记录和成果增加人工审核状态
+ALTER TABLE `expressions`
+  ADD COLUMN `review_status` varchar(20) NOT NULL DEFAULT 'none' COMMENT '人工审核状态：none,pending,approved,rejected' AFTER `assessment_detail`;
+
+ALTER TABLE `achievements`
+  ADD COLUMN `review_status` varchar(20) NOT NULL DEFAULT 'none' COMMENT '人工审核状态：none,pending,approved,rejected' AFTER `status`;
@@ -0,0 +1,3 @@
+-- 删除用户偏好语言
+ALTER TABLE `users`
+  DROP COLUMN `language`;
@@ -0,0 +1,3 @@
+-- 用户表增加偏好语言，AI对话和润色按该语言生成内容
+ALTER TABLE `users`
+  ADD COLUMN `language` varchar(10) NOT NULL DEFAULT 'zh-CN' COMMENT '偏好语言：zh-CN,en-US' AFTER `gender`;
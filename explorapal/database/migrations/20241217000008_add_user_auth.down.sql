@@ -0,0 +1,4 @@
+-- 删除用户登录密码和角色
+ALTER TABLE `users`
+  DROP COLUMN `role`,
+  DROP COLUMN `password_hash`;
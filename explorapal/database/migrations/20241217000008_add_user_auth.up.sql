@@ -0,0 +1,4 @@
+-- 用户表增加登录密码和角色
+ALTER TABLE `users`
+  ADD COLUMN `password_hash` varchar(255) DEFAULT NULL COMMENT '登录密码哈希' AFTER `email`,
+  ADD COLUMN `role` varchar(20) NOT NULL DEFAULT 'child' COMMENT '角色：child,parent,teacher' AFTER `password_hash`;
@@ -0,0 +1,3 @@
+-- 删除家长同意记录表和家长与孩子账号关联表
+DROP TABLE IF EXISTS `parental_consents`;
+DROP TABLE IF EXISTS `guardian_links`;
@@ -0,0 +1,34 @@
+-- 创建家长与孩子账号关联表
+CREATE TABLE IF NOT EXISTS `guardian_links` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `delete_time` datetime DEFAULT NULL COMMENT '删除时间',
+  `link_id` bigint(20) NOT NULL COMMENT '关联记录ID',
+  `parent_id` bigint(20) NOT NULL COMMENT '家长用户ID',
+  `child_id` bigint(20) NOT NULL COMMENT '孩子用户ID',
+  `relation` varchar(20) NOT NULL DEFAULT 'guardian' COMMENT '关系：father,mother,guardian',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_link_id` (`link_id`),
+  UNIQUE KEY `idx_parent_child` (`parent_id`, `child_id`),
+  KEY `idx_child_id` (`child_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='家长与孩子账号关联表';
+
+-- 创建家长同意记录表，每次同意或变更都新增一条，最新一条为当前生效的同意
+CREATE TABLE IF NOT EXISTS `parental_consents` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `delete_time` datetime DEFAULT NULL COMMENT '删除时间',
+  `consent_id` bigint(20) NOT NULL COMMENT '同意记录ID',
+  `parent_id` bigint(20) NOT NULL COMMENT '同意的家长用户ID',
+  `child_id` bigint(20) NOT NULL COMMENT '孩子用户ID',
+  `terms_version` varchar(20) NOT NULL COMMENT '同意的条款版本',
+  `voice_recording` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否允许录音',
+  `photo_upload` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否允许上传照片',
+  `sharing` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否允许分享',
+  `consent_time` datetime NOT NULL COMMENT '同意时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_consent_id` (`consent_id`),
+  KEY `idx_child_time` (`child_id`, `consent_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='家长同意记录表';
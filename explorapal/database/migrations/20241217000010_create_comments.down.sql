@@ -0,0 +1,6 @@
+-- 删除通知表和留言表
+DROP TABLE IF EXISTS `notifications`;
+DROP TABLE IF EXISTS `comments`;
+
+ALTER TABLE `guardian_links`
+  MODIFY COLUMN `relation` varchar(20) NOT NULL DEFAULT 'guardian' COMMENT '关系：father,mother,guardian';
@@ -0,0 +1,50 @@
+-- 老师账号也通过关联表关联孩子
+ALTER TABLE `guardian_links`
+  MODIFY COLUMN `relation` varchar(20) NOT NULL DEFAULT 'guardian' COMMENT '关系：father,mother,guardian,teacher';
+
+-- 创建留言表：家长和老师对孩子的项目、观察、表达和成果的留言
+CREATE TABLE IF NOT EXISTS `comments` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `delete_time` datetime DEFAULT NULL COMMENT '删除时间',
+  `comment_id` bigint(20) NOT NULL COMMENT '留言ID',
+  `project_id` bigint(20) NOT NULL COMMENT '项目ID',
+  `child_id` bigint(20) NOT NULL COMMENT '项目所属的孩子用户ID',
+  `author_id` bigint(20) NOT NULL COMMENT '留言人用户ID',
+  `author_role` varchar(20) NOT NULL COMMENT '留言人角色：parent,teacher',
+  `relation` varchar(20) NOT NULL COMMENT '留言人与孩子的关系：father,mother,guardian,teacher',
+  `target_type` varchar(20) NOT NULL COMMENT '留言对象类型：project,observation,expression,achievement',
+  `target_id` bigint(20) NOT NULL COMMENT '留言对象的业务ID',
+  `kind` varchar(20) NOT NULL COMMENT '留言形式：text,sticker,voice',
+  `content` text COMMENT '文字内容，语音留言为识别文本',
+  `sticker` varchar(50) DEFAULT NULL COMMENT '贴纸代码',
+  `voice_url` varchar(500) DEFAULT NULL COMMENT '语音留言URL',
+  `voice_duration` decimal(10,2) DEFAULT NULL COMMENT '语音时长(秒)',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_comment_id` (`comment_id`),
+  KEY `idx_project_time` (`project_id`, `create_time`),
+  KEY `idx_target` (`target_type`, `target_id`),
+  KEY `idx_author_id` (`author_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='留言表';
+
+-- 创建通知表
+CREATE TABLE IF NOT EXISTS `notifications` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `delete_time` datetime DEFAULT NULL COMMENT '删除时间',
+  `notification_id` bigint(20) NOT NULL COMMENT '通知ID',
+  `user_id` bigint(20) NOT NULL COMMENT '接收通知的用户ID',
+  `type` varchar(30) NOT NULL COMMENT '通知类型：comment',
+  `title` varchar(200) NOT NULL COMMENT '通知标题',
+  `content` varchar(500) DEFAULT NULL COMMENT '通知内容',
+  `project_id` bigint(20) DEFAULT NULL COMMENT '关联的项目ID',
+  `ref_type` varchar(30) DEFAULT NULL COMMENT '关联记录类型：comment',
+  `ref_id` bigint(20) DEFAULT NULL COMMENT '关联记录的业务ID',
+  `read_time` datetime DEFAULT NULL COMMENT '已读时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_notification_id` (`notification_id`),
+  KEY `idx_user_read` (`user_id`, `read_time`),
+  KEY `idx_user_time` (`user_id`, `create_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='通知表';
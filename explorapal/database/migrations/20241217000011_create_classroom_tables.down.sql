@@ -0,0 +1,12 @@
+-- 删除班级作业、班级成员和班级表，移除项目关联的作业
+ALTER TABLE `projects`
+  DROP KEY `idx_assignment_id`,
+  DROP COLUMN `assignment_id`;
+
+ALTER TABLE `notifications`
+  MODIFY COLUMN `type` varchar(30) NOT NULL COMMENT '通知类型：comment',
+  MODIFY COLUMN `ref_type` varchar(30) DEFAULT NULL COMMENT '关联记录类型：comment';
+
+DROP TABLE IF EXISTS `assignments`;
+DROP TABLE IF EXISTS `class_members`;
+DROP TABLE IF EXISTS `classes`;
@@ -0,0 +1,59 @@
+-- 创建班级表
+CREATE TABLE IF NOT EXISTS `classes` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `delete_time` datetime DEFAULT NULL COMMENT '删除时间',
+  `class_id` bigint(20) NOT NULL COMMENT '班级ID',
+  `teacher_id` bigint(20) NOT NULL COMMENT '老师用户ID',
+  `name` varchar(100) NOT NULL COMMENT '班级名称',
+  `grade` varchar(20) DEFAULT NULL COMMENT '年级',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_class_id` (`class_id`),
+  KEY `idx_teacher_id` (`teacher_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='班级表';
+
+-- 创建班级成员表
+CREATE TABLE IF NOT EXISTS `class_members` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '加入时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `class_id` bigint(20) NOT NULL COMMENT '班级ID',
+  `student_id` bigint(20) NOT NULL COMMENT '学生用户ID',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_class_student` (`class_id`, `student_id`),
+  KEY `idx_student_id` (`student_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='班级成员表';
+
+-- 创建班级作业表：老师布置的探索项目，布置时为每个学生创建一个项目
+CREATE TABLE IF NOT EXISTS `assignments` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `delete_time` datetime DEFAULT NULL COMMENT '删除时间',
+  `assignment_id` bigint(20) NOT NULL COMMENT '作业ID',
+  `class_id` bigint(20) NOT NULL COMMENT '班级ID',
+  `teacher_id` bigint(20) NOT NULL COMMENT '老师用户ID',
+  `title` varchar(200) NOT NULL COMMENT '作业标题，也是学生项目的标题',
+  `category` varchar(50) NOT NULL COMMENT '项目类别',
+  `driving_question` text NOT NULL COMMENT '驱动问题',
+  `description` text COMMENT '作业说明',
+  `tags` text COMMENT '标签JSON数组',
+  `required_observations` int(11) NOT NULL DEFAULT '0' COMMENT '要求的观察记录数',
+  `required_questions` int(11) NOT NULL DEFAULT '0' COMMENT '要求得到回答的问题数',
+  `required_expressions` int(11) NOT NULL DEFAULT '0' COMMENT '要求的表达记录数',
+  `due_time` datetime DEFAULT NULL COMMENT '截止时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_assignment_id` (`assignment_id`),
+  KEY `idx_class_id` (`class_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='班级作业表';
+
+-- 项目关联布置它的作业，学生自己创建的项目为空
+ALTER TABLE `projects`
+  ADD COLUMN `assignment_id` bigint(20) DEFAULT NULL COMMENT '布置该项目的作业ID' AFTER `last_activity_at`,
+  ADD KEY `idx_assignment_id` (`assignment_id`);
+
+-- 布置作业时通知学生
+ALTER TABLE `notifications`
+  MODIFY COLUMN `type` varchar(30) NOT NULL COMMENT '通知类型：comment,assignment',
+  MODIFY COLUMN `ref_type` varchar(30) DEFAULT NULL COMMENT '关联记录类型：comment,assignment';
@@ -0,0 +1,6 @@
+-- 删除项目里程碑表和项目模板表，移除项目使用的模板
+ALTER TABLE `projects`
+  DROP COLUMN `template_id`;
+
+DROP TABLE IF EXISTS `project_milestones`;
+DROP TABLE IF EXISTS `project_templates`;
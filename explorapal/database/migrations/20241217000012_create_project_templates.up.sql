@@ -0,0 +1,57 @@
+-- 创建项目模板表：按类别整理的引导式学习路径
+CREATE TABLE IF NOT EXISTS `project_templates` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `delete_time` datetime DEFAULT NULL COMMENT '删除时间',
+  `template_id` bigint(20) NOT NULL COMMENT '模板ID',
+  `title` varchar(200) NOT NULL COMMENT '模板标题，也是项目的默认标题',
+  `category` varchar(50) NOT NULL COMMENT '项目类别',
+  `description` text COMMENT '模板介绍',
+  `milestones` text NOT NULL COMMENT '有序里程碑JSON数组：stage,kind,title,description,target',
+  `starter_prompts` text COMMENT '开场引导语JSON数组',
+  `suggested_tags` text COMMENT '推荐标签JSON数组',
+  `sort` int(11) NOT NULL DEFAULT '0' COMMENT '排序，越小越靠前',
+  `status` varchar(20) NOT NULL DEFAULT 'active' COMMENT '状态：active,inactive',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_template_id` (`template_id`),
+  KEY `idx_category` (`category`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='项目模板表';
+
+-- 创建项目里程碑表：使用模板创建项目时复制模板的里程碑，作为项目的任务清单
+CREATE TABLE IF NOT EXISTS `project_milestones` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `milestone_id` bigint(20) NOT NULL COMMENT '里程碑ID',
+  `project_id` bigint(20) NOT NULL COMMENT '项目ID',
+  `seq` int(11) NOT NULL COMMENT '顺序，从1开始',
+  `stage` varchar(20) NOT NULL COMMENT '探索阶段：observe,question,express,achievement',
+  `kind` varchar(20) DEFAULT NULL COMMENT '阶段内的具体类型，如问题类型comparison、表达类型speech、成果类型poster，为空时不限',
+  `title` varchar(200) NOT NULL COMMENT '里程碑标题',
+  `description` text COMMENT '里程碑说明',
+  `target` int(11) NOT NULL DEFAULT '1' COMMENT '需要完成的数量',
+  `completed_time` datetime DEFAULT NULL COMMENT '完成时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_milestone_id` (`milestone_id`),
+  UNIQUE KEY `idx_project_seq` (`project_id`, `seq`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='项目里程碑表';
+
+-- 项目记录使用的模板，自由创建的项目为空
+ALTER TABLE `projects`
+  ADD COLUMN `template_id` bigint(20) DEFAULT NULL COMMENT '创建项目使用的模板ID' AFTER `assignment_id`;
+
+-- 内置模板
+INSERT INTO `project_templates` (`template_id`, `title`, `category`, `description`, `milestones`, `starter_prompts`, `suggested_tags`, `sort`) VALUES
+(1001, '恐龙侦探', 'dinosaur', '像古生物学家一样观察化石和恐龙模型，找出不同恐龙的秘密。',
+ '[{"stage":"observe","title":"观察3只不同的恐龙","description":"拍下恐龙模型、化石或图片，看看它们的牙齿、爪子和体型","target":3},{"stage":"question","kind":"comparison","title":"提一个比较的问题","description":"比如：霸王龙和三角龙谁跑得更快？","target":1},{"stage":"express","kind":"speech","title":"录一段语音讲讲你的发现","target":1},{"stage":"achievement","kind":"poster","title":"做一张恐龙海报","target":1}]',
+ '["你最喜欢哪只恐龙？它吃肉还是吃植物？","看看恐龙的牙齿，你能猜出它吃什么吗？"]',
+ '["恐龙","化石","古生物"]', 1),
+(1002, '造一枚火箭', 'rocket', '观察火箭的结构，想一想它是怎么飞上太空的，再设计一枚自己的火箭。',
+ '[{"stage":"observe","title":"观察2张火箭的图片或模型","description":"找一找火箭的头部、箭体和发动机","target":2},{"stage":"question","kind":"reasoning","title":"问一个为什么的问题","description":"比如：火箭为什么要分成好几节？","target":1},{"stage":"question","kind":"experiment","title":"想一个可以动手试试的实验","target":1},{"stage":"express","title":"写下或说出你的火箭设计","target":1},{"stage":"achievement","kind":"report","title":"完成一份火箭研究报告","target":1}]',
+ '["火箭是怎么飞起来的？","如果你来设计火箭，你会给它起什么名字？"]',
+ '["火箭","太空","工程"]', 2),
+(1003, '海洋分层探险', 'ocean', '从海面到海底，认识不同深度的海洋生物，发现它们适应环境的本领。',
+ '[{"stage":"observe","title":"观察3种生活在不同深度的海洋生物","target":3},{"stage":"question","kind":"comparison","title":"比较浅海和深海的生物有什么不同","target":1},{"stage":"express","kind":"speech","title":"录一段语音介绍你最喜欢的海洋生物","target":1},{"stage":"achievement","kind":"documentary","title":"制作一部海洋纪录片","target":1}]',
+ '["大海最深的地方有多深？那里有光吗？","深海里的鱼为什么会发光？"]',
+ '["海洋","海洋生物","深海"]', 3);
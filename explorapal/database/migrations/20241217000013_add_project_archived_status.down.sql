@@ -0,0 +1,5 @@
+-- 移除归档状态，已归档的项目恢复为进行中
+UPDATE `projects` SET `status` = 'active' WHERE `status` = 'archived';
+
+ALTER TABLE `projects`
+  MODIFY COLUMN `status` varchar(20) NOT NULL DEFAULT 'active' COMMENT '状态：active,completed,paused';
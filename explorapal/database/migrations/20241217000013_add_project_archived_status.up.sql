@@ -0,0 +1,3 @@
+-- 项目状态增加归档
+ALTER TABLE `projects`
+  MODIFY COLUMN `status` varchar(20) NOT NULL DEFAULT 'active' COMMENT '状态：active,paused,completed,archived';
@@ -0,0 +1,13 @@
+-- 删除全文搜索索引
+ALTER TABLE `expressions`
+  DROP KEY `ft_expression_polished`,
+  DROP KEY `ft_expression_raw`;
+
+ALTER TABLE `questions`
+  DROP KEY `ft_question_text`;
+
+ALTER TABLE `observations`
+  DROP KEY `ft_observation_text`;
+
+ALTER TABLE `projects`
+  DROP KEY `ft_project_text`;
@@ -0,0 +1,17 @@
+-- 孩子在自己的项目、观察、问题和表达中搜索
+-- 使用ngram分词支持中文，词长由服务器参数ngram_token_size决定，默认2
+ALTER TABLE `projects`
+  ADD FULLTEXT KEY `ft_project_text` (`title`, `description`) WITH PARSER ngram;
+
+ALTER TABLE `observations`
+  ADD FULLTEXT KEY `ft_observation_text` (`object_name`, `scientific_name`, `description`) WITH PARSER ngram;
+
+ALTER TABLE `questions`
+  ADD FULLTEXT KEY `ft_question_text` (`content`, `ai_answer`, `user_response`) WITH PARSER ngram;
+
+-- 润色内容可能在等待人工审核，单独建索引以便只搜索可以展示的部分；InnoDB每条语句只能创建一个全文索引
+ALTER TABLE `expressions`
+  ADD FULLTEXT KEY `ft_expression_raw` (`raw_content`) WITH PARSER ngram;
+
+ALTER TABLE `expressions`
+  ADD FULLTEXT KEY `ft_expression_polished` (`polished_title`, `polished_summary`, `polished_formatted`) WITH PARSER ngram;
@@ -0,0 +1,2 @@
+-- 删除内容向量表
+DROP TABLE IF EXISTS `embeddings`;
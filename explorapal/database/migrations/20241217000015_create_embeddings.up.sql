@@ -0,0 +1,18 @@
+-- 观察、问题和表达的文本向量，用于相似观察和相关项目推荐
+CREATE TABLE IF NOT EXISTS `embeddings` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `entity_type` varchar(20) NOT NULL COMMENT '记录类型：observation,question,expression',
+  `entity_id` bigint(20) NOT NULL COMMENT '记录ID',
+  `user_id` bigint(20) NOT NULL COMMENT '用户ID',
+  `project_id` bigint(20) NOT NULL COMMENT '项目ID',
+  `model` varchar(50) NOT NULL COMMENT '向量模型，不同模型的向量不能互相比较',
+  `dimensions` int(11) NOT NULL COMMENT '向量维数',
+  `vector` blob NOT NULL COMMENT '归一化的向量，float32小端序',
+  `content_hash` char(64) NOT NULL COMMENT '生成向量的文本SHA-256，文本不变时不重新计算',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_entity_model` (`entity_type`, `entity_id`, `model`),
+  KEY `idx_user_model` (`user_id`, `model`),
+  KEY `idx_project_id` (`project_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='内容向量表';
@@ -0,0 +1,4 @@
+-- 删除概念图相关表
+DROP TABLE IF EXISTS `concept_mentions`;
+DROP TABLE IF EXISTS `concept_edges`;
+DROP TABLE IF EXISTS `concepts`;
@@ -0,0 +1,46 @@
+-- 孩子探索过的科学概念，名称规范化后全局共享
+CREATE TABLE IF NOT EXISTS `concepts` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `concept_id` bigint(20) NOT NULL COMMENT '概念ID',
+  `name` varchar(64) NOT NULL COMMENT '展示名称，使用第一次出现时的写法',
+  `normalized_name` varchar(64) NOT NULL COMMENT '规范化名称：去掉首尾标点和空白，英文转小写',
+  `kind` varchar(20) NOT NULL COMMENT '概念类型：concept科学概念,category类别,species物种学名',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_concept_id` (`concept_id`),
+  UNIQUE KEY `idx_normalized_name` (`normalized_name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='概念表';
+
+-- 每个孩子的概念图的边：项目包含概念(contains)，概念之间相关(related)
+CREATE TABLE IF NOT EXISTS `concept_edges` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `edge_id` bigint(20) NOT NULL COMMENT '边ID',
+  `user_id` bigint(20) NOT NULL COMMENT '用户ID',
+  `relation` varchar(20) NOT NULL COMMENT '关系：contains时source为项目ID，related时source和target为概念ID且source较小',
+  `source_id` bigint(20) NOT NULL COMMENT '起点ID',
+  `target_id` bigint(20) NOT NULL COMMENT '终点概念ID',
+  `weight` int(11) NOT NULL DEFAULT '0' COMMENT '权重：contains为项目中提到的次数，related为同时出现的次数',
+  `last_seen_time` datetime NOT NULL COMMENT '最近一次出现的时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_edge_id` (`edge_id`),
+  UNIQUE KEY `idx_edge` (`user_id`, `relation`, `source_id`, `target_id`),
+  KEY `idx_user_relation` (`user_id`, `relation`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='概念图边表';
+
+-- 概念出现在哪条记录中，保证同一条记录重复写入概念图时不重复计数
+CREATE TABLE IF NOT EXISTS `concept_mentions` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `create_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `user_id` bigint(20) NOT NULL COMMENT '用户ID',
+  `project_id` bigint(20) NOT NULL COMMENT '项目ID',
+  `concept_id` bigint(20) NOT NULL COMMENT '概念ID',
+  `source_type` varchar(20) NOT NULL COMMENT '来源记录类型：observation,expression',
+  `source_id` bigint(20) NOT NULL COMMENT '来源记录ID',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_source_concept` (`source_type`, `source_id`, `concept_id`),
+  KEY `idx_user_concept` (`user_id`, `concept_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='概念出处表';
@@ -0,0 +1,2 @@
+-- 删除观察记录识别结果的人工审核状态
+ALTER TABLE `observations` DROP COLUMN `review_status`;
@@ -0,0 +1,3 @@
+-- 观察记录增加识别结果的人工审核状态
+ALTER TABLE `observations`
+  ADD COLUMN `review_status` varchar(20) NOT NULL DEFAULT 'none' COMMENT '识别结果的人工审核状态：none,pending,approved,rejected' AFTER `interesting_facts`;
@@ -0,0 +1,2 @@
+-- 删除项目已达成的探索阶段
+ALTER TABLE `projects` DROP COLUMN `reached_stages`;
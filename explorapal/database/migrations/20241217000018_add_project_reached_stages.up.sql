@@ -0,0 +1,10 @@
+-- 项目增加已达成的探索阶段，阶段第一次达成的活动只在这里从未达成变为达成时记录一次
+ALTER TABLE `projects`
+  ADD COLUMN `reached_stages` varchar(64) NOT NULL DEFAULT '' COMMENT '已达成的探索阶段，逗号分隔：observe,question,express,achievement' AFTER `progress`;
+
+-- 已有项目按现有记录回填，避免下一次记录时重复写入阶段达成活动
+UPDATE `projects` p SET p.`reached_stages` = concat_ws(',',
+  if(exists(select 1 from `observations` o where o.`project_id` = p.`project_id` and o.`status` = 'recognized' and o.`delete_time` IS NULL), 'observe', NULL),
+  if(exists(select 1 from `questions` q where q.`project_id` = p.`project_id` and (q.`ai_answer` IS NOT NULL or q.`user_response` IS NOT NULL) and q.`delete_time` IS NULL), 'question', NULL),
+  if(exists(select 1 from `expressions` e where e.`project_id` = p.`project_id` and e.`delete_time` IS NULL), 'express', NULL),
+  if(exists(select 1 from `achievements` a where a.`project_id` = p.`project_id` and a.`delete_time` IS NULL), 'achievement', NULL));
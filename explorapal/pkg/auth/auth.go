@@ -0,0 +1,137 @@
+// Package auth 登录令牌的签发与校验
+// 用户身份只从令牌中获取，请求体中的用户ID一律不作为身份依据
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// 用户角色
+const (
+	RoleChild   = "child"
+	RoleParent  = "parent"
+	RoleTeacher = "teacher"
+	// 审核人员和管理员由运营在后台开通，不能自行注册
+	RoleReviewer = "reviewer"
+	RoleAdmin    = "admin"
+)
+
+// 令牌类型：访问令牌用于调用接口，刷新令牌只能用于换取新的令牌
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+// 默认的刷新令牌有效期(秒)
+const defaultRefreshExpire = 30 * 24 * 3600
+
+var (
+	// ErrTokenInvalid 令牌缺失、签名错误、已过期或类型不符
+	ErrTokenInvalid = errors.New("登录已失效，请重新登录")
+	// ErrForbidden 没有访问该资源的权限
+	ErrForbidden = errors.New("没有访问权限")
+)
+
+// Config 令牌配置
+type Config struct {
+	AccessSecret  string
+	AccessExpire  int64 // 访问令牌有效期(秒)
+	RefreshExpire int64 // 刷新令牌有效期(秒)
+}
+
+// Claims 令牌中携带的用户身份
+type Claims struct {
+	UserID    int64  `json:"uid"`
+	Role      string `json:"role"`
+	TokenType string `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+// TokenPair 登录或刷新后签发的令牌
+type TokenPair struct {
+	AccessToken   string
+	AccessExpire  int64 // 过期时间(Unix秒)
+	RefreshToken  string
+	RefreshExpire int64
+}
+
+// Issuer 令牌签发与校验
+type Issuer struct {
+	secret        []byte
+	accessExpire  time.Duration
+	refreshExpire time.Duration
+}
+
+// NewIssuer 创建令牌签发器
+func NewIssuer(c *Config) *Issuer {
+	refreshExpire := c.RefreshExpire
+	if refreshExpire <= 0 {
+		refreshExpire = defaultRefreshExpire
+	}
+	return &Issuer{
+		secret:        []byte(c.AccessSecret),
+		accessExpire:  time.Duration(c.AccessExpire) * time.Second,
+		refreshExpire: time.Duration(refreshExpire) * time.Second,
+	}
+}
+
+// Issue 为用户签发访问令牌和刷新令牌
+func (i *Issuer) Issue(userID int64, role string) (*TokenPair, error) {
+	now := time.Now()
+	access, accessExpire, err := i.sign(userID, role, TokenTypeAccess, now, i.accessExpire)
+	if err != nil {
+		return nil, err
+	}
+	refresh, refreshExpire, err := i.sign(userID, role, TokenTypeRefresh, now, i.refreshExpire)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		AccessToken:   access,
+		AccessExpire:  accessExpire,
+		RefreshToken:  refresh,
+		RefreshExpire: refreshExpire,
+	}, nil
+}
+
+// Parse 校验令牌签名、有效期和类型，返回令牌中的用户身份
+func (i *Issuer) Parse(token, tokenType string) (*Claims, error) {
+	claims := &Claims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("不支持的签名算法: %v", t.Header["alg"])
+		}
+		return i.secret, nil
+	})
+	if err != nil || !parsed.Valid {
+		return nil, ErrTokenInvalid
+	}
+	if claims.TokenType != tokenType || claims.UserID <= 0 {
+		return nil, ErrTokenInvalid
+	}
+	return claims, nil
+}
+
+func (i *Issuer) sign(userID int64, role, tokenType string, now time.Time, expire time.Duration) (string, int64, error) {
+	expiresAt := now.Add(expire)
+	claims := &Claims{
+		UserID:    userID,
+		Role:      role,
+		TokenType: tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.FormatInt(userID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.secret)
+	if err != nil {
+		return "", 0, fmt.Errorf("签发令牌失败: %w", err)
+	}
+	return token, expiresAt.Unix(), nil
+}
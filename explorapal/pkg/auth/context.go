@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"context"
+	"strings"
+)
+
+// Identity 当前请求的用户身份
+type Identity struct {
+	UserID int64
+	Role   string
+	Token  string // 原始访问令牌，调用下游RPC时透传
+}
+
+type identityKey struct{}
+
+// WithIdentity 把用户身份放入上下文
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext 从上下文获取用户身份
+func FromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok && identity != nil
+}
+
+// UserID 返回上下文中的用户ID，未登录时返回0
+func UserID(ctx context.Context) int64 {
+	if identity, ok := FromContext(ctx); ok {
+		return identity.UserID
+	}
+	return 0
+}
+
+// BearerToken 从Authorization头中取出令牌
+func BearerToken(header string) string {
+	const prefix = "bearer "
+	if len(header) > len(prefix) && strings.EqualFold(header[:len(prefix)], prefix) {
+		return strings.TrimSpace(header[len(prefix):])
+	}
+	return ""
+}
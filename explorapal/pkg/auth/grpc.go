@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AuthorizationHeader 传递访问令牌的metadata键
+const AuthorizationHeader = "authorization"
+
+// UnaryServerInterceptor 校验调用方透传的访问令牌，把用户身份放入上下文
+// publicMethods 为无需登录即可调用的方法全名
+func UnaryServerInterceptor(issuer *Issuer, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := make(map[string]bool, len(publicMethods))
+	for _, m := range publicMethods {
+		public[m] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		token := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(AuthorizationHeader); len(values) > 0 {
+				token = BearerToken(values[0])
+			}
+		}
+
+		if token == "" {
+			if public[info.FullMethod] {
+				return handler(ctx, req)
+			}
+			return nil, status.Error(codes.Unauthenticated, ErrTokenInvalid.Error())
+		}
+
+		claims, err := issuer.Parse(token, TokenTypeAccess)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		ctx = WithIdentity(ctx, &Identity{UserID: claims.UserID, Role: claims.Role, Token: token})
+		return handler(ctx, req)
+	}
+}
+
+// UnaryClientInterceptor 调用下游RPC时透传当前用户的访问令牌
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if identity, ok := FromContext(ctx); ok && identity.Token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, AuthorizationHeader, "Bearer "+identity.Token)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 密码哈希参数：PBKDF2-HMAC-SHA256
+const (
+	passwordScheme     = "pbkdf2-sha256"
+	passwordIterations = 120000
+	passwordSaltLen    = 16
+	passwordKeyLen     = 32
+)
+
+// HashPassword 生成密码哈希，格式为 pbkdf2-sha256$迭代次数$盐$哈希
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, passwordSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("生成密码盐失败: %w", err)
+	}
+	key := pbkdf2([]byte(password), salt, passwordIterations, passwordKeyLen)
+	return strings.Join([]string{
+		passwordScheme,
+		strconv.Itoa(passwordIterations),
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	}, "$"), nil
+}
+
+// VerifyPassword 校验密码与哈希是否匹配
+func VerifyPassword(password, hash string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != passwordScheme {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations <= 0 {
+		return false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	expected, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil || len(expected) == 0 {
+		return false
+	}
+	key := pbkdf2([]byte(password), salt, iterations, len(expected))
+	return subtle.ConstantTimeCompare(key, expected) == 1
+}
+
+// pbkdf2 RFC 8018 PBKDF2，伪随机函数为HMAC-SHA256
+func pbkdf2(password, salt []byte, iterations, keyLen int) []byte {
+	prf := hmac.New(sha256.New, password)
+	hashLen := prf.Size()
+	blocks := (keyLen + hashLen - 1) / hashLen
+
+	key := make([]byte, 0, blocks*hashLen)
+	var counter [4]byte
+	u := make([]byte, hashLen)
+	t := make([]byte, hashLen)
+	for block := 1; block <= blocks; block++ {
+		binary.BigEndian.PutUint32(counter[:], uint32(block))
+		prf.Reset()
+		prf.Write(salt)
+		prf.Write(counter[:])
+		u = prf.Sum(u[:0])
+		copy(t, u)
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+	return key[:keyLen]
+}
@@ -0,0 +1,273 @@
+// Package conceptgraph 孩子跨项目探索过的概念图
+// 从观察的类别、学名和表达润色结果中的科学概念、关联知识提取概念，名称规范化后作为节点；
+// 项目到概念连contains边，权重为项目中提到该概念的记录数；同一条记录中一起出现的概念之间连related边。
+// 每条记录只计数一次，同一条记录重复写入（如审核重试）不会重复累加
+package conceptgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"explorapal/app/model/hps"
+	"explorapal/pkg/idgen"
+)
+
+// 概念的来源记录类型
+const (
+	SourceObservation = "observation"
+	SourceExpression  = "expression"
+)
+
+const (
+	// 概念名称的最大长度（字数），更长的通常是一句话而不是概念
+	maxNameLength = 20
+	// 每条记录最多提取的概念数，限制related边的数量
+	maxTermsPerRecord = 10
+)
+
+// Models 概念图用到的数据库模型
+type Models struct {
+	Concepts hps.ConceptsModel
+	Edges    hps.ConceptEdgesModel
+	Mentions hps.ConceptMentionsModel
+	Projects hps.ProjectsModel
+}
+
+// Term 记录中提到的概念
+type Term struct {
+	Name string
+	Kind string // 取值见hps.ConceptKind*
+}
+
+// Record 需要写入概念图的记录
+type Record struct {
+	Type      string // 取值见Source*
+	ID        int64
+	UserID    int64
+	ProjectID int64
+	Terms     []Term
+}
+
+// Graph 概念图
+type Graph struct {
+	models *Models
+}
+
+// NewGraph 创建概念图
+func NewGraph(models *Models) *Graph {
+	return &Graph{
+		models: models,
+	}
+}
+
+// Add 把记录中的概念写入概念图，记录中已经计数过的概念不再累加
+func (g *Graph) Add(ctx context.Context, r *Record) error {
+	terms := normalizeTerms(r.Terms)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	conceptIDs := make([]int64, 0, len(terms))
+	added := make(map[int64]bool, len(terms))
+	for _, t := range terms {
+		concept, err := g.findOrCreate(ctx, t)
+		if err != nil {
+			return err
+		}
+		conceptIDs = append(conceptIDs, concept.ConceptId)
+
+		isNew, err := g.addMention(ctx, r, concept.ConceptId)
+		if err != nil {
+			return err
+		}
+		if !isNew {
+			continue
+		}
+		added[concept.ConceptId] = true
+		if err := g.addEdge(ctx, r.UserID, hps.ConceptRelationContains, r.ProjectID, concept.ConceptId, now); err != nil {
+			return err
+		}
+	}
+
+	// 至少有一端是本次新增的概念时才累加related边
+	for i := 0; i < len(conceptIDs); i++ {
+		for j := i + 1; j < len(conceptIDs); j++ {
+			a, b := conceptIDs[i], conceptIDs[j]
+			if !added[a] && !added[b] {
+				continue
+			}
+			if err := g.addEdge(ctx, r.UserID, hps.ConceptRelationRelated, min(a, b), max(a, b), now); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// findOrCreate 按规范化名称查找概念，不存在时创建
+func (g *Graph) findOrCreate(ctx context.Context, t Term) (*hps.Concepts, error) {
+	normalized := normalizeName(t.Name)
+	concept, err := g.models.Concepts.FindOneByNormalizedName(ctx, normalized)
+	if err == nil {
+		return concept, nil
+	}
+	if err != hps.ErrNotFound {
+		return nil, fmt.Errorf("查询概念失败: %w", err)
+	}
+
+	concept = &hps.Concepts{
+		ConceptId:      idgen.Next(),
+		Name:           t.Name,
+		NormalizedName: normalized,
+		Kind:           t.Kind,
+	}
+	if _, err := g.models.Concepts.Insert(ctx, concept); err != nil {
+		// 并发写入同名概念时唯一索引冲突，改用已经写入的概念
+		if existing, findErr := g.models.Concepts.FindOneByNormalizedName(ctx, normalized); findErr == nil {
+			return existing, nil
+		}
+		return nil, fmt.Errorf("保存概念失败: %w", err)
+	}
+	return concept, nil
+}
+
+// addMention 记录概念出现在该记录中，已经记录过时返回false
+func (g *Graph) addMention(ctx context.Context, r *Record, conceptID int64) (bool, error) {
+	_, err := g.models.Mentions.FindOneBySourceTypeSourceIdConceptId(ctx, r.Type, r.ID, conceptID)
+	if err == nil {
+		return false, nil
+	}
+	if err != hps.ErrNotFound {
+		return false, fmt.Errorf("查询概念出处失败: %w", err)
+	}
+
+	_, err = g.models.Mentions.Insert(ctx, &hps.ConceptMentions{
+		UserId:     r.UserID,
+		ProjectId:  r.ProjectID,
+		ConceptId:  conceptID,
+		SourceType: r.Type,
+		SourceId:   r.ID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("保存概念出处失败: %w", err)
+	}
+	return true, nil
+}
+
+// addEdge 边的权重加一，边不存在时创建
+func (g *Graph) addEdge(ctx context.Context, userID int64, relation string, sourceID, targetID int64, seen time.Time) error {
+	edge, err := g.models.Edges.FindOneByUserIdRelationSourceIdTargetId(ctx, userID, relation, sourceID, targetID)
+	if err != nil && err != hps.ErrNotFound {
+		return fmt.Errorf("查询概念图失败: %w", err)
+	}
+
+	if edge != nil {
+		edge.Weight++
+		edge.LastSeenTime = seen
+		if err := g.models.Edges.Update(ctx, edge); err != nil {
+			return fmt.Errorf("更新概念图失败: %w", err)
+		}
+		return nil
+	}
+	_, err = g.models.Edges.Insert(ctx, &hps.ConceptEdges{
+		EdgeId:       idgen.Next(),
+		UserId:       userID,
+		Relation:     relation,
+		SourceId:     sourceID,
+		TargetId:     targetID,
+		Weight:       1,
+		LastSeenTime: seen,
+	})
+	if err != nil {
+		return fmt.Errorf("保存概念图失败: %w", err)
+	}
+	return nil
+}
+
+// ObservationRecord 观察记录按识别出的类别和学名提取概念
+func ObservationRecord(o *hps.Observations) *Record {
+	return &Record{
+		Type:      SourceObservation,
+		ID:        o.ObservationId,
+		UserID:    o.UserId,
+		ProjectID: o.ProjectId,
+		Terms: []Term{
+			{Name: o.Category.String, Kind: hps.ConceptKindCategory},
+			{Name: o.ScientificName.String, Kind: hps.ConceptKindSpecies},
+		},
+	}
+}
+
+// ExpressionRecord 表达记录按润色结果中的科学概念和关联知识提取概念
+// 等待审核或被驳回的润色结果不提取，审核通过后再写入
+func ExpressionRecord(e *hps.Expressions) *Record {
+	r := &Record{
+		Type:      SourceExpression,
+		ID:        e.ExpressionId,
+		UserID:    e.UserId,
+		ProjectID: e.ProjectId,
+	}
+	if e.ReviewStatus == hps.ReviewStatusPending || e.ReviewStatus == hps.ReviewStatusRejected {
+		return r
+	}
+	for _, name := range parseList(e.PolishedConcepts.String) {
+		r.Terms = append(r.Terms, Term{Name: name, Kind: hps.ConceptKindConcept})
+	}
+	for _, name := range parseList(e.PolishedConnections.String) {
+		r.Terms = append(r.Terms, Term{Name: name, Kind: hps.ConceptKindConcept})
+	}
+	return r
+}
+
+// parseList 解析JSON字符串数组，格式不正确时按没有内容处理
+func parseList(data string) []string {
+	if data == "" {
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal([]byte(data), &list); err != nil {
+		return nil
+	}
+	return list
+}
+
+// normalizeTerms 清理概念名称并按规范化名称去重，过长的名称不作为概念
+func normalizeTerms(terms []Term) []Term {
+	seen := make(map[string]bool, len(terms))
+	result := make([]Term, 0, len(terms))
+	for _, t := range terms {
+		t.Name = cleanName(t.Name)
+		if t.Name == "" || utf8.RuneCountInString(t.Name) > maxNameLength {
+			continue
+		}
+		normalized := normalizeName(t.Name)
+		if seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		result = append(result, t)
+		if len(result) >= maxTermsPerRecord {
+			break
+		}
+	}
+	return result
+}
+
+// cleanName 去掉首尾的标点和空白，中间的连续空白合并为一个空格
+func cleanName(name string) string {
+	name = strings.TrimFunc(name, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+	})
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// normalizeName 概念的规范化名称，用于判断是否为同一个概念
+func normalizeName(name string) string {
+	return strings.ToLower(cleanName(name))
+}
@@ -0,0 +1,250 @@
+package conceptgraph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"explorapal/app/model/hps"
+)
+
+// 导出的概念图中最多包含的概念数，按提到的次数保留前面的概念
+const maxGraphConcepts = 200
+
+// 导出的概念图中的节点类型
+const (
+	NodeProject = "project"
+	NodeConcept = "concept"
+)
+
+// ConceptSummary 孩子探索过的概念
+type ConceptSummary struct {
+	Concept    *hps.Concepts
+	Projects   []*hps.Projects // 提到该概念的项目，按最近活动时间排列
+	Mentions   int64           // 提到该概念的记录数
+	LastSeenAt time.Time
+}
+
+// Export 导出的概念图，可以直接序列化为JSON用于可视化
+type Export struct {
+	Nodes []*Node `json:"nodes"`
+	Edges []*Edge `json:"edges"`
+}
+
+// Node 概念图节点，ID为"project:项目ID"或"concept:概念ID"
+type Node struct {
+	ID     string `json:"id"`
+	Label  string `json:"label"`
+	Type   string `json:"type"`
+	Kind   string `json:"kind,omitempty"`
+	Weight int64  `json:"weight"`
+}
+
+// Edge 概念图的边，related边没有方向
+type Edge struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	Relation string `json:"relation"`
+	Weight   int64  `json:"weight"`
+}
+
+// Concepts 查询孩子探索过的概念，按提到的次数从多到少排列；kind为空时不限概念类型
+// 已删除项目中的概念不计入
+func (g *Graph) Concepts(ctx context.Context, userID int64, kind string) ([]*ConceptSummary, error) {
+	summaries, err := g.summaries(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if kind == "" {
+		return summaries, nil
+	}
+	result := make([]*ConceptSummary, 0, len(summaries))
+	for _, s := range summaries {
+		if s.Concept.Kind == kind {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+// SharedConcepts 查询在两个及以上项目中都出现过的概念；projectIDs为空时在孩子的全部项目中查找
+func (g *Graph) SharedConcepts(ctx context.Context, userID int64, projectIDs []int64) ([]*ConceptSummary, error) {
+	var only map[int64]bool
+	if len(projectIDs) > 0 {
+		only = make(map[int64]bool, len(projectIDs))
+		for _, id := range projectIDs {
+			only[id] = true
+		}
+	}
+	summaries, err := g.summaries(ctx, userID, only)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*ConceptSummary, 0, len(summaries))
+	for _, s := range summaries {
+		if len(s.Projects) >= 2 {
+			result = append(result, s)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return len(result[i].Projects) > len(result[j].Projects)
+	})
+	return result, nil
+}
+
+// Export 导出孩子的概念图；projectID不为0时只导出该项目和其中的概念
+func (g *Graph) Export(ctx context.Context, userID, projectID int64) (*Export, error) {
+	var only map[int64]bool
+	if projectID != 0 {
+		only = map[int64]bool{projectID: true}
+	}
+	summaries, err := g.summaries(ctx, userID, only)
+	if err != nil {
+		return nil, err
+	}
+	if len(summaries) > maxGraphConcepts {
+		summaries = summaries[:maxGraphConcepts]
+	}
+
+	export := &Export{
+		Nodes: make([]*Node, 0, len(summaries)),
+		Edges: []*Edge{},
+	}
+	included := make(map[int64]bool, len(summaries))
+	projects := make(map[int64]bool)
+	for _, s := range summaries {
+		included[s.Concept.ConceptId] = true
+		export.Nodes = append(export.Nodes, &Node{
+			ID:     conceptNodeID(s.Concept.ConceptId),
+			Label:  s.Concept.Name,
+			Type:   NodeConcept,
+			Kind:   s.Concept.Kind,
+			Weight: s.Mentions,
+		})
+		for _, p := range s.Projects {
+			if !projects[p.ProjectId] {
+				projects[p.ProjectId] = true
+				export.Nodes = append(export.Nodes, &Node{
+					ID:    projectNodeID(p.ProjectId),
+					Label: p.Title,
+					Type:  NodeProject,
+				})
+			}
+		}
+	}
+
+	contains, err := g.findEdges(ctx, userID, hps.ConceptRelationContains)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range contains {
+		if projects[e.SourceId] && included[e.TargetId] {
+			export.Edges = append(export.Edges, &Edge{
+				Source:   projectNodeID(e.SourceId),
+				Target:   conceptNodeID(e.TargetId),
+				Relation: e.Relation,
+				Weight:   e.Weight,
+			})
+		}
+	}
+	related, err := g.findEdges(ctx, userID, hps.ConceptRelationRelated)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range related {
+		if included[e.SourceId] && included[e.TargetId] {
+			export.Edges = append(export.Edges, &Edge{
+				Source:   conceptNodeID(e.SourceId),
+				Target:   conceptNodeID(e.TargetId),
+				Relation: e.Relation,
+				Weight:   e.Weight,
+			})
+		}
+	}
+	return export, nil
+}
+
+// summaries 按contains边汇总每个概念出现的项目和次数，only不为空时只统计其中的项目
+// 结果按提到的次数从多到少排列，次数相同时最近出现的在前
+func (g *Graph) summaries(ctx context.Context, userID int64, only map[int64]bool) ([]*ConceptSummary, error) {
+	edges, err := g.findEdges(ctx, userID, hps.ConceptRelationContains)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make(map[int64]*hps.Projects)
+	byConcept := make(map[int64]*ConceptSummary)
+	var conceptIDs []int64
+	for _, e := range edges {
+		if only != nil && !only[e.SourceId] {
+			continue
+		}
+		project, ok := projects[e.SourceId]
+		if !ok {
+			project, err = g.models.Projects.FindOneOwned(ctx, e.SourceId, userID)
+			if err != nil && err != hps.ErrNotFound {
+				return nil, fmt.Errorf("查询项目失败: %w", err)
+			}
+			projects[e.SourceId] = project
+		}
+		// 项目已删除
+		if project == nil {
+			continue
+		}
+
+		s, ok := byConcept[e.TargetId]
+		if !ok {
+			s = &ConceptSummary{}
+			byConcept[e.TargetId] = s
+			conceptIDs = append(conceptIDs, e.TargetId)
+		}
+		s.Projects = append(s.Projects, project)
+		s.Mentions += e.Weight
+		if e.LastSeenTime.After(s.LastSeenAt) {
+			s.LastSeenAt = e.LastSeenTime
+		}
+	}
+	if len(conceptIDs) == 0 {
+		return []*ConceptSummary{}, nil
+	}
+
+	concepts, err := g.models.Concepts.FindByConceptIds(ctx, conceptIDs)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, fmt.Errorf("查询概念失败: %w", err)
+	}
+	result := make([]*ConceptSummary, 0, len(concepts))
+	for _, c := range concepts {
+		s := byConcept[c.ConceptId]
+		s.Concept = c
+		sort.SliceStable(s.Projects, func(i, j int) bool {
+			return s.Projects[i].LastActivityAt.Time.After(s.Projects[j].LastActivityAt.Time)
+		})
+		result = append(result, s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Mentions != result[j].Mentions {
+			return result[i].Mentions > result[j].Mentions
+		}
+		return result[i].LastSeenAt.After(result[j].LastSeenAt)
+	})
+	return result, nil
+}
+
+func (g *Graph) findEdges(ctx context.Context, userID int64, relation string) ([]*hps.ConceptEdges, error) {
+	edges, err := g.models.Edges.FindByUser(ctx, userID, relation)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, fmt.Errorf("查询概念图失败: %w", err)
+	}
+	return edges, nil
+}
+
+func projectNodeID(id int64) string {
+	return NodeProject + ":" + strconv.FormatInt(id, 10)
+}
+
+func conceptNodeID(id int64) string {
+	return NodeConcept + ":" + strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,92 @@
+// Package consent 家长同意的功能开关与校验
+// 孩子账号使用录音、上传照片和分享前，必须有家长对当前条款版本的同意并开启了对应功能
+package consent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+)
+
+// 需要家长同意的功能
+const (
+	FeatureVoiceRecording = "voice_recording"
+	FeaturePhotoUpload    = "photo_upload"
+	FeatureSharing        = "sharing"
+)
+
+// 缺少家长同意时给孩子的提示
+var (
+	ErrVoiceRecordingRequired = errors.New("录音需要爸爸妈妈先同意哦，请让爸爸妈妈在家长端开启吧！")
+	ErrPhotoUploadRequired    = errors.New("拍照上传需要爸爸妈妈先同意哦，请让爸爸妈妈在家长端开启吧！")
+	ErrSharingRequired        = errors.New("分享需要爸爸妈妈先同意哦，请让爸爸妈妈在家长端开启吧！")
+)
+
+var requiredErrors = map[string]error{
+	FeatureVoiceRecording: ErrVoiceRecordingRequired,
+	FeaturePhotoUpload:    ErrPhotoUploadRequired,
+	FeatureSharing:        ErrSharingRequired,
+}
+
+// Enabled 同意记录中是否开启了该功能
+func Enabled(record *hps.ParentalConsents, feature string) bool {
+	if record == nil {
+		return false
+	}
+	switch feature {
+	case FeatureVoiceRecording:
+		return record.VoiceRecording == 1
+	case FeaturePhotoUpload:
+		return record.PhotoUpload == 1
+	case FeatureSharing:
+		return record.Sharing == 1
+	default:
+		return false
+	}
+}
+
+// Checker 按当前条款版本校验孩子的家长同意
+type Checker struct {
+	model        hps.ParentalConsentsModel
+	termsVersion string
+}
+
+// NewChecker 创建同意校验器；termsVersion为空时接受任意版本的同意
+func NewChecker(model hps.ParentalConsentsModel, termsVersion string) *Checker {
+	return &Checker{
+		model:        model,
+		termsVersion: termsVersion,
+	}
+}
+
+// Require 孩子账号使用功能前检查家长同意，家长和老师账号不受限制
+func (c *Checker) Require(ctx context.Context, feature string) error {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.ErrTokenInvalid
+	}
+	if identity.Role != auth.RoleChild {
+		return nil
+	}
+
+	record, err := c.model.FindLatest(ctx, identity.UserID)
+	if err != nil && err != hps.ErrNotFound {
+		return fmt.Errorf("查询家长同意失败: %w", err)
+	}
+	if err == nil && c.IsCurrent(record.TermsVersion) && Enabled(record, feature) {
+		return nil
+	}
+
+	if e, ok := requiredErrors[feature]; ok {
+		return e
+	}
+	return auth.ErrForbidden
+}
+
+// IsCurrent 是否为当前条款版本；条款更新后需要家长重新同意
+func (c *Checker) IsCurrent(termsVersion string) bool {
+	return c.termsVersion == "" || termsVersion == c.termsVersion
+}
@@ -0,0 +1,307 @@
+// Package dashboard 家长端的孩子探索汇总
+// 只有已关联该孩子的家长可以查看，统计来自项目、观察、问题、表达、成果和项目活动记录
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"explorapal/app/model/hps"
+	"explorapal/pkg/auth"
+	"explorapal/third/openai"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/mr"
+)
+
+const (
+	// DefaultDays 未指定时间段时统计最近的天数
+	DefaultDays = 7
+	// MaxDays 单次最多统计的天数
+	MaxDays = 366
+
+	// 生成亮点的条数和参考的活动记录条数
+	highlightCount      = 3
+	highlightActivities = 50
+
+	dateLayout = "2006-01-02"
+)
+
+// 一天中的时段
+const (
+	TimeOfDayMorning   = "morning"   // 6-12点
+	TimeOfDayAfternoon = "afternoon" // 12-18点
+	TimeOfDayEvening   = "evening"   // 18-22点
+	TimeOfDayNight     = "night"     // 22-6点
+)
+
+var (
+	ErrNotParent      = errors.New("只有家长账号可以查看孩子的探索汇总")
+	ErrChildNotLinked = errors.New("没有关联这个孩子")
+	ErrInvalidPeriod  = errors.New("统计时间段不正确")
+)
+
+// Models 汇总用到的数据库模型
+type Models struct {
+	Users             hps.UsersModel
+	GuardianLinks     hps.GuardianLinksModel
+	Projects          hps.ProjectsModel
+	Observations      hps.ObservationsModel
+	Questions         hps.QuestionsModel
+	Expressions       hps.ExpressionsModel
+	Achievements      hps.AchievementsModel
+	ProjectActivities hps.ProjectActivitiesModel
+}
+
+// Period 统计时间段，包含From不包含To
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// Count 分组数量
+type Count struct {
+	Name  string `json:"name"`
+	Total int64  `json:"total"`
+}
+
+// TimeOfDay 活动的时间分布
+type TimeOfDay struct {
+	Hours     []int64 `json:"hours"` // 每小时的活动次数，下标为小时
+	Morning   int64   `json:"morning"`
+	Afternoon int64   `json:"afternoon"`
+	Evening   int64   `json:"evening"`
+	Night     int64   `json:"night"`
+	Peak      string  `json:"peak"` // 活动最多的时段，没有活动时为空
+}
+
+// Summary 孩子在时间段内的探索汇总
+type Summary struct {
+	ChildID           int64     `json:"-"`
+	Nickname          string    `json:"-"`
+	Period            Period    `json:"-"`
+	ProjectsStarted   int64     `json:"projects_started"`
+	ProjectsCompleted int64     `json:"projects_completed"`
+	Observations      []Count   `json:"observations"` // 按类别统计的观察记录
+	ObservationTotal  int64     `json:"observation_total"`
+	QuestionsAnswered int64     `json:"questions_answered"`
+	Expressions       []Count   `json:"expressions"` // 按类型统计的表达记录
+	ExpressionTotal   int64     `json:"expression_total"`
+	Achievements      []Count   `json:"achievements"` // 按类型统计的成果
+	AchievementTotal  int64     `json:"achievement_total"`
+	TimeOfDay         TimeOfDay `json:"time_of_day"`
+	Highlights        []string  `json:"-"`
+}
+
+// Builder 家长端汇总生成器
+type Builder struct {
+	models *Models
+	ai     *openai.Client
+}
+
+// NewBuilder 创建汇总生成器；ai为空时不生成亮点
+func NewBuilder(models *Models, ai *openai.Client) *Builder {
+	return &Builder{
+		models: models,
+		ai:     ai,
+	}
+}
+
+// ParsePeriod 解析YYYY-MM-DD格式的起止日期，结束日期包含当天；都为空时为截至今天的最近7天
+func ParsePeriod(startDate, endDate string, now time.Time) (Period, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	end := today
+	if endDate != "" {
+		t, err := time.ParseInLocation(dateLayout, endDate, now.Location())
+		if err != nil {
+			return Period{}, ErrInvalidPeriod
+		}
+		end = t
+	}
+	start := end.AddDate(0, 0, -(DefaultDays - 1))
+	if startDate != "" {
+		t, err := time.ParseInLocation(dateLayout, startDate, now.Location())
+		if err != nil {
+			return Period{}, ErrInvalidPeriod
+		}
+		start = t
+	}
+
+	period := Period{From: start, To: end.AddDate(0, 0, 1)}
+	if !period.From.Before(period.To) || period.To.Sub(period.From) > MaxDays*24*time.Hour {
+		return Period{}, ErrInvalidPeriod
+	}
+	return period, nil
+}
+
+// Summarize 汇总孩子在时间段内的探索；调用方必须是已关联该孩子的家长
+func (b *Builder) Summarize(ctx context.Context, childID int64, period Period) (*Summary, error) {
+	child, err := b.authorize(ctx, childID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{
+		ChildID:  childID,
+		Nickname: child.Nickname.String,
+		Period:   period,
+	}
+	from, to := period.From, period.To
+
+	err = mr.Finish(func() (err error) {
+		summary.ProjectsStarted, err = b.models.Projects.CountCreated(ctx, childID, from, to)
+		return err
+	}, func() (err error) {
+		summary.ProjectsCompleted, err = b.models.Projects.CountCompleted(ctx, childID, from, to)
+		return err
+	}, func() error {
+		groups, err := b.models.Observations.CountByCategory(ctx, childID, from, to)
+		summary.Observations, summary.ObservationTotal = toCounts(groups)
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		summary.QuestionsAnswered, err = b.models.Questions.CountAnswered(ctx, childID, from, to)
+		return err
+	}, func() error {
+		groups, err := b.models.Expressions.CountByType(ctx, childID, from, to)
+		summary.Expressions, summary.ExpressionTotal = toCounts(groups)
+		return ignoreNotFound(err)
+	}, func() error {
+		groups, err := b.models.Achievements.CountByType(ctx, childID, from, to)
+		summary.Achievements, summary.AchievementTotal = toCounts(groups)
+		return ignoreNotFound(err)
+	}, func() error {
+		hours, err := b.models.ProjectActivities.CountByHour(ctx, childID, from, to)
+		summary.TimeOfDay = toTimeOfDay(hours)
+		return ignoreNotFound(err)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary.Highlights = b.highlights(ctx, summary)
+	return summary, nil
+}
+
+// authorize 校验调用方是已关联该孩子的家长，返回孩子的账号
+func (b *Builder) authorize(ctx context.Context, childID int64) (*hps.Users, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, auth.ErrTokenInvalid
+	}
+	if identity.Role != auth.RoleParent {
+		return nil, ErrNotParent
+	}
+
+	link, err := b.models.GuardianLinks.FindOneByParentIdChildId(ctx, identity.UserID, childID)
+	if err == hps.ErrNotFound || (err == nil && link.DeleteTime.Valid) {
+		return nil, ErrChildNotLinked
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := b.models.Users.FindOneByUserId(ctx, childID)
+	if err == hps.ErrNotFound || (err == nil && child.DeleteTime.Valid) {
+		return nil, ErrChildNotLinked
+	}
+	if err != nil {
+		return nil, err
+	}
+	return child, nil
+}
+
+// highlights 请AI根据统计和活动记录生成亮点；没有活动或生成失败时返回空，不影响汇总结果
+func (b *Builder) highlights(ctx context.Context, summary *Summary) []string {
+	if b.ai == nil {
+		return nil
+	}
+	logger := logx.WithContext(ctx)
+
+	activities, err := b.models.ProjectActivities.FindByUserRange(ctx, summary.ChildID, summary.Period.From, summary.Period.To, highlightActivities)
+	if err != nil && err != hps.ErrNotFound {
+		logger.Errorf("查询孩子活动记录失败: %v", err)
+		return nil
+	}
+	if len(activities) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(activities))
+	for _, a := range activities {
+		descriptions = append(descriptions, a.CreateTime.Format("01-02 15:04")+" "+a.Description)
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"start_date": summary.Period.From.Format(dateLayout),
+		"end_date":   summary.Period.To.AddDate(0, 0, -1).Format(dateLayout),
+		"statistics": summary,
+		"activities": descriptions,
+	})
+	if err != nil {
+		logger.Errorf("序列化孩子学习数据失败: %v", err)
+		return nil
+	}
+
+	highlights, err := b.ai.GenerateHighlights(ctx, string(data), highlightCount)
+	if err != nil {
+		logger.Errorf("生成学习亮点失败: %v", err)
+		return nil
+	}
+	return highlights
+}
+
+func toCounts(groups []*hps.GroupCount) ([]Count, int64) {
+	counts := make([]Count, 0, len(groups))
+	var total int64
+	for _, g := range groups {
+		counts = append(counts, Count{Name: g.Name, Total: g.Total})
+		total += g.Total
+	}
+	return counts, total
+}
+
+func toTimeOfDay(hours []*hps.HourCount) TimeOfDay {
+	result := TimeOfDay{Hours: make([]int64, 24)}
+	for _, h := range hours {
+		if h.Hour < 0 || h.Hour > 23 {
+			continue
+		}
+		result.Hours[h.Hour] = h.Total
+		switch {
+		case h.Hour >= 6 && h.Hour < 12:
+			result.Morning += h.Total
+		case h.Hour >= 12 && h.Hour < 18:
+			result.Afternoon += h.Total
+		case h.Hour >= 18 && h.Hour < 22:
+			result.Evening += h.Total
+		default:
+			result.Night += h.Total
+		}
+	}
+
+	var peak int64
+	for _, p := range []struct {
+		name  string
+		total int64
+	}{
+		{TimeOfDayMorning, result.Morning},
+		{TimeOfDayAfternoon, result.Afternoon},
+		{TimeOfDayEvening, result.Evening},
+		{TimeOfDayNight, result.Night},
+	} {
+		if p.total > peak {
+			peak = p.total
+			result.Peak = p.name
+		}
+	}
+	return result
+}
+
+func ignoreNotFound(err error) error {
+	if err == hps.ErrNotFound {
+		return nil
+	}
+	return err
+}
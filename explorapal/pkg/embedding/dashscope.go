@@ -0,0 +1,42 @@
+package embedding
+
+import (
+	"context"
+	"fmt"
+
+	"explorapal/third/openai"
+)
+
+// DashScopeProvider 使用DashScope文本向量模型，支持中英文的语义相近
+type DashScopeProvider struct {
+	client     *openai.Client
+	dimensions int
+}
+
+// NewDashScopeProvider 创建DashScope向量提供方，dimensions不大于0时使用256维
+func NewDashScopeProvider(client *openai.Client, dimensions int) *DashScopeProvider {
+	if dimensions <= 0 {
+		dimensions = defaultDimensions
+	}
+	return &DashScopeProvider{
+		client:     client,
+		dimensions: dimensions,
+	}
+}
+
+// Model 向量模型名称，包含维数
+func (p *DashScopeProvider) Model() string {
+	return fmt.Sprintf("%s-%d", openai.ModelTextEmbedding, p.dimensions)
+}
+
+// Embed 计算文本向量
+func (p *DashScopeProvider) Embed(ctx context.Context, text string) ([]float32, error) {
+	vector, err := p.client.CreateEmbedding(ctx, text, p.dimensions)
+	if err != nil {
+		return nil, err
+	}
+	if len(vector) != p.dimensions {
+		return nil, ErrDimensionMismatch
+	}
+	return vector, nil
+}
@@ -0,0 +1,393 @@
+// Package embedding 内容向量与相似推荐
+// 观察、问题和表达保存后通过Provider计算文本向量，存入embeddings表；推荐时在Go中对孩子自己的向量
+// 做余弦相似度检索。一个孩子的记录数量不大，使用暴力检索，不需要额外的向量索引服务
+package embedding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"explorapal/app/model/hps"
+	"explorapal/third/openai"
+)
+
+// 向量提供方
+const (
+	ProviderLocal     = "local"
+	ProviderDashScope = "dashscope"
+)
+
+const (
+	// 推荐结果的最低相似度
+	minSimilarity = 0.2
+	// 候选结果多取一些，过滤已删除的记录后仍能凑够数量
+	candidateFactor = 2
+)
+
+var ErrDimensionMismatch = errors.New("向量维数与模型不一致")
+
+// Config 向量配置
+type Config struct {
+	Provider   string `json:",default=local,options=local|dashscope"` // local为本地确定性向量，dashscope使用DashScope文本向量模型
+	Dimensions int    `json:",default=256"`                           // 向量维数
+}
+
+// Provider 文本向量提供方
+type Provider interface {
+	// Model 向量模型名称，不同模型的向量不能互相比较
+	Model() string
+	// Embed 计算文本向量
+	Embed(ctx context.Context, text string) ([]float32, error)
+}
+
+// NewProvider 按配置创建向量提供方
+func NewProvider(c Config, client *openai.Client) Provider {
+	if c.Provider == ProviderDashScope {
+		return NewDashScopeProvider(client, c.Dimensions)
+	}
+	return NewLocalEmbedder(c.Dimensions)
+}
+
+// Models 向量索引用到的数据库模型
+type Models struct {
+	Embeddings   hps.EmbeddingsModel
+	Projects     hps.ProjectsModel
+	Observations hps.ObservationsModel
+}
+
+// Document 需要计算向量的记录
+type Document struct {
+	Type      string // 记录类型，取值见hps.EmbeddingEntity*
+	ID        int64
+	UserID    int64
+	ProjectID int64
+	Text      string
+}
+
+// SimilarObservation 相似的观察记录
+type SimilarObservation struct {
+	Observation  *hps.Observations
+	ProjectTitle string
+	Score        float64
+}
+
+// RelatedProject 相关的项目
+type RelatedProject struct {
+	Project *hps.Projects
+	Score   float64
+}
+
+// Index 内容向量索引
+type Index struct {
+	models   *Models
+	provider Provider
+}
+
+// NewIndex 创建内容向量索引
+func NewIndex(models *Models, provider Provider) *Index {
+	return &Index{
+		models:   models,
+		provider: provider,
+	}
+}
+
+// Add 计算记录的向量并保存，文本没有变化时不重新计算
+func (x *Index) Add(ctx context.Context, doc *Document) error {
+	return x.add(ctx, x.models.Embeddings, doc)
+}
+
+// AddTx 在事务中计算记录的向量并保存，向量和记录一起提交或回滚
+func (x *Index) AddTx(ctx context.Context, tx *hps.Models, doc *Document) error {
+	return x.add(ctx, tx.Embeddings, doc)
+}
+
+func (x *Index) add(ctx context.Context, embeddings hps.EmbeddingsModel, doc *Document) error {
+	text := strings.TrimSpace(doc.Text)
+	if text == "" {
+		return nil
+	}
+	sum := sha256.Sum256([]byte(text))
+	hash := hex.EncodeToString(sum[:])
+	model := x.provider.Model()
+
+	existing, err := embeddings.FindOneByEntityTypeEntityIdModel(ctx, doc.Type, doc.ID, model)
+	if err != nil && err != hps.ErrNotFound {
+		return fmt.Errorf("查询内容向量失败: %w", err)
+	}
+	if existing != nil && existing.ContentHash == hash {
+		return nil
+	}
+
+	vector, err := x.provider.Embed(ctx, text)
+	if err != nil {
+		return err
+	}
+	normalize(vector)
+
+	if existing != nil {
+		existing.Dimensions = int64(len(vector))
+		existing.Vector = encode(vector)
+		existing.ContentHash = hash
+		if err := embeddings.Update(ctx, existing); err != nil {
+			return fmt.Errorf("更新内容向量失败: %w", err)
+		}
+		return nil
+	}
+	_, err = embeddings.Insert(ctx, &hps.Embeddings{
+		EntityType:  doc.Type,
+		EntityId:    doc.ID,
+		UserId:      doc.UserID,
+		ProjectId:   doc.ProjectID,
+		Model:       model,
+		Dimensions:  int64(len(vector)),
+		Vector:      encode(vector),
+		ContentHash: hash,
+	})
+	if err != nil {
+		return fmt.Errorf("保存内容向量失败: %w", err)
+	}
+	return nil
+}
+
+// SimilarObservations 在孩子自己的全部项目中查找与该观察相似的观察，不包含被拦截的图片和已删除的记录
+// 观察还没有向量时返回空列表
+func (x *Index) SimilarObservations(ctx context.Context, userID, observationID int64, limit int) ([]*SimilarObservation, error) {
+	model := x.provider.Model()
+	target, err := x.models.Embeddings.FindOneByEntityTypeEntityIdModel(ctx, hps.EmbeddingEntityObservation, observationID, model)
+	if err == hps.ErrNotFound || (err == nil && target.UserId != userID) {
+		return []*SimilarObservation{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询内容向量失败: %w", err)
+	}
+	targetVector, err := decode(target)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := x.models.Embeddings.FindByUser(ctx, userID, model, hps.EmbeddingEntityObservation)
+	if err != nil && err != hps.ErrNotFound {
+		return nil, fmt.Errorf("查询内容向量失败: %w", err)
+	}
+	vectors := make(map[int64][]float32, len(candidates))
+	for _, c := range candidates {
+		if c.EntityId == observationID {
+			continue
+		}
+		if v, err := decode(c); err == nil {
+			vectors[c.EntityId] = v
+		}
+	}
+
+	result := make([]*SimilarObservation, 0, limit)
+	projectTitles := make(map[int64]string)
+	for _, s := range rank(targetVector, vectors, limit*candidateFactor) {
+		if len(result) >= limit {
+			break
+		}
+		observation, err := x.models.Observations.FindOneByObservationId(ctx, s.id)
+		if err == hps.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("查询观察记录失败: %w", err)
+		}
+		if observation.DeleteTime.Valid || observation.Quarantined() || observation.RecognitionHidden() {
+			continue
+		}
+		title, ok := projectTitles[observation.ProjectId]
+		if !ok {
+			project, err := x.models.Projects.FindOneByProjectId(ctx, observation.ProjectId)
+			if err != nil && err != hps.ErrNotFound {
+				return nil, fmt.Errorf("查询项目失败: %w", err)
+			}
+			if err == nil && !project.DeleteTime.Valid {
+				title = project.Title
+			}
+			projectTitles[observation.ProjectId] = title
+		}
+		// 所属项目已删除
+		if title == "" {
+			continue
+		}
+		result = append(result, &SimilarObservation{
+			Observation:  observation,
+			ProjectTitle: title,
+			Score:        s.score,
+		})
+	}
+	return result, nil
+}
+
+// RelatedProjects 查找孩子自己的项目中与该项目内容相近的项目
+// 项目的向量为项目中全部观察、问题和表达向量的平均，项目还没有向量时返回空列表
+func (x *Index) RelatedProjects(ctx context.Context, userID, projectID int64, limit int) ([]*RelatedProject, error) {
+	all, err := x.models.Embeddings.FindByUser(ctx, userID, x.provider.Model(), "")
+	if err != nil && err != hps.ErrNotFound {
+		return nil, fmt.Errorf("查询内容向量失败: %w", err)
+	}
+
+	centroids := make(map[int64][]float32)
+	for _, e := range all {
+		v, err := decode(e)
+		if err != nil {
+			continue
+		}
+		c, ok := centroids[e.ProjectId]
+		if !ok {
+			c = make([]float32, len(v))
+			centroids[e.ProjectId] = c
+		}
+		for i := range v {
+			c[i] += v[i]
+		}
+	}
+	target, ok := centroids[projectID]
+	if !ok {
+		return []*RelatedProject{}, nil
+	}
+	delete(centroids, projectID)
+	normalize(target)
+	for _, c := range centroids {
+		normalize(c)
+	}
+
+	result := make([]*RelatedProject, 0, limit)
+	for _, s := range rank(target, centroids, limit*candidateFactor) {
+		if len(result) >= limit {
+			break
+		}
+		project, err := x.models.Projects.FindOneByProjectId(ctx, s.id)
+		if err == hps.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("查询项目失败: %w", err)
+		}
+		if project.DeleteTime.Valid {
+			continue
+		}
+		result = append(result, &RelatedProject{
+			Project: project,
+			Score:   s.score,
+		})
+	}
+	return result, nil
+}
+
+type scored struct {
+	id    int64
+	score float64
+}
+
+// rank 按余弦相似度从高到低返回前k个不低于最低相似度的候选，向量均已归一化
+func rank(target []float32, candidates map[int64][]float32, k int) []scored {
+	result := make([]scored, 0, len(candidates))
+	for id, v := range candidates {
+		if len(v) != len(target) {
+			continue
+		}
+		var dot float64
+		for i := range v {
+			dot += float64(v[i]) * float64(target[i])
+		}
+		if dot >= minSimilarity {
+			result = append(result, scored{id: id, score: dot})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].score != result[j].score {
+			return result[i].score > result[j].score
+		}
+		return result[i].id > result[j].id
+	})
+	if len(result) > k {
+		result = result[:k]
+	}
+	return result
+}
+
+// normalize 把向量缩放为单位长度，零向量保持不变
+func normalize(v []float32) {
+	var sum float64
+	for _, f := range v {
+		sum += float64(f) * float64(f)
+	}
+	if sum == 0 {
+		return
+	}
+	norm := float32(math.Sqrt(sum))
+	for i := range v {
+		v[i] /= norm
+	}
+}
+
+// encode 向量按float32小端序保存
+func encode(v []float32) string {
+	data := make([]byte, len(v)*4)
+	for i, f := range v {
+		binary.LittleEndian.PutUint32(data[i*4:], math.Float32bits(f))
+	}
+	return string(data)
+}
+
+func decode(e *hps.Embeddings) ([]float32, error) {
+	if int64(len(e.Vector)) != e.Dimensions*4 {
+		return nil, ErrDimensionMismatch
+	}
+	v := make([]float32, e.Dimensions)
+	for i := range v {
+		v[i] = math.Float32frombits(binary.LittleEndian.Uint32([]byte(e.Vector[i*4:])))
+	}
+	return v, nil
+}
+
+// ObservationDocument 观察记录按识别结果计算向量
+func ObservationDocument(o *hps.Observations) *Document {
+	return &Document{
+		Type:      hps.EmbeddingEntityObservation,
+		ID:        o.ObservationId,
+		UserID:    o.UserId,
+		ProjectID: o.ProjectId,
+		Text:      joinText(o.ObjectName.String, o.ScientificName.String, o.Category.String, o.Description.String),
+	}
+}
+
+// QuestionDocument 问题按内容和孩子的回答计算向量
+func QuestionDocument(q *hps.Questions) *Document {
+	return &Document{
+		Type:      hps.EmbeddingEntityQuestion,
+		ID:        q.QuestionId,
+		UserID:    q.UserId,
+		ProjectID: q.ProjectId,
+		Text:      joinText(q.Content, q.UserResponse.String),
+	}
+}
+
+// ExpressionDocument 表达记录按孩子的原文计算向量，润色内容可能还在等待审核
+func ExpressionDocument(e *hps.Expressions) *Document {
+	return &Document{
+		Type:      hps.EmbeddingEntityExpression,
+		ID:        e.ExpressionId,
+		UserID:    e.UserId,
+		ProjectID: e.ProjectId,
+		Text:      e.RawContent,
+	}
+}
+
+func joinText(parts ...string) string {
+	texts := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			texts = append(texts, p)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
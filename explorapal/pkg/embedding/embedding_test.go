@@ -0,0 +1,89 @@
+package embedding
+
+import (
+	"testing"
+
+	"explorapal/app/model/hps"
+)
+
+func TestRank(t *testing.T) {
+	target := unit(1, 0, 0)
+	candidates := map[int64][]float32{
+		1: unit(1, 1, 0),   // 0.71
+		2: unit(1, 0, 0),   // 1
+		3: unit(0, 1, 0),   // 0，低于最低相似度
+		4: unit(-1, 0, 0),  // -1
+		5: unit(1, 0, 0.2), // 0.98
+		6: unit(1, 0),      // 维数不一致
+	}
+
+	got := rank(target, candidates, 10)
+	wantIDs := []int64{2, 5, 1}
+	if len(got) != len(wantIDs) {
+		t.Fatalf("rank = %v, want ids %v", got, wantIDs)
+	}
+	for i, id := range wantIDs {
+		if got[i].id != id {
+			t.Fatalf("rank[%d].id = %d, want %d (%v)", i, got[i].id, id, got)
+		}
+	}
+	if got[0].score < 0.9999 || got[0].score > 1.0001 {
+		t.Fatalf("score of identical vector = %v, want 1", got[0].score)
+	}
+
+	if top := rank(target, candidates, 2); len(top) != 2 || top[0].id != 2 || top[1].id != 5 {
+		t.Fatalf("rank with k=2 = %v, want ids [2 5]", top)
+	}
+}
+
+func TestRankTies(t *testing.T) {
+	target := unit(0, 1)
+	candidates := map[int64][]float32{
+		7: unit(0, 1),
+		9: unit(0, 1),
+		8: unit(0, 1),
+	}
+	got := rank(target, candidates, 3)
+	for i, id := range []int64{9, 8, 7} {
+		if got[i].id != id {
+			t.Fatalf("rank[%d].id = %d, want %d", i, got[i].id, id)
+		}
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	v := []float32{0.5, -0.25, 1, 0}
+	got, err := decode(&hps.Embeddings{Dimensions: int64(len(v)), Vector: encode(v)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range v {
+		if got[i] != v[i] {
+			t.Fatalf("decode[%d] = %v, want %v", i, got[i], v[i])
+		}
+	}
+
+	if _, err := decode(&hps.Embeddings{Dimensions: 5, Vector: encode(v)}); err != ErrDimensionMismatch {
+		t.Fatalf("decode with wrong dimensions: err = %v, want %v", err, ErrDimensionMismatch)
+	}
+}
+
+func TestQuestionDocument(t *testing.T) {
+	doc := QuestionDocument(&hps.Questions{
+		QuestionId: 1,
+		UserId:     2,
+		ProjectId:  3,
+		Content:    " 蚂蚁怎么找到回家的路？ ",
+	})
+	if doc.Type != hps.EmbeddingEntityQuestion || doc.ID != 1 || doc.UserID != 2 || doc.ProjectID != 3 {
+		t.Fatalf("document = %+v", doc)
+	}
+	if doc.Text != "蚂蚁怎么找到回家的路？" {
+		t.Fatalf("text = %q", doc.Text)
+	}
+}
+
+func unit(values ...float32) []float32 {
+	normalize(values)
+	return values
+}
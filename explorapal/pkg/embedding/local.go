@@ -0,0 +1,94 @@
+package embedding
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"unicode"
+)
+
+const defaultDimensions = 256
+
+// LocalEmbedder 本地确定性向量：把文本切成词、汉字和汉字二元组后做特征哈希，相同文本总是得到相同的向量
+// 不依赖外部服务，适合开发和测试；只能发现字面相近的内容，语义相近的推荐需要使用DashScope
+type LocalEmbedder struct {
+	dimensions int
+}
+
+// NewLocalEmbedder 创建本地向量，dimensions不大于0时使用256维
+func NewLocalEmbedder(dimensions int) *LocalEmbedder {
+	if dimensions <= 0 {
+		dimensions = defaultDimensions
+	}
+	return &LocalEmbedder{
+		dimensions: dimensions,
+	}
+}
+
+// Model 向量模型名称
+func (e *LocalEmbedder) Model() string {
+	return fmt.Sprintf("local-hash-%d", e.dimensions)
+}
+
+// Embed 计算文本向量
+func (e *LocalEmbedder) Embed(ctx context.Context, text string) ([]float32, error) {
+	vector := make([]float32, e.dimensions)
+	for _, f := range features(strings.ToLower(text)) {
+		h := fnv.New64a()
+		h.Write([]byte(f.token))
+		sum := h.Sum64()
+		// 最高位决定符号，减少哈希冲突带来的偏差
+		sign := float32(1)
+		if sum>>63 == 1 {
+			sign = -1
+		}
+		vector[sum%uint64(e.dimensions)] += sign * f.weight
+	}
+	normalize(vector)
+	return vector, nil
+}
+
+type feature struct {
+	token  string
+	weight float32
+}
+
+// features 连续的汉字取单字和二元组，其他字母和数字按词切分
+func features(text string) []feature {
+	var result []feature
+	var word []rune
+	var han []rune
+	flushWord := func() {
+		if len(word) > 0 {
+			result = append(result, feature{token: string(word), weight: 1})
+			word = word[:0]
+		}
+	}
+	flushHan := func() {
+		for i, r := range han {
+			result = append(result, feature{token: string(r), weight: 0.5})
+			if i+1 < len(han) {
+				result = append(result, feature{token: string(han[i : i+2]), weight: 1})
+			}
+		}
+		han = han[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flushWord()
+			han = append(han, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushHan()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushHan()
+		}
+	}
+	flushWord()
+	flushHan()
+	return result
+}
@@ -0,0 +1,99 @@
+package embedding
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestLocalEmbedderDeterministic(t *testing.T) {
+	e := NewLocalEmbedder(64)
+	a, err := e.Embed(context.Background(), "小蚂蚁在搬运食物 ants")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewLocalEmbedder(64).Embed(context.Background(), "小蚂蚁在搬运食物 ants")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a) != 64 {
+		t.Fatalf("dimensions = %d, want 64", len(a))
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("vector[%d] = %v and %v, want the same value", i, a[i], b[i])
+		}
+	}
+}
+
+func TestLocalEmbedderNormalized(t *testing.T) {
+	v, err := NewLocalEmbedder(0).Embed(context.Background(), "为什么树叶到了秋天会变黄？")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(v) != defaultDimensions {
+		t.Fatalf("dimensions = %d, want %d", len(v), defaultDimensions)
+	}
+	if norm := length(v); math.Abs(norm-1) > 1e-5 {
+		t.Fatalf("norm = %v, want 1", norm)
+	}
+
+	empty, err := NewLocalEmbedder(8).Embed(context.Background(), "？！")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if norm := length(empty); norm != 0 {
+		t.Fatalf("norm of text without features = %v, want 0", norm)
+	}
+}
+
+func TestLocalEmbedderSimilarText(t *testing.T) {
+	e := NewLocalEmbedder(256)
+	embed := func(text string) []float32 {
+		v, err := e.Embed(context.Background(), text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	target := embed("蚂蚁排着队搬运食物")
+	near := embed("蚂蚁一起搬运食物回家")
+	far := embed("月亮为什么会有圆缺")
+
+	if nearScore, farScore := dot(target, near), dot(target, far); nearScore <= farScore {
+		t.Fatalf("similar text scored %v, unrelated text scored %v", nearScore, farScore)
+	}
+	if score := dot(target, target); math.Abs(score-1) > 1e-5 {
+		t.Fatalf("self similarity = %v, want 1", score)
+	}
+}
+
+func TestFeatures(t *testing.T) {
+	got := features("蝴蝶 Butterfly2")
+	want := []feature{
+		{token: "蝴", weight: 0.5},
+		{token: "蝴蝶", weight: 1},
+		{token: "蝶", weight: 0.5},
+		{token: "Butterfly2", weight: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("features = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("features[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func length(v []float32) float64 {
+	return math.Sqrt(dot(v, v))
+}
+
+func dot(a, b []float32) float64 {
+	var sum float64
+	for i := range a {
+		sum += float64(a[i]) * float64(b[i])
+	}
+	return sum
+}
@@ -0,0 +1,40 @@
+// Package guardian 家长、老师与孩子账号的关联查询
+package guardian
+
+import (
+	"context"
+	"errors"
+
+	"explorapal/app/model/hps"
+)
+
+// ErrNotLinked 调用方没有关联该孩子
+var ErrNotLinked = errors.New("没有关联这个孩子")
+
+// 关系在孩子端展示的称呼
+var relationNames = map[string]string{
+	hps.GuardianRelationFather:   "爸爸",
+	hps.GuardianRelationMother:   "妈妈",
+	hps.GuardianRelationGuardian: "家长",
+	hps.GuardianRelationTeacher:  "老师",
+}
+
+// Relation 查询大人与孩子的关系，没有关联或关联已删除时返回ErrNotLinked
+func Relation(ctx context.Context, links hps.GuardianLinksModel, adultID, childID int64) (string, error) {
+	link, err := links.FindOneByParentIdChildId(ctx, adultID, childID)
+	if err == hps.ErrNotFound || (err == nil && link.DeleteTime.Valid) {
+		return "", ErrNotLinked
+	}
+	if err != nil {
+		return "", err
+	}
+	return link.Relation, nil
+}
+
+// RelationName 关系在孩子端展示的称呼
+func RelationName(relation string) string {
+	if name, ok := relationNames[relation]; ok {
+		return name
+	}
+	return relationNames[hps.GuardianRelationGuardian]
+}
@@ -0,0 +1,71 @@
+package idgen
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// Crockford Base32字母表，不含容易混淆的I、L、O、U
+const codeAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+const (
+	projectCodePrefix = "EXP-"
+	// 随机部分的字符数，每个字符5位，共40位
+	projectCodeLength = 8
+	// 每组字符数，便于朗读和抄写
+	projectCodeGroup = 3
+)
+
+// ProjectCode 生成项目编码，如EXP-7K3-MQ9-TZ4
+// 8个随机的Base32字符加1个校验字符，按3个一组用短横线分隔；校验字符可以发现抄错的单个字符和大部分相邻字符互换。
+// 随机编码仍有很小的概率重复，保存时遇到唯一键冲突需要重新生成
+func ProjectCode() string {
+	value := rand.Uint64()
+	chars := make([]byte, 0, projectCodeLength+1)
+	for i := 0; i < projectCodeLength; i++ {
+		chars = append(chars, codeAlphabet[value&31])
+		value >>= 5
+	}
+	chars = append(chars, checkChar(chars))
+
+	var b strings.Builder
+	b.WriteString(projectCodePrefix)
+	for i, c := range chars {
+		if i > 0 && i%projectCodeGroup == 0 {
+			b.WriteByte('-')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// ValidProjectCode 校验孩子或老师输入的项目编码，不区分大小写，忽略短横线和空格，I、L按1、O按0处理
+func ValidProjectCode(code string) bool {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !strings.HasPrefix(code, projectCodePrefix) {
+		return false
+	}
+	chars := []byte(strings.NewReplacer("-", "", " ", "", "I", "1", "L", "1", "O", "0").
+		Replace(strings.TrimPrefix(code, projectCodePrefix)))
+	if len(chars) != projectCodeLength+1 {
+		return false
+	}
+	for _, c := range chars {
+		if strings.IndexByte(codeAlphabet, c) < 0 {
+			return false
+		}
+	}
+	return checkChar(chars[:projectCodeLength]) == chars[projectCodeLength]
+}
+
+// checkChar 按Luhn mod 32算法计算校验字符
+func checkChar(chars []byte) byte {
+	const n = len(codeAlphabet)
+	factor, sum := 2, 0
+	for i := len(chars) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(codeAlphabet, chars[i])
+		sum += addend/n + addend%n
+		factor = 3 - factor
+	}
+	return codeAlphabet[(n-sum%n)%n]
+}
@@ -0,0 +1,70 @@
+package idgen
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var projectCodePattern = regexp.MustCompile(`^EXP-[0-9A-HJKMNP-TV-Z]{3}-[0-9A-HJKMNP-TV-Z]{3}-[0-9A-HJKMNP-TV-Z]{3}$`)
+
+func TestProjectCode(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		code := ProjectCode()
+		if !projectCodePattern.MatchString(code) {
+			t.Fatalf("ProjectCode() = %s, want format EXP-XXX-XXX-XXX", code)
+		}
+		if !ValidProjectCode(code) {
+			t.Fatalf("ValidProjectCode(%s) = false", code)
+		}
+
+		// 抄错任意一个字符都能发现
+		chars := []byte(code)
+		for pos := len(projectCodePrefix); pos < len(chars); pos++ {
+			if chars[pos] == '-' {
+				continue
+			}
+			original := chars[pos]
+			for j := 0; j < len(codeAlphabet); j++ {
+				if codeAlphabet[j] == original {
+					continue
+				}
+				chars[pos] = codeAlphabet[j]
+				if ValidProjectCode(string(chars)) {
+					t.Fatalf("ValidProjectCode(%s) = true for a mistyped %s", chars, code)
+				}
+			}
+			chars[pos] = original
+		}
+	}
+}
+
+func TestValidProjectCode(t *testing.T) {
+	body := "10ABCDEF"
+	code := "EXP-10A-BCD-EF" + string(checkChar([]byte(body)))
+
+	tests := []struct {
+		name string
+		code string
+		want bool
+	}{
+		{"canonical", code, true},
+		{"lower case and spaces", " " + strings.ToLower(code) + " ", true},
+		{"without group dashes", projectCodePrefix + strings.ReplaceAll(strings.TrimPrefix(code, projectCodePrefix), "-", ""), true},
+		{"confusable letters", "EXP-IOA-BCD-EF" + code[len(code)-1:], true},
+		{"lower case l", "exp-lOa-bcd-ef" + strings.ToLower(code[len(code)-1:]), true},
+		{"swapped adjacent characters", "EXP-01A-BCD-EF" + code[len(code)-1:], false},
+		{"missing prefix", strings.TrimPrefix(code, projectCodePrefix), false},
+		{"too short", code[:len(code)-1], false},
+		{"too long", code + "0", false},
+		{"character outside alphabet", "EXP-10A-BCD-EU" + code[len(code)-1:], false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidProjectCode(tt.code); got != tt.want {
+				t.Fatalf("ValidProjectCode(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package idgen
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/discov"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/proc"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// 机器号租约的有效期，实例失联超过该时间后机器号被释放
+	workerTTL = 10
+	// 重新申请机器号的间隔
+	claimRetryInterval = time.Second
+	// 续约期限比租约到期提前的余量，覆盖续约请求的网络延迟；超过期限仍未续约时暂停生成ID
+	leaseSafetyMargin = 3 * time.Second
+	etcdDialTimeout   = 5 * time.Second
+)
+
+var ErrNoWorker = errors.New("etcd中没有空闲的机器号")
+
+// etcdWorker 在etcd中持有的机器号
+// 每个机器号对应<Key>/<机器号>，绑定到实例的租约上，实例退出时撤销租约释放机器号
+type etcdWorker struct {
+	client *clientv3.Client
+	prefix string
+	owner  string
+	gen    *Generator
+	lease  atomic.Int64 // 当前持有的租约
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// newEtcdGenerator 在etcd中申请机器号并创建生成器，租约失效后自动重新申请
+func newEtcdGenerator(c discov.EtcdConf) (*Generator, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	client, err := newEtcdClient(c)
+	if err != nil {
+		return nil, fmt.Errorf("连接etcd失败: %w", err)
+	}
+
+	hostname, _ := os.Hostname()
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &etcdWorker{
+		client: client,
+		prefix: strings.TrimSuffix(c.Key, "/"),
+		owner:  fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	worker, lease, err := w.claim(-1)
+	if err != nil {
+		cancel()
+		client.Close()
+		return nil, err
+	}
+	if w.gen, err = NewGenerator(worker); err != nil {
+		cancel()
+		client.Close()
+		return nil, err
+	}
+
+	w.gen.renew(leaseDeadline(workerTTL))
+	w.lease.Store(int64(lease))
+	go w.keepAlive(worker, lease)
+	proc.AddShutdownListener(func() {
+		w.cancel()
+		revokeCtx, revokeCancel := context.WithTimeout(context.Background(), etcdDialTimeout)
+		defer revokeCancel()
+		if _, err := w.client.Revoke(revokeCtx, clientv3.LeaseID(w.lease.Load())); err != nil {
+			logx.Errorf("释放机器号失败: %v", err)
+		}
+		w.client.Close()
+	})
+	return w.gen, nil
+}
+
+// claim 申请一个空闲的机器号，优先申请preferred
+func (w *etcdWorker) claim(preferred int64) (int64, clientv3.LeaseID, error) {
+	resp, err := w.client.Get(w.ctx, w.prefix+"/", clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return 0, 0, fmt.Errorf("查询已占用的机器号失败: %w", err)
+	}
+	used := make(map[int64]bool, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		if id, err := strconv.ParseInt(strings.TrimPrefix(string(kv.Key), w.prefix+"/"), 10, 64); err == nil {
+			used[id] = true
+		}
+	}
+
+	lease, err := w.client.Grant(w.ctx, workerTTL)
+	if err != nil {
+		return 0, 0, fmt.Errorf("申请etcd租约失败: %w", err)
+	}
+	candidates := make([]int64, 0, MaxWorkerID+2)
+	if preferred >= 0 {
+		candidates = append(candidates, preferred)
+	}
+	for id := int64(0); id <= MaxWorkerID; id++ {
+		if !used[id] && id != preferred {
+			candidates = append(candidates, id)
+		}
+	}
+
+	// 只在键不存在时写入，多个实例同时申请同一个机器号时只有一个成功
+	for _, id := range candidates {
+		key := w.prefix + "/" + strconv.FormatInt(id, 10)
+		txn, err := w.client.Txn(w.ctx).
+			If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+			Then(clientv3.OpPut(key, w.owner, clientv3.WithLease(lease.ID))).
+			Commit()
+		if err != nil {
+			w.client.Revoke(context.Background(), lease.ID)
+			return 0, 0, fmt.Errorf("申请机器号失败: %w", err)
+		}
+		if txn.Succeeded {
+			return id, lease.ID, nil
+		}
+	}
+	w.client.Revoke(context.Background(), lease.ID)
+	return 0, 0, ErrNoWorker
+}
+
+// keepAlive 续约机器号并延长生成器的续约期限；租约失效时暂停生成ID，直到重新申请到机器号，避免与接手该机器号的实例生成重复的ID
+func (w *etcdWorker) keepAlive(worker int64, lease clientv3.LeaseID) {
+	for {
+		ch, err := w.client.KeepAlive(w.ctx, lease)
+		if err == nil {
+			for resp := range ch {
+				w.gen.renew(leaseDeadline(resp.TTL))
+			}
+		}
+		if w.ctx.Err() != nil {
+			return
+		}
+
+		logx.Errorf("机器号%d的etcd租约已失效，暂停生成ID并重新申请", worker)
+		w.gen.suspend()
+		for {
+			worker, lease, err = w.claim(worker)
+			if err == nil {
+				break
+			}
+			logx.Errorf("重新申请机器号失败: %v", err)
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-time.After(claimRetryInterval):
+			}
+		}
+		w.lease.Store(int64(lease))
+		w.gen.resume(worker, leaseDeadline(workerTTL))
+		logx.Infof("已重新申请机器号: %d", worker)
+	}
+}
+
+// leaseDeadline 租约剩余ttl秒时，本实例可以使用机器号的期限
+func leaseDeadline(ttl int64) time.Time {
+	return time.Now().Add(time.Duration(ttl)*time.Second - leaseSafetyMargin)
+}
+
+func newEtcdClient(c discov.EtcdConf) (*clientv3.Client, error) {
+	cfg := clientv3.Config{
+		Endpoints:   c.Hosts,
+		DialTimeout: etcdDialTimeout,
+	}
+	if c.HasAccount() {
+		cfg.Username = c.User
+		cfg.Password = c.Pass
+	}
+	if c.HasTLS() {
+		tlsConfig, err := loadTLS(c)
+		if err != nil {
+			return nil, err
+		}
+		cfg.TLS = tlsConfig
+	}
+	return clientv3.New(cfg)
+}
+
+func loadTLS(c discov.EtcdConf) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.CertKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("读取etcd证书失败: %w", err)
+	}
+	caData, err := os.ReadFile(c.CACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("读取etcd CA证书失败: %w", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(caData)
+	return &tls.Config{
+		Certificates:       []tls.Certificate{cert},
+		RootCAs:            pool,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}, nil
+}
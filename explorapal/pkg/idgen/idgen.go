@@ -0,0 +1,188 @@
+// Package idgen 分布式ID生成
+// 数据表的业务ID使用雪花算法：41位毫秒时间 + 10位机器号 + 12位序号，同一实例内严格递增。
+// 机器号来自配置，或者在etcd中申请；同时运行的实例机器号不能重复。
+// 纪元取2010-01-01，使新ID大于此前用纳秒时间戳生成的ID，新旧ID不会冲突且仍按时间递增
+package idgen
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/discov"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	workerBits   = 10
+	sequenceBits = 12
+
+	// MaxWorkerID 最大机器号
+	MaxWorkerID = 1<<workerBits - 1
+
+	sequenceMask = 1<<sequenceBits - 1
+	workerShift  = sequenceBits
+	timeShift    = sequenceBits + workerBits
+)
+
+// 时钟回拨超过该时间时记录错误日志
+const skewLogThreshold = time.Second
+
+var epoch = time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+
+var ErrInvalidWorker = fmt.Errorf("机器号必须在0到%d之间", MaxWorkerID)
+
+// Config ID生成配置
+type Config struct {
+	// WorkerID 机器号，同时运行的实例不能重复；配置了Etcd时忽略
+	WorkerID int64 `json:",default=0,range=[0:1023]"`
+	// Etcd 在etcd中申请机器号，Key为机器号的前缀；实例退出或失联后机器号自动释放
+	Etcd discov.EtcdConf `json:",optional"`
+}
+
+// Generator 雪花ID生成器
+// 时钟回拨时继续使用上次的时间并递增序号，不会生成重复或更小的ID；
+// 机器号失效（etcd租约过期）或超过续约期限未续约期间Next会等待，续约或重新申请到机器号后继续
+type Generator struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	worker   int64     // 小于0表示机器号已失效
+	deadline time.Time // 机器号的有效期限，零值表示不需要续约（固定机器号）
+	last     int64     // 上次生成ID的时间，纪元后的毫秒数
+	seq      int64
+	skewed   bool
+}
+
+// NewGenerator 使用固定机器号创建生成器
+func NewGenerator(worker int64) (*Generator, error) {
+	if worker < 0 || worker > MaxWorkerID {
+		return nil, ErrInvalidWorker
+	}
+	g := &Generator{worker: worker}
+	g.cond = sync.NewCond(&g.mu)
+	return g, nil
+}
+
+// Next 生成下一个ID
+func (g *Generator) Next() int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for !g.usable() {
+		g.cond.Wait()
+	}
+
+	now := elapsed()
+	if now < g.last {
+		// 时钟回拨：沿用上次的时间，保证ID递增
+		if !g.skewed && time.Duration(g.last-now)*time.Millisecond > skewLogThreshold {
+			logx.Errorf("系统时钟回拨%dms，ID生成继续使用上次的时间", g.last-now)
+		}
+		g.skewed = true
+		now = g.last
+	} else {
+		g.skewed = false
+	}
+
+	if now == g.last {
+		g.seq = (g.seq + 1) & sequenceMask
+		if g.seq == 0 {
+			// 当前毫秒的序号已用完
+			now = nextMillis(g.last)
+		}
+	} else {
+		g.seq = 0
+	}
+	g.last = now
+	return now<<timeShift | g.worker<<workerShift | g.seq
+}
+
+// Worker 当前的机器号，机器号已失效时返回-1
+func (g *Generator) Worker() int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.worker
+}
+
+// usable 机器号有效且未超过续约期限，调用方需持有锁
+func (g *Generator) usable() bool {
+	return g.worker >= 0 && (g.deadline.IsZero() || time.Now().Before(g.deadline))
+}
+
+// renew 续约成功，延长机器号的有效期限
+func (g *Generator) renew(deadline time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.deadline = deadline
+	g.cond.Broadcast()
+}
+
+// suspend 机器号失效，Next等待新的机器号
+func (g *Generator) suspend() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.worker = -1
+}
+
+// resume 使用新的机器号继续生成
+func (g *Generator) resume(worker int64, deadline time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.worker = worker
+	g.deadline = deadline
+	g.cond.Broadcast()
+}
+
+// elapsed 纪元后的毫秒数
+func elapsed() int64 {
+	return time.Since(epoch).Milliseconds()
+}
+
+// nextMillis 等到last之后的下一毫秒；时钟回拨期间等不到，直接使用下一毫秒
+func nextMillis(last int64) int64 {
+	for i := 0; i < 2; i++ {
+		if now := elapsed(); now > last {
+			return now
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return last + 1
+}
+
+var defaultGenerator atomic.Pointer[Generator]
+
+// MustSetup 按配置创建全局生成器，服务启动时调用，失败时退出
+func MustSetup(c Config) {
+	if err := Setup(c); err != nil {
+		logx.Must(err)
+	}
+}
+
+// Setup 按配置创建全局生成器，配置了Etcd时在etcd中申请机器号
+func Setup(c Config) error {
+	var g *Generator
+	var err error
+	if len(c.Etcd.Hosts) > 0 {
+		g, err = newEtcdGenerator(c.Etcd)
+	} else {
+		g, err = NewGenerator(c.WorkerID)
+	}
+	if err != nil {
+		return err
+	}
+	defaultGenerator.Store(g)
+	logx.Infof("ID生成器已初始化，机器号: %d", g.Worker())
+	return nil
+}
+
+var errNotSetup = errors.New("idgen未初始化，服务启动时需要先调用idgen.MustSetup")
+
+// Next 使用全局生成器生成下一个ID
+func Next() int64 {
+	g := defaultGenerator.Load()
+	if g == nil {
+		panic(errNotSetup)
+	}
+	return g.Next()
+}
@@ -0,0 +1,144 @@
+package idgen
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewGenerator(t *testing.T) {
+	tests := []struct {
+		worker int64
+		err    error
+	}{
+		{0, nil},
+		{MaxWorkerID, nil},
+		{-1, ErrInvalidWorker},
+		{MaxWorkerID + 1, ErrInvalidWorker},
+	}
+	for _, tt := range tests {
+		if _, err := NewGenerator(tt.worker); err != tt.err {
+			t.Errorf("NewGenerator(%d) error = %v, want %v", tt.worker, err, tt.err)
+		}
+	}
+}
+
+func TestNextMonotonic(t *testing.T) {
+	g, err := NewGenerator(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const workers, perWorker = 8, 5000
+	ids := make([][]int64, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				ids[w] = append(ids[w], g.Next())
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	seen := make(map[int64]bool, workers*perWorker)
+	for _, list := range ids {
+		for i, id := range list {
+			if seen[id] {
+				t.Fatalf("duplicate id %d", id)
+			}
+			seen[id] = true
+			if i > 0 && id <= list[i-1] {
+				t.Fatalf("id %d after %d is not increasing", id, list[i-1])
+			}
+			if worker := id >> workerShift & MaxWorkerID; worker != 7 {
+				t.Fatalf("id %d has worker %d, want 7", id, worker)
+			}
+		}
+	}
+}
+
+func TestNextClockSkew(t *testing.T) {
+	tests := []struct {
+		name     string
+		ahead    int64 // 上次生成ID的时间比当前时钟快的毫秒数
+		seq      int64
+		wantTime int64 // 相对上次时间的偏移
+		wantSeq  int64
+	}{
+		{"clock moved back", 2000, 5, 0, 6},
+		{"clock moved back with sequence exhausted", 2000, sequenceMask, 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := NewGenerator(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			last := elapsed() + tt.ahead
+			g.last, g.seq = last, tt.seq
+
+			id := g.Next()
+			if got := id >> timeShift; got != last+tt.wantTime {
+				t.Fatalf("time = %d, want %d", got, last+tt.wantTime)
+			}
+			if got := id & sequenceMask; got != tt.wantSeq {
+				t.Fatalf("sequence = %d, want %d", got, tt.wantSeq)
+			}
+			if next := g.Next(); next <= id {
+				t.Fatalf("id %d after %d is not increasing", next, id)
+			}
+		})
+	}
+}
+
+func TestNextWaitsForWorker(t *testing.T) {
+	tests := []struct {
+		name    string
+		block   func(g *Generator)
+		release func(g *Generator)
+		worker  int64
+	}{
+		{
+			name:    "lease lost",
+			block:   func(g *Generator) { g.suspend() },
+			release: func(g *Generator) { g.resume(9, time.Now().Add(time.Minute)) },
+			worker:  9,
+		},
+		{
+			name:    "renewal overdue",
+			block:   func(g *Generator) { g.renew(time.Now().Add(-time.Millisecond)) },
+			release: func(g *Generator) { g.renew(time.Now().Add(time.Minute)) },
+			worker:  3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := NewGenerator(3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.block(g)
+
+			done := make(chan int64)
+			go func() { done <- g.Next() }()
+			select {
+			case id := <-done:
+				t.Fatalf("Next returned %d without a valid worker", id)
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			tt.release(g)
+			select {
+			case id := <-done:
+				if worker := id >> workerShift & MaxWorkerID; worker != tt.worker {
+					t.Fatalf("id %d has worker %d, want %d", id, worker, tt.worker)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("Next still waiting after the worker became valid")
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package imagescreen
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// JPEG标记
+const (
+	markerSOI  = 0xD8 // 图像开始
+	markerSOS  = 0xDA // 扫描开始，之后是压缩数据
+	markerAPP1 = 0xE1 // EXIF / XMP 元数据
+)
+
+// EXIF中GPS信息IFD的标签
+const tagGPSInfo = 0x8825
+
+var exifHeader = []byte("Exif\x00\x00")
+
+// hasGPS 检查JPEG的EXIF中是否有GPS定位信息
+func hasGPS(data []byte) bool {
+	found := false
+	walkSegments(data, func(marker byte, payload []byte) {
+		if marker == markerAPP1 && bytes.HasPrefix(payload, exifHeader) && exifHasGPS(payload[len(exifHeader):]) {
+			found = true
+		}
+	})
+	return found
+}
+
+// exifHasGPS 在EXIF的第一个IFD中查找GPS信息指针
+func exifHasGPS(tiff []byte) bool {
+	if len(tiff) < 8 {
+		return false
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return false
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset+2 > len(tiff) {
+		return false
+	}
+	count := int(order.Uint16(tiff[offset : offset+2]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return false
+		}
+		if order.Uint16(tiff[entry:entry+2]) == tagGPSInfo {
+			return true
+		}
+	}
+	return false
+}
+
+// StripMetadata 去除JPEG中的EXIF、XMP等元数据（包括拍摄位置），其他格式原样返回
+func StripMetadata(data []byte) []byte {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != markerSOI {
+		return data
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:2]...)
+	pos := 2
+	stripped := false
+	ok := walkSegments(data, func(marker byte, payload []byte) {
+		segmentLen := 4 + len(payload)
+		if marker == markerAPP1 {
+			stripped = true
+		} else {
+			out = append(out, data[pos:pos+segmentLen]...)
+		}
+		pos += segmentLen
+	})
+	if !ok || !stripped {
+		return data
+	}
+	return append(out, data[pos:]...)
+}
+
+// walkSegments 依次遍历JPEG扫描数据之前的段，结构不完整时返回false
+func walkSegments(data []byte, fn func(marker byte, payload []byte)) bool {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != markerSOI {
+		return false
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return false
+		}
+		marker := data[pos+1]
+		if marker == markerSOS {
+			return true
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		if length < 2 || pos+2+length > len(data) {
+			return false
+		}
+		fn(marker, data[pos+4:pos+2+length])
+		pos += 2 + length
+	}
+	return false
+}
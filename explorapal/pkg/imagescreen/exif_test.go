@@ -0,0 +1,97 @@
+package imagescreen
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image/jpeg"
+	"testing"
+)
+
+func TestGPS(t *testing.T) {
+	plain := encodeJPEG(t)
+	tests := []struct {
+		name     string
+		data     []byte
+		hasGPS   bool
+		stripped bool
+	}{
+		{"no exif", plain, false, false},
+		{"exif with gps little endian", withAPP1(plain, exif(binary.LittleEndian, tagGPSInfo)), true, true},
+		{"exif with gps big endian", withAPP1(plain, exif(binary.BigEndian, tagGPSInfo)), true, true},
+		{"exif without gps", withAPP1(plain, exif(binary.LittleEndian, 0x010f)), false, true},
+		{"truncated exif", withAPP1(plain, exif(binary.LittleEndian, tagGPSInfo)[:14]), false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasGPS(tt.data); got != tt.hasGPS {
+				t.Fatalf("hasGPS = %v, want %v", got, tt.hasGPS)
+			}
+
+			stripped := StripMetadata(tt.data)
+			if changed := !bytes.Equal(stripped, tt.data); changed != tt.stripped {
+				t.Fatalf("StripMetadata changed data = %v, want %v", changed, tt.stripped)
+			}
+			if hasGPS(stripped) {
+				t.Fatal("GPS still present after StripMetadata")
+			}
+			if !bytes.Equal(stripped, plain) {
+				t.Fatal("StripMetadata did not restore the original image")
+			}
+
+			result, err := Screen(tt.data, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.HasGPS != tt.hasGPS || !result.Passed() {
+				t.Fatalf("Screen = %+v, want HasGPS %v and passed", result, tt.hasGPS)
+			}
+		})
+	}
+}
+
+func TestStripMetadataKeepsOtherFormats(t *testing.T) {
+	for _, data := range [][]byte{
+		encodePNG(t, fill(2, 2, sky)),
+		{0xFF, markerSOI, 0xFF},
+		nil,
+	} {
+		if got := StripMetadata(data); !bytes.Equal(got, data) {
+			t.Errorf("StripMetadata(%x) = %x, want unchanged", data, got)
+		}
+	}
+}
+
+func encodeJPEG(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, fill(16, 16, sky), nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// exif 只有一个IFD条目的EXIF数据
+func exif(order binary.ByteOrder, tag uint16) []byte {
+	tiff := make([]byte, 8+2+12+4)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:4], 42)
+	order.PutUint32(tiff[4:8], 8)
+	order.PutUint16(tiff[8:10], 1)
+	order.PutUint16(tiff[10:12], tag)
+	order.PutUint16(tiff[12:14], 4) // LONG
+	order.PutUint32(tiff[14:18], 1)
+	return append(append([]byte{}, exifHeader...), tiff...)
+}
+
+// withAPP1 在SOI之后插入APP1段
+func withAPP1(jpg, payload []byte) []byte {
+	out := append([]byte{}, jpg[:2]...)
+	out = append(out, 0xFF, markerAPP1)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(payload)+2))
+	out = append(out, payload...)
+	return append(out, jpg[2:]...)
+}
@@ -0,0 +1,304 @@
+// Package imagescreen 观察图片的本地安全筛查
+// 在调用安全中心和图像识别之前，用简单的启发式规则发现人脸、门牌和屏幕上的文字以及照片中的定位信息
+package imagescreen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg" // 注册JPEG解码
+	_ "image/png"  // 注册PNG解码
+	"math"
+)
+
+// 筛查发现的问题类型
+const (
+	FindingFace     = "face"     // 疑似人脸
+	FindingText     = "text"     // 疑似门牌、证件或屏幕上的文字
+	FindingLocation = "location" // 照片包含GPS定位信息
+)
+
+const (
+	// 检测时缩放后的最长边
+	faceSampleSize = 160
+	textSampleSize = 320
+	// 文字检测的网格大小（像素）
+	textCellSize = 16
+
+	defaultMaxTextRatio = 0.3
+	// 默认的像素数上限，约4000万像素，解码后占用约160MB内存
+	defaultMaxPixels = 40000000
+)
+
+// ErrTooLarge 图片的像素数超过上限，不解码以免占用过多内存
+var ErrTooLarge = errors.New("图片尺寸过大")
+
+// Options 筛查阈值
+type Options struct {
+	MaxFaces     int     // 允许的疑似人脸数量，超过即不通过；默认0，出现人脸即不通过
+	MaxTextRatio float64 // 允许的文字区域占比(0-1)，超过即不通过；默认0.3
+	MaxPixels    int     // 允许的像素数（宽×高），超过时不解码直接返回ErrTooLarge；默认4000万
+}
+
+// Finding 筛查发现的问题
+type Finding struct {
+	Type        string  `json:"type"`
+	Description string  `json:"description"`
+	Score       float64 `json:"score"`
+	Blocking    bool    `json:"blocking"` // 是否导致筛查不通过
+}
+
+// Result 筛查结果
+type Result struct {
+	Width     int       `json:"width"`
+	Height    int       `json:"height"`
+	Format    string    `json:"format"`
+	FaceCount int       `json:"face_count"`
+	TextRatio float64   `json:"text_ratio"`
+	HasGPS    bool      `json:"has_gps"`
+	Findings  []Finding `json:"findings"`
+}
+
+// Passed 是否通过筛查
+func (r *Result) Passed() bool {
+	for _, f := range r.Findings {
+		if f.Blocking {
+			return false
+		}
+	}
+	return true
+}
+
+// Screen 筛查图片，图片无法解码或像素数超过上限时返回错误
+func Screen(data []byte, opts Options) (*Result, error) {
+	if opts.MaxTextRatio <= 0 {
+		opts.MaxTextRatio = defaultMaxTextRatio
+	}
+	if opts.MaxPixels <= 0 {
+		opts.MaxPixels = defaultMaxPixels
+	}
+
+	// 先只读取图片头中的尺寸，压缩得很小的超大图片解码后会占用大量内存
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("图片解码失败: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width > opts.MaxPixels/cfg.Height {
+		return nil, fmt.Errorf("%w: %dx%d", ErrTooLarge, cfg.Width, cfg.Height)
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("图片解码失败: %w", err)
+	}
+
+	bounds := img.Bounds()
+	result := &Result{
+		Width:     bounds.Dx(),
+		Height:    bounds.Dy(),
+		Format:    format,
+		FaceCount: countFaces(img),
+		TextRatio: textRatio(img),
+		HasGPS:    format == "jpeg" && hasGPS(data),
+	}
+
+	if result.FaceCount > opts.MaxFaces {
+		result.Findings = append(result.Findings, Finding{
+			Type:        FindingFace,
+			Description: fmt.Sprintf("疑似包含%d张人脸", result.FaceCount),
+			Score:       float64(result.FaceCount),
+			Blocking:    true,
+		})
+	}
+	if result.TextRatio > opts.MaxTextRatio {
+		result.Findings = append(result.Findings, Finding{
+			Type:        FindingText,
+			Description: "疑似包含门牌、证件或屏幕上的文字",
+			Score:       result.TextRatio,
+			Blocking:    true,
+		})
+	}
+	// 定位信息在保存前去除即可，不影响筛查结论
+	if result.HasGPS {
+		result.Findings = append(result.Findings, Finding{
+			Type:        FindingLocation,
+			Description: "照片包含拍摄位置信息，已去除",
+			Score:       1,
+		})
+	}
+
+	return result, nil
+}
+
+// sample 按最长边等比缩小，返回缩小后的尺寸和取样函数
+func sample(img image.Image, maxSize int) (w, h int, at func(x, y int) color.Color) {
+	bounds := img.Bounds()
+	scale := math.Max(float64(bounds.Dx()), float64(bounds.Dy())) / float64(maxSize)
+	if scale < 1 {
+		scale = 1
+	}
+	w = int(float64(bounds.Dx()) / scale)
+	h = int(float64(bounds.Dy()) / scale)
+	return w, h, func(x, y int) color.Color {
+		return img.At(bounds.Min.X+int(float64(x)*scale), bounds.Min.Y+int(float64(y)*scale))
+	}
+}
+
+// isSkin 按YCbCr色彩空间的经典肤色范围判断
+func isSkin(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	y, cb, cr := color.RGBToYCbCr(uint8(r>>8), uint8(g>>8), uint8(b>>8))
+	return y > 60 && cb >= 77 && cb <= 127 && cr >= 133 && cr <= 173
+}
+
+// countFaces 统计疑似人脸：大小、长宽比和填充率接近椭圆，且内部有眼睛、嘴巴形成的非肤色空洞的肤色区域
+func countFaces(img image.Image) int {
+	w, h, at := sample(img, faceSampleSize)
+	if w == 0 || h == 0 {
+		return 0
+	}
+
+	skin := make([]bool, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			skin[y*w+x] = isSkin(at(x, y))
+		}
+	}
+
+	minArea := w * h / 100
+	visited := make([]bool, w*h)
+	faces := 0
+	for start := range skin {
+		if !skin[start] || visited[start] {
+			continue
+		}
+
+		// 广度优先遍历连通的肤色区域
+		area, minX, minY, maxX, maxY := 0, w, h, 0, 0
+		queue := []int{start}
+		visited[start] = true
+		for len(queue) > 0 {
+			p := queue[0]
+			queue = queue[1:]
+			x, y := p%w, p/w
+			area++
+			minX, maxX = min(minX, x), max(maxX, x)
+			minY, maxY = min(minY, y), max(maxY, y)
+			for _, n := range [4][2]int{{x - 1, y}, {x + 1, y}, {x, y - 1}, {x, y + 1}} {
+				if n[0] < 0 || n[0] >= w || n[1] < 0 || n[1] >= h {
+					continue
+				}
+				q := n[1]*w + n[0]
+				if skin[q] && !visited[q] {
+					visited[q] = true
+					queue = append(queue, q)
+				}
+			}
+		}
+
+		if area < minArea {
+			continue
+		}
+		bw, bh := maxX-minX+1, maxY-minY+1
+		aspect := float64(bh) / float64(bw)
+		fill := float64(area) / float64(bw*bh)
+		if aspect < 0.8 || aspect > 2.0 || fill < 0.45 || fill > 0.9 {
+			continue
+		}
+		if hasFeatureHoles(skin, w, minX, minY, maxX, maxY) {
+			faces++
+		}
+	}
+	return faces
+}
+
+// hasFeatureHoles 检查区域上半部分中间是否有眼睛形成的非肤色空洞
+func hasFeatureHoles(skin []bool, w, minX, minY, maxX, maxY int) bool {
+	bw, bh := maxX-minX+1, maxY-minY+1
+	x0, x1 := minX+bw/5, maxX-bw/5
+	y0, y1 := minY+bh/5, minY+bh/2
+	holes, total := 0, 0
+	for y := y0; y <= y1; y++ {
+		for x := x0; x <= x1; x++ {
+			total++
+			if !skin[y*w+x] {
+				holes++
+			}
+		}
+	}
+	if total == 0 {
+		return false
+	}
+	ratio := float64(holes) / float64(total)
+	return ratio > 0.03 && ratio < 0.4
+}
+
+// textRatio 估算文字区域占比：边缘密集且明暗两极分化（深色笔画、浅色背景）的网格视为文字
+func textRatio(img image.Image) float64 {
+	w, h, at := sample(img, textSampleSize)
+	if w < textCellSize || h < textCellSize {
+		return 0
+	}
+
+	gray := make([]uint8, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			gray[y*w+x] = color.GrayModel.Convert(at(x, y)).(color.Gray).Y
+		}
+	}
+
+	cells, textCells := 0, 0
+	for cy := 0; cy+textCellSize <= h; cy += textCellSize {
+		for cx := 0; cx+textCellSize <= w; cx += textCellSize {
+			cells++
+			if isTextCell(gray, w, cx, cy) {
+				textCells++
+			}
+		}
+	}
+	if cells == 0 {
+		return 0
+	}
+	return float64(textCells) / float64(cells)
+}
+
+func isTextCell(gray []uint8, w, cx, cy int) bool {
+	lo, hi := uint8(255), uint8(0)
+	for y := cy; y < cy+textCellSize; y++ {
+		for x := cx; x < cx+textCellSize; x++ {
+			v := gray[y*w+x]
+			lo, hi = min(lo, v), max(hi, v)
+		}
+	}
+	contrast := int(hi) - int(lo)
+	if contrast < 100 {
+		return false
+	}
+
+	edges, polar := 0, 0
+	band := contrast / 4
+	for y := cy; y < cy+textCellSize; y++ {
+		for x := cx; x < cx+textCellSize; x++ {
+			v := int(gray[y*w+x])
+			if v-int(lo) <= band || int(hi)-v <= band {
+				polar++
+			}
+			if x+1 < cx+textCellSize && abs(v-int(gray[y*w+x+1])) > contrast/2 {
+				edges++
+			}
+		}
+	}
+
+	n := float64(textCellSize * textCellSize)
+	edgeRatio := float64(edges) / n
+	return edgeRatio > 0.08 && edgeRatio < 0.5 && float64(polar)/n > 0.8
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
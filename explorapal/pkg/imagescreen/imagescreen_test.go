@@ -0,0 +1,159 @@
+package imagescreen
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+var (
+	sky  = color.RGBA{40, 90, 200, 255}
+	skin = color.RGBA{224, 172, 150, 255}
+)
+
+func TestScreen(t *testing.T) {
+	tests := []struct {
+		name      string
+		img       image.Image
+		opts      Options
+		faces     int
+		text      bool
+		passed    bool
+		findings  []string
+		dimension [2]int
+	}{
+		{"plain sky", fill(200, 100, sky), Options{}, 0, false, true, nil, [2]int{200, 100}},
+		{"face", face(true), Options{}, 1, false, false, []string{FindingFace}, [2]int{160, 160}},
+		{"face allowed", face(true), Options{MaxFaces: 1}, 1, false, true, nil, [2]int{160, 160}},
+		{"skin without eyes is not a face", face(false), Options{}, 0, false, true, nil, [2]int{160, 160}},
+		{"text", stripes(320, 320), Options{}, 0, true, false, []string{FindingText}, [2]int{320, 320}},
+		{"text below custom threshold", stripes(320, 320), Options{MaxTextRatio: 1}, 0, true, true, nil, [2]int{320, 320}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Screen(encodePNG(t, tt.img), tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Format != "png" || got.Width != tt.dimension[0] || got.Height != tt.dimension[1] {
+				t.Errorf("image = %s %dx%d, want png %dx%d", got.Format, got.Width, got.Height, tt.dimension[0], tt.dimension[1])
+			}
+			if got.FaceCount != tt.faces {
+				t.Errorf("FaceCount = %d, want %d", got.FaceCount, tt.faces)
+			}
+			if (got.TextRatio > 0.5) != tt.text {
+				t.Errorf("TextRatio = %v, want text %v", got.TextRatio, tt.text)
+			}
+			if got.Passed() != tt.passed {
+				t.Errorf("Passed = %v, want %v (%+v)", got.Passed(), tt.passed, got.Findings)
+			}
+			if len(got.Findings) != len(tt.findings) {
+				t.Fatalf("Findings = %+v, want %v", got.Findings, tt.findings)
+			}
+			for i, finding := range tt.findings {
+				if got.Findings[i].Type != finding {
+					t.Errorf("Findings[%d].Type = %s, want %s", i, got.Findings[i].Type, finding)
+				}
+			}
+		})
+	}
+}
+
+func TestScreenRejects(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		opts     Options
+		tooLarge bool
+	}{
+		{"not an image", []byte("not an image"), Options{}, false},
+		{"over custom pixel limit", encodePNG(t, fill(200, 100, sky)), Options{MaxPixels: 19999}, true},
+		{"huge dimensions in header", pngWithSize(t, 100000, 100000), Options{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Screen(tt.data, tt.opts)
+			if err == nil {
+				t.Fatalf("Screen = %+v, want error", got)
+			}
+			if errors.Is(err, ErrTooLarge) != tt.tooLarge {
+				t.Fatalf("Screen error = %v, want ErrTooLarge %v", err, tt.tooLarge)
+			}
+		})
+	}
+
+	if _, err := Screen(encodePNG(t, fill(200, 100, sky)), Options{MaxPixels: 20000}); err != nil {
+		t.Fatalf("Screen at the pixel limit = %v", err)
+	}
+}
+
+func fill(w, h int, c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+// face 天空背景上的肤色椭圆，eyes为true时画上两只深色的眼睛
+func face(eyes bool) *image.RGBA {
+	img := fill(160, 160, sky)
+	const cx, cy, rx, ry = 80, 80, 35, 50
+	for y := 0; y < 160; y++ {
+		for x := 0; x < 160; x++ {
+			dx, dy := float64(x-cx)/rx, float64(y-cy)/ry
+			if dx*dx+dy*dy <= 1 {
+				img.Set(x, y, skin)
+			}
+		}
+	}
+	if eyes {
+		for y := 58; y <= 66; y++ {
+			for x := 63; x <= 73; x++ {
+				img.Set(x, y, color.Black)
+				img.Set(x+24, y, color.Black)
+			}
+		}
+	}
+	return img
+}
+
+// stripes 黑白相间的竖条纹，边缘密集且明暗两极分化，接近印刷文字
+func stripes(w, h int) *image.RGBA {
+	img := fill(w, h, color.White)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x/3%2 == 0 {
+				img.Set(x, y, color.Black)
+			}
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// pngWithSize 把一张1x1的PNG的IHDR改成指定尺寸，模拟压缩得很小的超大图片
+func pngWithSize(t *testing.T, w, h uint32) []byte {
+	t.Helper()
+	data := encodePNG(t, fill(1, 1, sky))
+	// 8字节签名之后是IHDR：长度(4) 类型(4) 宽(4) 高(4) ... CRC(4)
+	binary.BigEndian.PutUint32(data[16:20], w)
+	binary.BigEndian.PutUint32(data[20:24], h)
+	binary.BigEndian.PutUint32(data[29:33], crc32.ChecksumIEEE(data[12:29]))
+	return data
+}
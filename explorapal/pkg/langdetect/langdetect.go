@@ -0,0 +1,104 @@
+package langdetect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 支持的语言代码（与语音服务的语言代码保持一致）
+const (
+	LanguageChinese = "zh-CN"
+	LanguageEnglish = "en-US"
+	LanguageUnknown = "und"
+)
+
+// MixedThreshold 次要语言占比达到该阈值时视为中英混合表达
+const MixedThreshold = 0.15
+
+// Result 语言检测结果
+type Result struct {
+	Language   string             `json:"language"`   // 主要语言
+	Mixed      bool               `json:"mixed"`      // 是否中英混合
+	Confidence float64            `json:"confidence"` // 主要语言占比(0-1)
+	Ratios     map[string]float64 `json:"ratios"`     // 各语言占比
+}
+
+// Detect 检测文本的主要语言
+// 以汉字（每字计一个单位）和英文单词（每词计一个单位）为统计单位，
+// 数字、标点和其他符号不参与统计。
+func Detect(text string) *Result {
+	var chinese, english int
+	inWord := false
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			chinese++
+			inWord = false
+		case r < unicode.MaxASCII && unicode.IsLetter(r):
+			if !inWord {
+				english++
+				inWord = true
+			}
+		case r == '\'' && inWord:
+			// 保持 don't、it's 之类的缩写为同一个单词
+		default:
+			inWord = false
+		}
+	}
+
+	total := chinese + english
+	if total == 0 {
+		return &Result{
+			Language: LanguageUnknown,
+			Ratios:   map[string]float64{},
+		}
+	}
+
+	ratios := map[string]float64{
+		LanguageChinese: float64(chinese) / float64(total),
+		LanguageEnglish: float64(english) / float64(total),
+	}
+
+	result := &Result{
+		Language:   LanguageChinese,
+		Confidence: ratios[LanguageChinese],
+		Ratios:     ratios,
+	}
+	if english > chinese {
+		result.Language = LanguageEnglish
+		result.Confidence = ratios[LanguageEnglish]
+	}
+	result.Mixed = 1-result.Confidence >= MixedThreshold
+
+	return result
+}
+
+// Normalize 将客户端传入的语言代码规范化为支持的语言代码
+// 无法识别或要求自动检测时返回空字符串
+func Normalize(language string) string {
+	switch strings.ToLower(strings.TrimSpace(language)) {
+	case "zh", "zh-cn", "zh_cn", "zh-hans", "cmn", "chinese":
+		return LanguageChinese
+	case "en", "en-us", "en_us", "en-gb", "english":
+		return LanguageEnglish
+	default:
+		return ""
+	}
+}
+
+// IsSupported 判断语言代码是否受支持
+func IsSupported(language string) bool {
+	return Normalize(language) != ""
+}
+
+// DisplayName 语言的中文名称，用于提示词和家长端展示
+func DisplayName(language string) string {
+	switch Normalize(language) {
+	case LanguageChinese:
+		return "中文"
+	case LanguageEnglish:
+		return "英文"
+	default:
+		return "未知语言"
+	}
+}
@@ -0,0 +1,76 @@
+package langdetect
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		language   string
+		mixed      bool
+		confidence float64
+	}{
+		{"chinese", "我看到了一只蝴蝶", LanguageChinese, false, 1},
+		{"english", "I saw a butterfly", LanguageEnglish, false, 1},
+		{"contraction is one word", "don't stop", LanguageEnglish, false, 1},
+		{"minor english below threshold", "我看到了一只butterfly", LanguageChinese, false, 6.0 / 7},
+		{"mixed", "我喜欢 butterfly 和 ladybug", LanguageChinese, true, 4.0 / 6},
+		{"mostly english", "The 蝴蝶 is flying over the flowers", LanguageEnglish, true, 6.0 / 8},
+		{"tie prefers chinese", "你好 hi there", LanguageChinese, true, 0.5},
+		{"digits and punctuation only", "123 !!! ...", LanguageUnknown, false, 0},
+		{"empty", "", LanguageUnknown, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Detect(tt.text)
+			if got.Language != tt.language || got.Mixed != tt.mixed || math.Abs(got.Confidence-tt.confidence) > 1e-9 {
+				t.Fatalf("Detect(%q) = %+v, want language %s mixed %v confidence %v", tt.text, got, tt.language, tt.mixed, tt.confidence)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		language string
+		want     string
+	}{
+		{"zh", LanguageChinese},
+		{" ZH-CN ", LanguageChinese},
+		{"zh_cn", LanguageChinese},
+		{"cmn", LanguageChinese},
+		{"en", LanguageEnglish},
+		{"en-GB", LanguageEnglish},
+		{"English", LanguageEnglish},
+		{"auto", ""},
+		{"fr", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.language); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.language, got, tt.want)
+		}
+		if got := IsSupported(tt.language); got != (tt.want != "") {
+			t.Errorf("IsSupported(%q) = %v", tt.language, got)
+		}
+	}
+}
+
+func TestDisplayName(t *testing.T) {
+	tests := []struct {
+		language string
+		want     string
+	}{
+		{LanguageChinese, "中文"},
+		{"en", "英文"},
+		{LanguageUnknown, "未知语言"},
+	}
+	for _, tt := range tests {
+		if got := DisplayName(tt.language); got != tt.want {
+			t.Errorf("DisplayName(%q) = %q, want %q", tt.language, got, tt.want)
+		}
+	}
+}
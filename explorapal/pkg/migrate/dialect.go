@@ -0,0 +1,94 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 迁移记录表
+const migrationsTable = "schema_migrations"
+
+// 迁移锁的名称
+const lockName = "explorapal_schema_migrations"
+
+var ErrLockTimeout = errors.New("等待迁移锁超时，可能有其他实例正在执行迁移")
+
+// Dialect 不同数据库的差异：迁移记录表的建表语句和迁移锁
+type Dialect interface {
+	// CreateTable 迁移记录表的建表语句
+	CreateTable() string
+	// TableExists 迁移记录表是否已存在
+	TableExists(ctx context.Context, conn *sql.Conn) (bool, error)
+	// Lock 获取迁移锁，锁与连接绑定
+	Lock(ctx context.Context, conn *sql.Conn, timeout time.Duration) error
+	// Unlock 释放迁移锁
+	Unlock(ctx context.Context, conn *sql.Conn) error
+}
+
+// MySQL 使用GET_LOCK作为迁移锁，多个实例同时启动时只有一个执行迁移
+type MySQL struct{}
+
+func (MySQL) CreateTable() string {
+	return "CREATE TABLE IF NOT EXISTS `" + migrationsTable + "` (\n" +
+		"  `version` bigint(20) NOT NULL COMMENT '迁移版本',\n" +
+		"  `name` varchar(255) NOT NULL COMMENT '迁移名称',\n" +
+		"  `checksum` char(64) NOT NULL COMMENT 'up文件的SHA-256',\n" +
+		"  `dirty` tinyint(1) NOT NULL DEFAULT '0' COMMENT '执行中或执行失败',\n" +
+		"  `applied_time` datetime NOT NULL COMMENT '执行时间',\n" +
+		"  PRIMARY KEY (`version`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='数据库迁移记录表'"
+}
+
+func (MySQL) TableExists(ctx context.Context, conn *sql.Conn) (bool, error) {
+	var count int64
+	err := conn.QueryRowContext(ctx, "select count(*) from information_schema.tables where table_schema = database() and table_name = ?",
+		migrationsTable).Scan(&count)
+	return count > 0, err
+}
+
+func (MySQL) Lock(ctx context.Context, conn *sql.Conn, timeout time.Duration) error {
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "select get_lock(?, ?)", lockName, int64(timeout.Seconds())).Scan(&locked); err != nil {
+		return fmt.Errorf("获取迁移锁失败: %w", err)
+	}
+	if locked.Int64 != 1 {
+		return ErrLockTimeout
+	}
+	return nil
+}
+
+func (MySQL) Unlock(ctx context.Context, conn *sql.Conn) error {
+	var released sql.NullInt64
+	return conn.QueryRowContext(ctx, "select release_lock(?)", lockName).Scan(&released)
+}
+
+// SQLite 用于本地和单元测试，数据库文件只有一个写入方，不需要迁移锁
+type SQLite struct{}
+
+func (SQLite) CreateTable() string {
+	return "CREATE TABLE IF NOT EXISTS " + migrationsTable + " (\n" +
+		"  version INTEGER NOT NULL PRIMARY KEY,\n" +
+		"  name TEXT NOT NULL,\n" +
+		"  checksum TEXT NOT NULL,\n" +
+		"  dirty INTEGER NOT NULL DEFAULT 0,\n" +
+		"  applied_time DATETIME NOT NULL\n" +
+		")"
+}
+
+func (SQLite) TableExists(ctx context.Context, conn *sql.Conn) (bool, error) {
+	var count int64
+	err := conn.QueryRowContext(ctx, "select count(*) from sqlite_master where type = 'table' and name = ?",
+		migrationsTable).Scan(&count)
+	return count > 0, err
+}
+
+func (SQLite) Lock(ctx context.Context, conn *sql.Conn, timeout time.Duration) error {
+	return nil
+}
+
+func (SQLite) Unlock(ctx context.Context, conn *sql.Conn) error {
+	return nil
+}
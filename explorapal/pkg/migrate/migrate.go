@@ -0,0 +1,144 @@
+// Package migrate 数据库迁移
+// 迁移文件为<版本>_<名称>.up.sql和<版本>_<名称>.down.sql，版本为14位数字（日期加当天序号）。
+// 已执行的迁移记录在schema_migrations表中，包含up文件的校验和；执行期间记录标记为dirty，
+// 执行失败时保持dirty，需要人工修复后用Force指定版本
+package migrate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNoChange    = errors.New("没有需要执行的迁移")
+	ErrInvalidName = errors.New("迁移名称只能包含字母、数字和下划线")
+)
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+var namePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Migration 一个版本的迁移
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string // up文件内容的SHA-256
+}
+
+// File 迁移的文件名
+func (m *Migration) File(direction string) string {
+	return fmt.Sprintf("%d_%s.%s.sql", m.Version, m.Name, direction)
+}
+
+// Load 读取目录中的迁移文件，按版本从小到大排列；每个版本必须同时有up和down文件
+func Load(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("读取迁移目录失败: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("迁移版本不正确: %s", entry.Name())
+		}
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("读取迁移文件失败: %w", err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("版本%d有多个迁移: %s, %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(data)
+			m.Checksum = checksum(data)
+		} else {
+			m.Down = string(data)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Checksum == "" {
+			return nil, fmt.Errorf("缺少迁移文件: %s", m.File("up"))
+		}
+		if m.Down == "" {
+			return nil, fmt.Errorf("缺少迁移文件: %s", m.File("down"))
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Create 在目录中创建新的迁移文件，返回创建的文件路径
+// 版本为当天日期加序号，当天已有迁移时在最新版本上加一
+func Create(dir, name string, migrations []*Migration, now time.Time) ([]string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !namePattern.MatchString(name) {
+		return nil, ErrInvalidName
+	}
+
+	version := nextVersion(migrations, now)
+	var paths []string
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%d_%s.%s.sql", version, name, direction))
+		content := fmt.Sprintf("-- %s\n", strings.ReplaceAll(name, "_", " "))
+		// 文件已存在时不覆盖
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("创建迁移文件失败: %w", err)
+		}
+		_, err = f.WriteString(content)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, fmt.Errorf("写入迁移文件失败: %w", err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func nextVersion(migrations []*Migration, now time.Time) int64 {
+	today, _ := strconv.ParseInt(now.Format("20060102"), 10, 64)
+	version := today*1000000 + 1
+	if n := len(migrations); n > 0 && migrations[n-1].Version >= version {
+		version = migrations[n-1].Version + 1
+	}
+	return version
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,349 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+const defaultLockTimeout = 30 * time.Second
+
+var ErrUnknownVersion = errors.New("迁移版本不存在")
+
+// DirtyError 上次迁移执行失败，需要修复数据库后用Force指定版本
+type DirtyError struct {
+	Version int64
+}
+
+func (e *DirtyError) Error() string {
+	return fmt.Sprintf("迁移%d上次执行失败，数据库处于dirty状态，修复后使用force指定版本", e.Version)
+}
+
+// ChecksumError 已执行的迁移文件被修改过
+type ChecksumError struct {
+	Version int64
+	Name    string
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("迁移%d_%s执行后文件被修改过，请新建迁移而不是修改已执行的迁移", e.Version, e.Name)
+}
+
+// Options 执行选项
+type Options struct {
+	DryRun      bool          // 只输出将要执行的语句，不修改数据库
+	LockTimeout time.Duration // 等待迁移锁的时间，默认30秒
+	Out         io.Writer     // 执行过程和dry-run语句的输出
+}
+
+// Applied 已执行的迁移记录
+type Applied struct {
+	Version     int64
+	Name        string
+	Checksum    string
+	Dirty       bool
+	AppliedTime time.Time
+}
+
+// Status 迁移的执行状态
+type Status struct {
+	Version     int64
+	Name        string
+	Applied     bool
+	Dirty       bool
+	Modified    bool // 执行后文件被修改过
+	Missing     bool // 已执行但找不到迁移文件
+	AppliedTime time.Time
+}
+
+// Runner 迁移执行器
+type Runner struct {
+	db         *sql.DB
+	dialect    Dialect
+	migrations []*Migration
+	opts       Options
+}
+
+// NewRunner 创建迁移执行器，migrations需按版本从小到大排列
+func NewRunner(db *sql.DB, dialect Dialect, migrations []*Migration, opts Options) *Runner {
+	if opts.LockTimeout <= 0 {
+		opts.LockTimeout = defaultLockTimeout
+	}
+	if opts.Out == nil {
+		opts.Out = io.Discard
+	}
+	return &Runner{
+		db:         db,
+		dialect:    dialect,
+		migrations: migrations,
+		opts:       opts,
+	}
+}
+
+// Up 按版本顺序执行未执行的迁移，n为0时执行全部
+func (r *Runner) Up(ctx context.Context, n int) error {
+	return r.withConn(ctx, true, func(conn *sql.Conn, applied map[int64]*Applied) error {
+		if err := r.verify(applied); err != nil {
+			return err
+		}
+		var pending []*Migration
+		for _, m := range r.migrations {
+			if _, ok := applied[m.Version]; !ok {
+				pending = append(pending, m)
+			}
+		}
+		if n > 0 && len(pending) > n {
+			pending = pending[:n]
+		}
+		if len(pending) == 0 {
+			return ErrNoChange
+		}
+
+		for _, m := range pending {
+			if err := r.apply(ctx, conn, m, "up"); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Down 按版本从新到旧回滚最近执行的n个迁移
+func (r *Runner) Down(ctx context.Context, n int) error {
+	return r.withConn(ctx, true, func(conn *sql.Conn, applied map[int64]*Applied) error {
+		if err := r.verify(applied); err != nil {
+			return err
+		}
+		var targets []*Migration
+		for i := len(r.migrations) - 1; i >= 0 && len(targets) < n; i-- {
+			if _, ok := applied[r.migrations[i].Version]; ok {
+				targets = append(targets, r.migrations[i])
+			}
+		}
+		if len(targets) == 0 {
+			return ErrNoChange
+		}
+
+		for _, m := range targets {
+			if err := r.apply(ctx, conn, m, "down"); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Force 不执行迁移，直接把数据库标记为已执行到version并清除dirty状态；version为0时清除全部记录
+// 用于迁移失败并手工修复数据库之后
+func (r *Runner) Force(ctx context.Context, version int64) error {
+	if version != 0 && r.find(version) == nil {
+		return ErrUnknownVersion
+	}
+	return r.withConn(ctx, true, func(conn *sql.Conn, applied map[int64]*Applied) error {
+		for v := range applied {
+			if v > version {
+				if err := r.exec(ctx, conn, "delete from "+migrationsTable+" where version = ?", v); err != nil {
+					return err
+				}
+			}
+		}
+		for _, m := range r.migrations {
+			if m.Version > version {
+				break
+			}
+			if err := r.exec(ctx, conn, "delete from "+migrationsTable+" where version = ?", m.Version); err != nil {
+				return err
+			}
+			if err := r.exec(ctx, conn, "insert into "+migrationsTable+" (version, name, checksum, dirty, applied_time) values (?, ?, ?, 0, ?)",
+				m.Version, m.Name, m.Checksum, time.Now()); err != nil {
+				return err
+			}
+		}
+		if r.opts.DryRun {
+			fmt.Fprintf(r.opts.Out, "-- 将把数据库标记为版本%d\n", version)
+		} else {
+			fmt.Fprintf(r.opts.Out, "已将数据库标记为版本%d\n", version)
+		}
+		return nil
+	})
+}
+
+// Status 查询全部迁移的执行状态，按版本从小到大排列；已执行但文件缺失的迁移也会列出
+func (r *Runner) Status(ctx context.Context) ([]*Status, error) {
+	var result []*Status
+	err := r.withConn(ctx, false, func(conn *sql.Conn, applied map[int64]*Applied) error {
+		seen := make(map[int64]bool, len(r.migrations))
+		for _, m := range r.migrations {
+			seen[m.Version] = true
+			s := &Status{Version: m.Version, Name: m.Name}
+			if a, ok := applied[m.Version]; ok {
+				s.Applied = true
+				s.Dirty = a.Dirty
+				s.Modified = a.Checksum != m.Checksum
+				s.AppliedTime = a.AppliedTime
+			}
+			result = append(result, s)
+		}
+		for _, a := range applied {
+			if !seen[a.Version] {
+				result = append(result, &Status{
+					Version:     a.Version,
+					Name:        a.Name,
+					Applied:     true,
+					Dirty:       a.Dirty,
+					Missing:     true,
+					AppliedTime: a.AppliedTime,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+	return result, nil
+}
+
+// withConn 在同一个连接上读取已执行的迁移后执行fn
+// 修改数据库时先获取迁移锁，迁移记录表不存在时创建；dry-run和只读查询不创建
+func (r *Runner) withConn(ctx context.Context, write bool, fn func(conn *sql.Conn, applied map[int64]*Applied) error) error {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("连接数据库失败: %w", err)
+	}
+	defer conn.Close()
+
+	if write {
+		if err := r.dialect.Lock(ctx, conn, r.opts.LockTimeout); err != nil {
+			return err
+		}
+		defer r.dialect.Unlock(context.Background(), conn)
+	}
+
+	applied, err := r.loadApplied(ctx, conn, write && !r.opts.DryRun)
+	if err != nil {
+		return err
+	}
+	return fn(conn, applied)
+}
+
+// loadApplied 读取已执行的迁移，create为true时记录表不存在则创建
+func (r *Runner) loadApplied(ctx context.Context, conn *sql.Conn, create bool) (map[int64]*Applied, error) {
+	applied := make(map[int64]*Applied)
+	exists, err := r.dialect.TableExists(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("查询迁移记录表失败: %w", err)
+	}
+	if !exists {
+		if !create {
+			return applied, nil
+		}
+		if _, err := conn.ExecContext(ctx, r.dialect.CreateTable()); err != nil {
+			return nil, fmt.Errorf("创建迁移记录表失败: %w", err)
+		}
+	}
+
+	rows, err := conn.QueryContext(ctx, "select version, name, checksum, dirty, applied_time from "+migrationsTable)
+	if err != nil {
+		return nil, fmt.Errorf("查询迁移记录失败: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		a := &Applied{}
+		if err := rows.Scan(&a.Version, &a.Name, &a.Checksum, &a.Dirty, &a.AppliedTime); err != nil {
+			return nil, fmt.Errorf("查询迁移记录失败: %w", err)
+		}
+		applied[a.Version] = a
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("查询迁移记录失败: %w", err)
+	}
+	return applied, nil
+}
+
+// verify 有dirty记录或已执行的迁移文件被修改时不允许继续
+func (r *Runner) verify(applied map[int64]*Applied) error {
+	for _, a := range applied {
+		if a.Dirty {
+			return &DirtyError{Version: a.Version}
+		}
+	}
+	for _, m := range r.migrations {
+		if a, ok := applied[m.Version]; ok && a.Checksum != m.Checksum {
+			return &ChecksumError{Version: m.Version, Name: m.Name}
+		}
+	}
+	return nil
+}
+
+// apply 执行一个迁移：先把记录标记为dirty，语句全部执行成功后up清除dirty、down删除记录
+// MySQL的DDL会隐式提交，无法放在事务中回滚，失败时保留dirty记录等待人工处理
+func (r *Runner) apply(ctx context.Context, conn *sql.Conn, m *Migration, direction string) error {
+	script := m.Up
+	if direction == "down" {
+		script = m.Down
+	}
+	statements := splitStatements(script)
+
+	if r.opts.DryRun {
+		fmt.Fprintf(r.opts.Out, "-- %s\n", m.File(direction))
+		for _, stmt := range statements {
+			fmt.Fprintf(r.opts.Out, "%s;\n\n", stmt)
+		}
+		return nil
+	}
+
+	start := time.Now()
+	if direction == "up" {
+		err := r.exec(ctx, conn, "insert into "+migrationsTable+" (version, name, checksum, dirty, applied_time) values (?, ?, ?, 1, ?)",
+			m.Version, m.Name, m.Checksum, start)
+		if err != nil {
+			return err
+		}
+	} else if err := r.exec(ctx, conn, "update "+migrationsTable+" set dirty = 1 where version = ?", m.Version); err != nil {
+		return err
+	}
+
+	for i, stmt := range statements {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("执行%s第%d条语句失败: %w", m.File(direction), i+1, err)
+		}
+	}
+
+	if direction == "up" {
+		if err := r.exec(ctx, conn, "update "+migrationsTable+" set dirty = 0 where version = ?", m.Version); err != nil {
+			return err
+		}
+	} else if err := r.exec(ctx, conn, "delete from "+migrationsTable+" where version = ?", m.Version); err != nil {
+		return err
+	}
+	fmt.Fprintf(r.opts.Out, "已执行 %s (%s)\n", m.File(direction), time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+// exec 修改迁移记录，dry-run时不执行
+func (r *Runner) exec(ctx context.Context, conn *sql.Conn, query string, args ...any) error {
+	if r.opts.DryRun {
+		return nil
+	}
+	if _, err := conn.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("更新迁移记录失败: %w", err)
+	}
+	return nil
+}
+
+func (r *Runner) find(version int64) *Migration {
+	for _, m := range r.migrations {
+		if m.Version == version {
+			return m
+		}
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	_ "modernc.org/sqlite"
+)
+
+var testFiles = fstest.MapFS{
+	"20241201000001_create_plants.up.sql": {Data: []byte(
+		"-- 植物表\nCREATE TABLE plants (id INTEGER PRIMARY KEY, name TEXT NOT NULL);\n" +
+			"INSERT INTO plants (id, name) VALUES (1, '向日葵;太阳花');\n")},
+	"20241201000001_create_plants.down.sql": {Data: []byte("DROP TABLE plants;\n")},
+	"20241201000002_add_plant_color.up.sql": {Data: []byte(
+		"ALTER TABLE plants ADD COLUMN color TEXT NOT NULL DEFAULT '';\n")},
+	"20241201000002_add_plant_color.down.sql": {Data: []byte("ALTER TABLE plants DROP COLUMN color;\n")},
+}
+
+func TestRunnerUp(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+
+	if err := newTestRunner(t, db, testFiles, Options{}).Up(ctx, 0); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+
+	var name string
+	if err := db.QueryRow("select name from plants where id = 1").Scan(&name); err != nil {
+		t.Fatalf("query plants: %v", err)
+	}
+	if name != "向日葵;太阳花" {
+		t.Fatalf("name = %q", name)
+	}
+	if _, err := db.Exec("update plants set color = 'yellow'"); err != nil {
+		t.Fatalf("second migration not applied: %v", err)
+	}
+
+	statuses, err := newTestRunner(t, db, testFiles, Options{}).Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if len(statuses) != 2 {
+		t.Fatalf("status count = %d, want 2", len(statuses))
+	}
+	for _, s := range statuses {
+		if !s.Applied || s.Dirty || s.Modified || s.Missing {
+			t.Fatalf("status of %d = %+v", s.Version, s)
+		}
+	}
+}
+
+func TestRunnerUpStep(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	runner := newTestRunner(t, db, testFiles, Options{})
+
+	if err := runner.Up(ctx, 1); err != nil {
+		t.Fatalf("up 1: %v", err)
+	}
+	assertApplied(t, runner, 20241201000001)
+	if err := runner.Up(ctx, 1); err != nil {
+		t.Fatalf("up 1: %v", err)
+	}
+	assertApplied(t, runner, 20241201000001, 20241201000002)
+}
+
+func TestRunnerUpIdempotent(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	runner := newTestRunner(t, db, testFiles, Options{})
+
+	if err := runner.Up(ctx, 0); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	if err := runner.Up(ctx, 0); !errors.Is(err, ErrNoChange) {
+		t.Fatalf("second up: err = %v, want %v", err, ErrNoChange)
+	}
+	var count int
+	if err := db.QueryRow("select count(*) from plants").Scan(&count); err != nil {
+		t.Fatalf("query plants: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("plants count = %d, want 1", count)
+	}
+}
+
+func TestRunnerDown(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	runner := newTestRunner(t, db, testFiles, Options{})
+
+	if err := runner.Up(ctx, 0); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	if err := runner.Down(ctx, 1); err != nil {
+		t.Fatalf("down: %v", err)
+	}
+	assertApplied(t, runner, 20241201000001)
+	if _, err := db.Exec("update plants set color = 'yellow'"); err == nil {
+		t.Fatal("column color still exists after down")
+	}
+}
+
+func TestRunnerChecksumMismatch(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+
+	if err := newTestRunner(t, db, testFiles, Options{}).Up(ctx, 1); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+
+	modified := cloneFS(testFiles)
+	modified["20241201000001_create_plants.up.sql"] = &fstest.MapFile{Data: []byte(
+		"CREATE TABLE plants (id INTEGER PRIMARY KEY, name TEXT NOT NULL, note TEXT);\n")}
+	runner := newTestRunner(t, db, modified, Options{})
+
+	var checksumErr *ChecksumError
+	if err := runner.Up(ctx, 0); !errors.As(err, &checksumErr) {
+		t.Fatalf("up: err = %v, want ChecksumError", err)
+	}
+	if checksumErr.Version != 20241201000001 {
+		t.Fatalf("version = %d, want 20241201000001", checksumErr.Version)
+	}
+	// 校验失败时不执行后续迁移
+	assertApplied(t, runner, 20241201000001)
+
+	statuses, err := runner.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if !statuses[0].Modified {
+		t.Fatalf("status = %+v, want modified", statuses[0])
+	}
+}
+
+func TestRunnerDirty(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+
+	broken := cloneFS(testFiles)
+	broken["20241201000002_add_plant_color.up.sql"] = &fstest.MapFile{Data: []byte(
+		"ALTER TABLE plants ADD COLUMN color TEXT NOT NULL DEFAULT '';\nALTER TABLE missing ADD COLUMN size INTEGER;\n")}
+	runner := newTestRunner(t, db, broken, Options{})
+
+	err := runner.Up(ctx, 0)
+	if err == nil || !strings.Contains(err.Error(), "20241201000002_add_plant_color.up.sql第2条语句") {
+		t.Fatalf("up: err = %v, want failure of the second statement", err)
+	}
+
+	var dirtyErr *DirtyError
+	if err := runner.Up(ctx, 0); !errors.As(err, &dirtyErr) {
+		t.Fatalf("up after failure: err = %v, want DirtyError", err)
+	}
+	if dirtyErr.Version != 20241201000002 {
+		t.Fatalf("dirty version = %d, want 20241201000002", dirtyErr.Version)
+	}
+	if err := runner.Down(ctx, 1); !errors.As(err, &dirtyErr) {
+		t.Fatalf("down after failure: err = %v, want DirtyError", err)
+	}
+
+	// 手工修复后用Force清除dirty状态
+	if err := runner.Force(ctx, 20241201000002); err != nil {
+		t.Fatalf("force: %v", err)
+	}
+	statuses, err := runner.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	for _, s := range statuses {
+		if !s.Applied || s.Dirty {
+			t.Fatalf("status of %d after force = %+v", s.Version, s)
+		}
+	}
+	if err := runner.Force(ctx, 20241201000003); !errors.Is(err, ErrUnknownVersion) {
+		t.Fatalf("force unknown version: err = %v, want %v", err, ErrUnknownVersion)
+	}
+}
+
+func TestRunnerDryRun(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	var out bytes.Buffer
+
+	if err := newTestRunner(t, db, testFiles, Options{DryRun: true, Out: &out}).Up(ctx, 0); err != nil {
+		t.Fatalf("dry-run up: %v", err)
+	}
+
+	for _, want := range []string{
+		"-- 20241201000001_create_plants.up.sql\n",
+		"CREATE TABLE plants (id INTEGER PRIMARY KEY, name TEXT NOT NULL);\n",
+		"INSERT INTO plants (id, name) VALUES (1, '向日葵;太阳花');\n",
+		"-- 20241201000002_add_plant_color.up.sql\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("dry-run output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	// dry-run不创建迁移记录表，也不执行迁移
+	var count int
+	if err := db.QueryRow("select count(*) from sqlite_master where type = 'table'").Scan(&count); err != nil {
+		t.Fatalf("query tables: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("tables after dry-run = %d, want 0", count)
+	}
+}
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "migrate.db"))
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newTestRunner(t *testing.T, db *sql.DB, files fstest.MapFS, opts Options) *Runner {
+	t.Helper()
+	migrations, err := Load(files)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	return NewRunner(db, SQLite{}, migrations, opts)
+}
+
+func assertApplied(t *testing.T, runner *Runner, versions ...int64) {
+	t.Helper()
+	statuses, err := runner.Status(context.Background())
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	var applied []int64
+	for _, s := range statuses {
+		if s.Applied {
+			applied = append(applied, s.Version)
+		}
+	}
+	if len(applied) != len(versions) {
+		t.Fatalf("applied = %v, want %v", applied, versions)
+	}
+	for i := range versions {
+		if applied[i] != versions[i] {
+			t.Fatalf("applied = %v, want %v", applied, versions)
+		}
+	}
+}
+
+func cloneFS(files fstest.MapFS) fstest.MapFS {
+	clone := make(fstest.MapFS, len(files))
+	for name, f := range files {
+		clone[name] = f
+	}
+	return clone
+}
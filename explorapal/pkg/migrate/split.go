@@ -0,0 +1,65 @@
+package migrate
+
+import "strings"
+
+// splitStatements 按分号把迁移文件拆成单条语句，跳过字符串、引用标识符和注释中的分号
+// 数据库驱动默认不允许一次执行多条语句，拆开后逐条执行也便于定位出错的语句
+func splitStatements(script string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+	)
+	flush := func() {
+		if stmt := strings.TrimSpace(current.String()); stmt != "" {
+			statements = append(statements, stmt)
+		}
+		current.Reset()
+	}
+
+	runes := []rune(script)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'' || r == '"' || r == '`':
+			end := quoteEnd(runes, i)
+			current.WriteString(string(runes[i:end]))
+			i = end - 1
+		case (r == '-' && i+1 < len(runes) && runes[i+1] == '-') || r == '#':
+			// 行注释
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			current.WriteRune('\n')
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			// 块注释，结束时i指向'/'
+			for i += 2; i+1 < len(runes) && !(runes[i] == '*' && runes[i+1] == '/'); i++ {
+			}
+			i++
+			current.WriteRune(' ')
+		case r == ';':
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return statements
+}
+
+// quoteEnd 返回引号内容结束后的位置，支持反斜杠转义和连续两个引号的写法
+func quoteEnd(runes []rune, start int) int {
+	quote := runes[start]
+	for i := start + 1; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\' && quote != '`':
+			i++
+		case runes[i] == quote:
+			if i+1 < len(runes) && runes[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(runes)
+}
@@ -0,0 +1,124 @@
+// Package moderation 人工审核队列
+// 安全中心判定为中等风险、自动过滤后仍需确认的内容，以及未通过安全筛查的图片，由大人审核后再展示给孩子
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"explorapal/app/model/hps"
+	"explorapal/pkg/idgen"
+	"explorapal/third/security"
+)
+
+// 待审核内容的来源表
+const (
+	SourceExpressions  = "expressions"
+	SourceAchievements = "achievements"
+	SourceObservations = "observations"
+)
+
+// 待审核内容的来源字段；表达记录的润色结果按字段分别审核，其中标题、摘要和润色文本允许审核人修改
+const (
+	FieldPolishedTitle       = "polished_title"       // 表达记录的润色标题
+	FieldPolishedSummary     = "polished_summary"     // 表达记录的润色摘要
+	FieldPolishedKeyPoints   = "polished_key_points"  // 表达记录的关键要点
+	FieldPolishedConcepts    = "polished_concepts"    // 表达记录的科学概念
+	FieldPolishedQuestions   = "polished_questions"   // 表达记录的延伸问题
+	FieldPolishedConnections = "polished_connections" // 表达记录的关联知识
+	FieldPolishedTranslation = "polished_translation" // 表达记录的平行译文，只能通过或驳回
+	FieldPolishedFormatted   = "polished_formatted"   // 表达记录的润色文本
+	FieldContent             = "content"              // 成果内容
+	FieldImage               = "image"                // 观察图片，只能通过或驳回
+	FieldRecognition         = "recognition"          // 观察图片的识别结果，只能通过或驳回
+)
+
+// WaitingMessage 内容等待审核时给孩子的提示
+const WaitingMessage = "这部分内容正在等大人检查，检查完就能看到啦！"
+
+// Item 提交审核的内容
+type Item struct {
+	ProjectID   int64
+	UserID      int64
+	SourceTable string
+	SourceID    int64 // 来源记录的业务ID
+	SourceField string
+	ContentType string // text, image_url
+	Content     string // 文字内容
+	ContentRef  string // 非文字内容的引用（如隔离区路径），为空时使用文字内容的摘要
+	RiskLevel   string
+	Details     interface{} // 风险详情，序列化为JSON保存
+}
+
+// Queue 审核队列
+type Queue struct {
+	model hps.ReviewItemsModel
+}
+
+// NewQueue 创建审核队列
+func NewQueue(model hps.ReviewItemsModel) *Queue {
+	return &Queue{model: model}
+}
+
+// Submit 提交一条待审核内容，返回审核记录ID
+func (q *Queue) Submit(ctx context.Context, item *Item) (int64, error) {
+	contentRef := item.ContentRef
+	if contentRef == "" && item.Content != "" {
+		contentRef = security.ContentHash(item.Content)
+	}
+	details, err := json.Marshal(item.Details)
+	if err != nil {
+		return 0, fmt.Errorf("序列化风险详情失败: %w", err)
+	}
+
+	reviewID := idgen.Next()
+	_, err = q.model.Insert(ctx, &hps.ReviewItems{
+		ReviewId:    reviewID,
+		ProjectId:   item.ProjectID,
+		UserId:      item.UserID,
+		SourceTable: item.SourceTable,
+		SourceId:    item.SourceID,
+		SourceField: item.SourceField,
+		ContentType: item.ContentType,
+		ContentRef:  sql.NullString{String: contentRef, Valid: contentRef != ""},
+		Content:     sql.NullString{String: item.Content, Valid: item.Content != ""},
+		RiskLevel:   item.RiskLevel,
+		RiskDetails: sql.NullString{String: string(details), Valid: item.Details != nil},
+		Status:      hps.ReviewStatusPending,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("提交人工审核失败: %w", err)
+	}
+	return reviewID, nil
+}
+
+// SubmitTx 与Submit相同，但在事务中写入，审核记录与待审核的来源记录一起提交
+func (q *Queue) SubmitTx(ctx context.Context, tx *hps.Models, item *Item) (int64, error) {
+	return NewQueue(tx.ReviewItems).Submit(ctx, item)
+}
+
+// SummarizeFlagged 汇总需要人工复核的字段，返回最高风险等级和逐字段详情
+func SummarizeFlagged(flagged []security.Flagged) (riskLevel string, details []FlaggedDetail) {
+	for _, f := range flagged {
+		if security.RiskRank(f.Decision.RiskLevel) > security.RiskRank(riskLevel) {
+			riskLevel = f.Decision.RiskLevel
+		}
+		detail := FlaggedDetail{Field: f.Field, Original: f.Original, Filtered: f.Decision.Content, RiskLevel: f.Decision.RiskLevel}
+		if f.Decision.Result != nil {
+			detail.Details = f.Decision.Result.Details
+		}
+		details = append(details, detail)
+	}
+	return riskLevel, details
+}
+
+// FlaggedDetail 单个字段的风险详情
+type FlaggedDetail struct {
+	Field     string                `json:"field"`
+	Original  string                `json:"original"`
+	Filtered  string                `json:"filtered"`
+	RiskLevel string                `json:"risk_level"`
+	Details   []security.RiskDetail `json:"details,omitempty"`
+}
@@ -0,0 +1,182 @@
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"explorapal/app/model/hps"
+	"explorapal/pkg/conceptgraph"
+	"explorapal/pkg/storage"
+)
+
+// Sources 审核结论写回来源记录所需的模型和存储
+type Sources struct {
+	Expressions  hps.ExpressionsModel
+	Achievements hps.AchievementsModel
+	Observations hps.ObservationsModel
+	ReviewItems  hps.ReviewItemsModel // 同一来源记录的其他审核记录
+	Storage      *storage.Storage
+	Concepts     *conceptgraph.Graph // 审核通过的润色结果写入概念图
+}
+
+// Editable 来源字段是否允许审核人修改内容
+func Editable(sourceTable, sourceField string) bool {
+	switch sourceTable {
+	case SourceExpressions:
+		return sourceField == FieldPolishedTitle || sourceField == FieldPolishedSummary || sourceField == FieldPolishedFormatted
+	case SourceAchievements:
+		return sourceField == FieldContent
+	default:
+		return false
+	}
+}
+
+// ApplyTx 与Apply相同，但在事务中写回来源记录和概念图，与审核记录的状态一起提交
+func (s *Sources) ApplyTx(ctx context.Context, tx *hps.Models, item *hps.ReviewItems, approved bool) error {
+	sources := &Sources{
+		Expressions:  tx.Expressions,
+		Achievements: tx.Achievements,
+		Observations: tx.Observations,
+		ReviewItems:  tx.ReviewItems,
+		Storage:      s.Storage,
+		Concepts: conceptgraph.NewGraph(&conceptgraph.Models{
+			Concepts: tx.Concepts,
+			Edges:    tx.ConceptEdges,
+			Mentions: tx.ConceptMentions,
+			Projects: tx.Projects,
+		}),
+	}
+	return sources.Apply(ctx, item, approved)
+}
+
+// Apply 把审核结论写回来源记录：通过时写入审核人修改后的内容并对孩子可见，驳回时保持不可见
+func (s *Sources) Apply(ctx context.Context, item *hps.ReviewItems, approved bool) error {
+	switch item.SourceTable {
+	case SourceExpressions:
+		return s.applyExpression(ctx, item, approved)
+	case SourceAchievements:
+		return s.applyAchievement(ctx, item, approved)
+	case SourceObservations:
+		if item.SourceField == FieldRecognition {
+			return s.applyRecognition(ctx, item, approved)
+		}
+		return s.applyObservation(ctx, item, approved)
+	default:
+		return fmt.Errorf("不支持的审核来源: %s", item.SourceTable)
+	}
+}
+
+// applyExpression 写回润色结果一个字段的审核结论
+// 润色结果按字段分别审核：任何一个字段被驳回时表达记录保持驳回，全部字段通过后才对孩子可见并写入概念图
+func (s *Sources) applyExpression(ctx context.Context, item *hps.ReviewItems, approved bool) error {
+	expression, err := s.Expressions.FindOneByExpressionId(ctx, item.SourceId)
+	if err != nil {
+		return fmt.Errorf("查询表达记录失败: %w", err)
+	}
+
+	if approved && item.EditedContent.Valid {
+		edited := sql.NullString{String: item.EditedContent.String, Valid: item.EditedContent.String != ""}
+		switch item.SourceField {
+		case FieldPolishedTitle:
+			expression.PolishedTitle = edited
+		case FieldPolishedSummary:
+			expression.PolishedSummary = edited
+		case FieldPolishedFormatted:
+			expression.PolishedFormatted = edited
+		}
+	}
+
+	published := false
+	switch {
+	case !approved:
+		expression.ReviewStatus = hps.ReviewStatusRejected
+	case expression.ReviewStatus != hps.ReviewStatusRejected:
+		undecided, err := s.ReviewItems.CountUndecided(ctx, item.SourceTable, item.SourceId)
+		if err != nil {
+			return fmt.Errorf("查询审核记录失败: %w", err)
+		}
+		if undecided == 0 {
+			expression.ReviewStatus = hps.ReviewStatusApproved
+			published = true
+		}
+	}
+	if err := s.Expressions.Update(ctx, expression); err != nil {
+		return fmt.Errorf("写回表达记录失败: %w", err)
+	}
+	if published {
+		return s.Concepts.Add(ctx, conceptgraph.ExpressionRecord(expression))
+	}
+	return nil
+}
+
+func (s *Sources) applyAchievement(ctx context.Context, item *hps.ReviewItems, approved bool) error {
+	achievement, err := s.Achievements.FindOneByAchievementId(ctx, item.SourceId)
+	if err != nil {
+		return fmt.Errorf("查询成果失败: %w", err)
+	}
+
+	achievement.ReviewStatus = reviewStatus(approved)
+	if approved && item.EditedContent.Valid && item.SourceField == FieldContent {
+		achievement.Content = item.EditedContent.String
+	}
+	if err := s.Achievements.Update(ctx, achievement); err != nil {
+		return fmt.Errorf("写回成果失败: %w", err)
+	}
+	return nil
+}
+
+// applyObservation 审核通过的图片从隔离区移回，观察记录恢复为可识别；驳回的图片继续留在隔离区，观察记录标记为已驳回
+func (s *Sources) applyObservation(ctx context.Context, item *hps.ReviewItems, approved bool) error {
+	observation, err := s.Observations.FindOneByObservationId(ctx, item.SourceId)
+	if err != nil {
+		return fmt.Errorf("查询观察记录失败: %w", err)
+	}
+	if observation.Status != hps.ObservationStatusBlocked || !observation.QuarantineKey.Valid {
+		return nil
+	}
+
+	if !approved {
+		observation.Status = hps.ObservationStatusRejected
+		if err := s.Observations.Update(ctx, observation); err != nil {
+			return fmt.Errorf("写回观察记录失败: %w", err)
+		}
+		return nil
+	}
+
+	imageURL, err := s.Storage.Restore(ctx, observation.QuarantineKey.String)
+	if err != nil {
+		return err
+	}
+	observation.ImageUrl = imageURL
+	observation.QuarantineKey = sql.NullString{}
+	observation.Status = hps.ObservationStatusUploaded
+	if err := s.Observations.Update(ctx, observation); err != nil {
+		return fmt.Errorf("写回观察记录失败: %w", err)
+	}
+	return nil
+}
+
+// applyRecognition 写回识别结果的审核结论，审核通过的识别结果写入概念图
+func (s *Sources) applyRecognition(ctx context.Context, item *hps.ReviewItems, approved bool) error {
+	observation, err := s.Observations.FindOneByObservationId(ctx, item.SourceId)
+	if err != nil {
+		return fmt.Errorf("查询观察记录失败: %w", err)
+	}
+
+	observation.ReviewStatus = reviewStatus(approved)
+	if err := s.Observations.Update(ctx, observation); err != nil {
+		return fmt.Errorf("写回观察记录失败: %w", err)
+	}
+	if approved {
+		return s.Concepts.Add(ctx, conceptgraph.ObservationRecord(observation))
+	}
+	return nil
+}
+
+func reviewStatus(approved bool) string {
+	if approved {
+		return hps.ReviewStatusApproved
+	}
+	return hps.ReviewStatusRejected
+}
@@ -0,0 +1,62 @@
+// Package profile AI生成内容时使用的孩子资料
+// 年龄、昵称和语言由服务端按用户ID查询，不使用客户端传入的值
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"explorapal/app/model/hps"
+	"explorapal/pkg/langdetect"
+)
+
+// ErrUserNotFound 用户不存在或已删除
+var ErrUserNotFound = errors.New("用户不存在")
+
+// Profile 孩子资料
+type Profile struct {
+	UserID   int64
+	Nickname string
+	Age      int    // 0表示未填写
+	Language string // 偏好语言，未填写时为中文
+}
+
+// Load 查询孩子资料，已删除的用户视为不存在
+func Load(ctx context.Context, model hps.UsersModel, userID int64) (*Profile, error) {
+	user, err := model.FindOneByUserId(ctx, userID)
+	if err != nil {
+		if err == hps.ErrNotFound {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("查询用户资料失败: %w", err)
+	}
+	if user.DeleteTime.Valid {
+		return nil, ErrUserNotFound
+	}
+
+	language := langdetect.Normalize(user.Language)
+	if language == "" {
+		language = langdetect.LanguageChinese
+	}
+	return &Profile{
+		UserID:   user.UserId,
+		Nickname: user.Nickname.String,
+		Age:      int(user.Age.Int64),
+		Language: language,
+	}, nil
+}
+
+// PromptContext 提示词中描述孩子的上下文，未填写的资料不出现
+func (p *Profile) PromptContext() string {
+	var parts []string
+	if p.Nickname != "" {
+		parts = append(parts, "孩子昵称："+p.Nickname)
+	}
+	if p.Age > 0 {
+		parts = append(parts, fmt.Sprintf("孩子年龄：%d岁", p.Age))
+	}
+	parts = append(parts, "孩子的偏好语言："+langdetect.DisplayName(p.Language))
+	return strings.Join(parts, "\n")
+}
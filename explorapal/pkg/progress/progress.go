@@ -0,0 +1,271 @@
+// Package progress 项目进度计算
+// 观察、提问、表达记录和成果创建后重新计算项目进度：使用模板的项目按里程碑的完成情况计算，
+// 其他项目按观察 → 提问 → 表达 → 成果四个阶段计算；进度达到100时自动完成项目
+package progress
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"explorapal/app/model/hps"
+	"explorapal/pkg/idgen"
+	"explorapal/pkg/projectstatus"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/mr"
+)
+
+// 探索阶段，与里程碑的阶段一致
+const (
+	StageObserve     = hps.MilestoneStageObserve
+	StageQuestion    = hps.MilestoneStageQuestion
+	StageExpress     = hps.MilestoneStageExpress
+	StageAchievement = hps.MilestoneStageAchievement
+)
+
+// 探索阶段的顺序
+var stages = []string{StageObserve, StageQuestion, StageExpress, StageAchievement}
+
+// 阶段在活动描述中的名称
+var stageNames = map[string]string{
+	StageObserve:     "观察",
+	StageQuestion:    "提问",
+	StageExpress:     "表达",
+	StageAchievement: "成果",
+}
+
+// Models 进度计算用到的数据库模型
+type Models struct {
+	Projects          hps.ProjectsModel
+	Milestones        hps.ProjectMilestonesModel
+	Observations      hps.ObservationsModel
+	Questions         hps.QuestionsModel
+	Expressions       hps.ExpressionsModel
+	Achievements      hps.AchievementsModel
+	ProjectActivities hps.ProjectActivitiesModel
+}
+
+// Engine 项目进度计算
+type Engine struct {
+	models *Models
+	// 事务中的查询在同一个连接上，不能并发执行
+	serial bool
+}
+
+// NewEngine 创建进度计算
+func NewEngine(models *Models) *Engine {
+	return &Engine{
+		models: models,
+	}
+}
+
+// stageCounts 项目各阶段的数量，按阶段和类型分组；类型为空的键是该阶段的总数
+type stageCounts map[string]map[string]int64
+
+func (c stageCounts) get(stage, kind string) int64 {
+	return c[stage][kind]
+}
+
+// Record 项目有新的观察、提问、表达或成果后更新最后活动时间并重新计算进度
+// stage为本次记录所属的阶段，阶段第一次达成时记录项目活动
+func (e *Engine) Record(ctx context.Context, projectID int64, stage string) error {
+	if err := e.models.Projects.UpdateLastActivity(ctx, projectID); err != nil {
+		return fmt.Errorf("更新项目最后活动时间失败: %w", err)
+	}
+
+	project, err := e.models.Projects.FindOneByProjectId(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("查询项目失败: %w", err)
+	}
+	if project.DeleteTime.Valid {
+		return nil
+	}
+
+	counts, err := e.count(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	// 阶段是否第一次达成以项目中保存的已达成阶段为准，记录被删除后再次达成时不重复记录
+	if counts.get(stage, "") > 0 {
+		reached, err := e.models.Projects.MarkStageReached(ctx, project, stage)
+		if err != nil {
+			return fmt.Errorf("更新项目已达成阶段失败: %w", err)
+		}
+		if reached {
+			e.logActivity(ctx, project, hps.ActivityTypeStageReached,
+				fmt.Sprintf("完成了第一次%s", stageNames[stage]), map[string]interface{}{"stage": stage})
+		}
+	}
+
+	milestones, err := e.models.Milestones.FindByProject(ctx, projectID)
+	if err != nil && err != hps.ErrNotFound {
+		return fmt.Errorf("查询项目里程碑失败: %w", err)
+	}
+
+	var progress int64
+	if len(milestones) > 0 {
+		progress, err = e.milestoneProgress(ctx, project, milestones, counts)
+		if err != nil {
+			return err
+		}
+	} else {
+		progress = stageProgress(counts)
+	}
+
+	if progress != project.Progress {
+		if err := e.models.Projects.UpdateProgress(ctx, projectID, int32(progress)); err != nil {
+			return fmt.Errorf("更新项目进度失败: %w", err)
+		}
+	}
+
+	// 只自动完成进行中的项目，暂停的项目由孩子自己决定
+	if progress == 100 && project.Status == projectstatus.Active {
+		updated, err := e.models.Projects.UpdateStatus(ctx, project, projectstatus.Completed)
+		if err != nil {
+			return fmt.Errorf("完成项目失败: %w", err)
+		}
+		if updated {
+			e.logActivity(ctx, project, hps.ActivityTypeCompleteProject,
+				fmt.Sprintf("完成了项目：%s", project.Title), map[string]interface{}{
+					"old_status": project.Status,
+					"new_status": projectstatus.Completed,
+				})
+		}
+	}
+	return nil
+}
+
+// RecordTx 与Record相同，但在事务中执行，进度、最后活动时间和阶段活动与触发它们的记录一起提交
+func (e *Engine) RecordTx(ctx context.Context, tx *hps.Models, projectID int64, stage string) error {
+	engine := &Engine{
+		models: &Models{
+			Projects:          tx.Projects,
+			Milestones:        tx.ProjectMilestones,
+			Observations:      tx.Observations,
+			Questions:         tx.Questions,
+			Expressions:       tx.Expressions,
+			Achievements:      tx.Achievements,
+			ProjectActivities: tx.ProjectActivities,
+		},
+		serial: true,
+	}
+	return engine.Record(ctx, projectID, stage)
+}
+
+// count 统计项目各阶段的数量：识别过的观察、得到回答的问题、表达记录和成果
+func (e *Engine) count(ctx context.Context, projectID int64) (stageCounts, error) {
+	counts := stageCounts{}
+	var observations int64
+	var questions, expressions, achievements []*hps.GroupCount
+
+	fns := []func() error{func() error {
+		groups, err := e.models.Observations.CountByProjects(ctx, []int64{projectID})
+		for _, g := range groups {
+			observations += g.Total
+		}
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		questions, err = e.models.Questions.CountByProjectType(ctx, projectID)
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		expressions, err = e.models.Expressions.CountByProjectType(ctx, projectID)
+		return ignoreNotFound(err)
+	}, func() (err error) {
+		achievements, err = e.models.Achievements.CountByProjectType(ctx, projectID)
+		return ignoreNotFound(err)
+	}}
+	if err := e.run(fns); err != nil {
+		return nil, fmt.Errorf("统计项目记录失败: %w", err)
+	}
+
+	counts[StageObserve] = map[string]int64{"": observations}
+	for stage, groups := range map[string][]*hps.GroupCount{
+		StageQuestion:    questions,
+		StageExpress:     expressions,
+		StageAchievement: achievements,
+	} {
+		counts[stage] = map[string]int64{}
+		for _, g := range groups {
+			counts[stage][g.Name] = g.Total
+			counts[stage][""] += g.Total
+		}
+	}
+	return counts, nil
+}
+
+// milestoneProgress 按里程碑计算进度，每个里程碑按完成数量占目标数量的比例计入；新完成的里程碑记录完成时间和项目活动
+func (e *Engine) milestoneProgress(ctx context.Context, project *hps.Projects, milestones []*hps.ProjectMilestones, counts stageCounts) (int64, error) {
+	var sum float64
+	for _, m := range milestones {
+		target := max(m.Target, 1)
+		done := min(counts.get(m.Stage, m.Kind.String), target)
+		sum += float64(done) / float64(target)
+
+		if done < target || m.CompletedTime.Valid {
+			continue
+		}
+		completed, err := e.models.Milestones.MarkCompleted(ctx, m, time.Now())
+		if err != nil {
+			return 0, fmt.Errorf("更新里程碑失败: %w", err)
+		}
+		if completed {
+			e.logActivity(ctx, project, hps.ActivityTypeMilestoneCompleted,
+				fmt.Sprintf("完成了里程碑：%s", m.Title), map[string]interface{}{
+					"milestone_id": m.MilestoneId,
+					"seq":          m.Seq,
+					"stage":        m.Stage,
+				})
+		}
+	}
+	return int64(sum / float64(len(milestones)) * 100), nil
+}
+
+// stageProgress 按四个阶段计算进度，每达成一个阶段计25
+func stageProgress(counts stageCounts) int64 {
+	var reached int64
+	for _, stage := range stages {
+		if counts.get(stage, "") > 0 {
+			reached++
+		}
+	}
+	return reached * 100 / int64(len(stages))
+}
+
+// logActivity 记录进度相关的项目活动，失败时只记录错误
+func (e *Engine) logActivity(ctx context.Context, project *hps.Projects, activityType, description string, metadata map[string]interface{}) {
+	data, _ := json.Marshal(metadata)
+	activity := &hps.ProjectActivities{
+		ActivityId:  idgen.Next(),
+		ProjectId:   project.ProjectId,
+		UserId:      project.UserId,
+		Type:        activityType,
+		Description: description,
+		Metadata:    sql.NullString{String: string(data), Valid: true},
+	}
+	if _, err := e.models.ProjectActivities.Insert(ctx, activity); err != nil {
+		logx.WithContext(ctx).Errorf("记录项目活动失败: %v", err)
+	}
+}
+
+// run 执行统计查询，事务中依次执行，否则并发执行
+func (e *Engine) run(fns []func() error) error {
+	if !e.serial {
+		return mr.Finish(fns...)
+	}
+	for _, fn := range fns {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ignoreNotFound(err error) error {
+	if err == hps.ErrNotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,117 @@
+// Package projectstatus 项目状态机
+// 项目状态只能按允许的动作变更：进行中和暂停之间切换，进行中的项目可以完成，
+// 已完成的项目只能通过重新打开回到进行中，任何未归档的项目都可以归档，归档后可以恢复为进行中
+package projectstatus
+
+import "errors"
+
+// 项目状态
+const (
+	Active    = "active"
+	Paused    = "paused"
+	Completed = "completed"
+	Archived  = "archived"
+)
+
+// 状态变更动作
+const (
+	ActionPause    = "pause"
+	ActionResume   = "resume"
+	ActionComplete = "complete"
+	ActionReopen   = "reopen"
+	ActionArchive  = "archive"
+	ActionRestore  = "restore"
+)
+
+var (
+	ErrInvalidStatus     = errors.New("项目状态不正确")
+	ErrInvalidAction     = errors.New("状态变更动作不正确")
+	ErrUnchanged         = errors.New("项目已经是这个状态了")
+	ErrInvalidTransition = errors.New("当前状态不能变更为这个状态")
+	ErrReopenRequired    = errors.New("已完成的项目需要重新打开才能继续探索")
+)
+
+// Transition 一次允许的状态变更
+type Transition struct {
+	Action string
+	From   string
+	To     string
+	// Name 展示给孩子的动作名称
+	Name string
+}
+
+// 允许的状态变更，同一状态的动作按展示顺序排列
+var transitions = []Transition{
+	{Action: ActionPause, From: Active, To: Paused, Name: "暂停"},
+	{Action: ActionComplete, From: Active, To: Completed, Name: "完成"},
+	{Action: ActionArchive, From: Active, To: Archived, Name: "归档"},
+	{Action: ActionResume, From: Paused, To: Active, Name: "继续"},
+	{Action: ActionArchive, From: Paused, To: Archived, Name: "归档"},
+	{Action: ActionReopen, From: Completed, To: Active, Name: "重新打开"},
+	{Action: ActionArchive, From: Completed, To: Archived, Name: "归档"},
+	{Action: ActionRestore, From: Archived, To: Active, Name: "恢复"},
+}
+
+// Valid 是否为有效的项目状态
+func Valid(status string) bool {
+	switch status {
+	case Active, Paused, Completed, Archived:
+		return true
+	}
+	return false
+}
+
+// Find 查找从当前状态出发的状态变更
+// 指定动作时按动作查找，同时指定的目标状态必须与动作一致；只指定目标状态时，已完成的项目回到进行中必须明确使用重新打开
+func Find(from, to, action string) (Transition, error) {
+	if action != "" {
+		for _, t := range transitions {
+			if t.From == from && t.Action == action {
+				if to != "" && t.To != to {
+					return Transition{}, ErrInvalidTransition
+				}
+				return t, nil
+			}
+		}
+		if !validAction(action) {
+			return Transition{}, ErrInvalidAction
+		}
+		return Transition{}, ErrInvalidTransition
+	}
+
+	if !Valid(to) {
+		return Transition{}, ErrInvalidStatus
+	}
+	if to == from {
+		return Transition{}, ErrUnchanged
+	}
+	for _, t := range transitions {
+		if t.From == from && t.To == to {
+			if t.Action == ActionReopen {
+				return Transition{}, ErrReopenRequired
+			}
+			return t, nil
+		}
+	}
+	return Transition{}, ErrInvalidTransition
+}
+
+// NextActions 当前状态可以执行的动作
+func NextActions(status string) []Transition {
+	var next []Transition
+	for _, t := range transitions {
+		if t.From == status {
+			next = append(next, t)
+		}
+	}
+	return next
+}
+
+func validAction(action string) bool {
+	for _, t := range transitions {
+		if t.Action == action {
+			return true
+		}
+	}
+	return false
+}
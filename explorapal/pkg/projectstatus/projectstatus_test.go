@@ -0,0 +1,80 @@
+package projectstatus
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   string
+		to     string
+		action string
+		want   string // 期望的动作，为空时期望err
+		err    error
+	}{
+		{"pause by action", Active, "", ActionPause, ActionPause, nil},
+		{"pause by status", Active, Paused, "", ActionPause, nil},
+		{"resume", Paused, Active, "", ActionResume, nil},
+		{"complete", Active, Completed, ActionComplete, ActionComplete, nil},
+		{"reopen by action", Completed, "", ActionReopen, ActionReopen, nil},
+		{"reopen by status needs action", Completed, Active, "", "", ErrReopenRequired},
+		{"archive from every status", Paused, Archived, "", ActionArchive, nil},
+		{"archive completed", Completed, "", ActionArchive, ActionArchive, nil},
+		{"restore", Archived, Active, ActionRestore, ActionRestore, nil},
+		{"action and status disagree", Active, Completed, ActionPause, "", ErrInvalidTransition},
+		{"action not allowed from status", Paused, "", ActionComplete, "", ErrInvalidTransition},
+		{"archived cannot be archived again", Archived, "", ActionArchive, "", ErrInvalidTransition},
+		{"archived cannot be paused", Archived, Paused, "", "", ErrInvalidTransition},
+		{"paused cannot be completed", Paused, Completed, "", "", ErrInvalidTransition},
+		{"unknown action", Active, "", "delete", "", ErrInvalidAction},
+		{"unknown status", Active, "deleted", "", "", ErrInvalidStatus},
+		{"no status or action", Active, "", "", "", ErrInvalidStatus},
+		{"unchanged", Paused, Paused, "", "", ErrUnchanged},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Find(tt.from, tt.to, tt.action)
+			if err != tt.err {
+				t.Fatalf("Find(%s, %s, %s) error = %v, want %v", tt.from, tt.to, tt.action, err, tt.err)
+			}
+			if got.Action != tt.want {
+				t.Fatalf("Find(%s, %s, %s) = %+v, want action %s", tt.from, tt.to, tt.action, got, tt.want)
+			}
+			if err == nil && got.From != tt.from {
+				t.Fatalf("Find(%s, %s, %s).From = %s", tt.from, tt.to, tt.action, got.From)
+			}
+		})
+	}
+}
+
+func TestNextActions(t *testing.T) {
+	tests := []struct {
+		status string
+		want   []string
+	}{
+		{Active, []string{ActionPause, ActionComplete, ActionArchive}},
+		{Paused, []string{ActionResume, ActionArchive}},
+		{Completed, []string{ActionReopen, ActionArchive}},
+		{Archived, []string{ActionRestore}},
+		{"unknown", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, next := range NextActions(tt.status) {
+			got = append(got, next.Action)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("NextActions(%s) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestTransitionsAreValid(t *testing.T) {
+	for _, tr := range transitions {
+		if !Valid(tr.From) || !Valid(tr.To) || tr.From == tr.To || tr.Name == "" {
+			t.Errorf("invalid transition %+v", tr)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package safetyaudit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"unicode/utf8"
+
+	"explorapal/app/model/hps"
+	"explorapal/pkg/idgen"
+	"explorapal/third/security"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 审计日志中保存的内容摘录长度(字符)
+const excerptLength = 200
+
+// Auditor 将内容安全检查结论写入safety_audit_logs表
+type Auditor struct {
+	model hps.SafetyAuditLogsModel
+}
+
+// NewAuditor 创建安全审计记录器
+func NewAuditor(model hps.SafetyAuditLogsModel) *Auditor {
+	return &Auditor{model: model}
+}
+
+// Record 写入审计记录，写入失败只记录日志，不影响业务请求
+func (a *Auditor) Record(ctx context.Context, record *security.AuditRecord) {
+	details := sql.NullString{}
+	if len(record.Details) > 0 {
+		if data, err := json.Marshal(record.Details); err == nil {
+			details = sql.NullString{String: string(data), Valid: true}
+		}
+	}
+
+	log := &hps.SafetyAuditLogs{
+		AuditId:        idgen.Next(),
+		UserId:         record.UserID,
+		SessionId:      nullString(record.SessionID),
+		Source:         record.Source,
+		Direction:      record.Direction,
+		Field:          nullString(record.Field),
+		ContentType:    record.ContentType,
+		ContentHash:    security.ContentHash(record.Content),
+		ContentExcerpt: nullString(excerpt(record.Content)),
+		RiskLevel:      record.RiskLevel,
+		Action:         record.Action,
+		Suggestion:     nullString(excerpt(record.Suggestion)),
+		Details:        details,
+		LatencyMs:      record.Latency.Milliseconds(),
+	}
+
+	if _, err := a.model.Insert(ctx, log); err != nil {
+		logx.WithContext(ctx).Errorf("写入安全审计日志失败: %v", err)
+	}
+}
+
+// excerpt 截取内容摘录，避免审计表保存完整的孩子输入
+func excerpt(s string) string {
+	if utf8.RuneCountInString(s) <= excerptLength {
+		return s
+	}
+	return string([]rune(s)[:excerptLength]) + "..."
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
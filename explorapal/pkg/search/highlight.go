@@ -0,0 +1,133 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	// 摘要的长度（字数）
+	snippetLength = 80
+	// 摘要中命中位置之前保留的字数
+	snippetLead = 20
+
+	highlightOpen  = "<em>"
+	highlightClose = "</em>"
+)
+
+// splitTerms 把关键词拆成高亮用的词：按空白拆分，中文词再拆成与ngram索引一致的两字词，
+// 这样只命中部分文字的记录也能高亮
+func splitTerms(keyword string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	add := func(term string) {
+		term = strings.ToLower(term)
+		if term != "" && !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	for _, word := range strings.Fields(keyword) {
+		add(word)
+		runes := []rune(word)
+		if len(runes) <= 2 || !hasHan(runes) {
+			continue
+		}
+		for i := 0; i+2 <= len(runes); i++ {
+			add(string(runes[i : i+2]))
+		}
+	}
+	return terms
+}
+
+func hasHan(runes []rune) bool {
+	for _, r := range runes {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches 文本中命中的区间（按字计算），重叠的区间合并
+func matches(runes []rune, terms []string) [][2]int {
+	lower := []rune(strings.ToLower(string(runes)))
+	// 大小写转换后长度变化时无法对应位置，不做高亮
+	if len(lower) != len(runes) {
+		return nil
+	}
+
+	marked := make([]bool, len(runes))
+	for _, term := range terms {
+		t := []rune(term)
+		for i := 0; i+len(t) <= len(lower); i++ {
+			if string(lower[i:i+len(t)]) == term {
+				for j := i; j < i+len(t); j++ {
+					marked[j] = true
+				}
+			}
+		}
+	}
+
+	var spans [][2]int
+	for i := 0; i < len(marked); i++ {
+		if !marked[i] {
+			continue
+		}
+		start := i
+		for i < len(marked) && marked[i] {
+			i++
+		}
+		spans = append(spans, [2]int{start, i})
+	}
+	return spans
+}
+
+// highlight 转义文本并标记命中的词
+func highlight(text string, terms []string) string {
+	runes := []rune(text)
+	return mark(runes, matches(runes, terms), 0, len(runes))
+}
+
+// snippet 截取第一个命中位置附近的文字作为摘要，转义并标记命中的词
+func snippet(text string, terms []string) string {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	spans := matches(runes, terms)
+
+	start := 0
+	if len(spans) > 0 {
+		start = max(spans[0][0]-snippetLead, 0)
+	}
+	end := min(start+snippetLength, len(runes))
+	// 靠近结尾时向前补足长度
+	start = max(end-snippetLength, 0)
+
+	result := mark(runes, spans, start, end)
+	if start > 0 {
+		result = "…" + result
+	}
+	if end < len(runes) {
+		result += "…"
+	}
+	return result
+}
+
+// mark 输出[start, end)之间的文字，命中的区间用高亮标签包围
+func mark(runes []rune, spans [][2]int, start, end int) string {
+	var b strings.Builder
+	pos := start
+	for _, span := range spans {
+		s, e := max(span[0], start), min(span[1], end)
+		if s >= e {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:s])))
+		b.WriteString(highlightOpen)
+		b.WriteString(html.EscapeString(string(runes[s:e])))
+		b.WriteString(highlightClose)
+		pos = e
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	return b.String()
+}
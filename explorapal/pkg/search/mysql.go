@@ -0,0 +1,86 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"explorapal/app/model/hps"
+
+	"github.com/zeromicro/go-zero/core/mr"
+)
+
+// Models MySQL全文检索用到的数据库模型
+type Models struct {
+	Projects     hps.ProjectsModel
+	Observations hps.ObservationsModel
+	Questions    hps.QuestionsModel
+	Expressions  hps.ExpressionsModel
+}
+
+// MySQLBackend 使用MySQL ngram全文索引检索，索引由数据库维护，保存记录时不需要额外处理
+type MySQLBackend struct {
+	models *Models
+}
+
+// NewMySQLBackend 创建MySQL全文检索后端
+func NewMySQLBackend(models *Models) *MySQLBackend {
+	return &MySQLBackend{
+		models: models,
+	}
+}
+
+// Search 并发检索各类型的记录
+func (b *MySQLBackend) Search(ctx context.Context, q *Query) ([]*Hit, error) {
+	var (
+		mu   sync.Mutex
+		hits []*Hit
+	)
+	fns := make([]func() error, 0, len(q.Types))
+	for _, t := range q.Types {
+		search, ok := b.searchFunc(t)
+		if !ok {
+			return nil, ErrInvalidType
+		}
+		t := t
+		fns = append(fns, func() error {
+			matches, err := search(ctx, q.UserID, q.Keyword, q.Limit)
+			if err != nil && err != hps.ErrNotFound {
+				return fmt.Errorf("检索%s失败: %w", t, err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, m := range matches {
+				hits = append(hits, &Hit{
+					Type:         t,
+					ID:           m.EntityId,
+					ProjectID:    m.ProjectId,
+					ProjectTitle: m.ProjectTitle,
+					Title:        m.Title,
+					Content:      m.Content,
+					CreateTime:   m.CreateTime,
+					Score:        m.Score,
+				})
+			}
+			return nil
+		})
+	}
+	if err := mr.Finish(fns...); err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+func (b *MySQLBackend) searchFunc(t string) (func(ctx context.Context, userID int64, keyword string, limit int64) ([]*hps.SearchMatch, error), bool) {
+	switch t {
+	case TypeProject:
+		return b.models.Projects.Search, true
+	case TypeObservation:
+		return b.models.Observations.Search, true
+	case TypeQuestion:
+		return b.models.Questions.Search, true
+	case TypeExpression:
+		return b.models.Expressions.Search, true
+	}
+	return nil, false
+}
@@ -0,0 +1,198 @@
+// Package search 孩子在自己的项目、观察、问题和表达中搜索
+// 检索由Backend完成，默认使用MySQL的ngram全文索引；需要进程内索引时可以实现Backend接入Bleve等搜索引擎。
+// 命中的记录按类型分组、按相关度排序，并生成带高亮的摘要
+package search
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 搜索的记录类型
+const (
+	TypeProject     = "project"
+	TypeObservation = "observation"
+	TypeQuestion    = "question"
+	TypeExpression  = "expression"
+)
+
+// Types 全部记录类型，按结果分组的默认顺序排列
+var Types = []string{TypeProject, TypeObservation, TypeQuestion, TypeExpression}
+
+const (
+	// DefaultLimit 未指定时每种类型返回的条数
+	DefaultLimit = 10
+	// MaxLimit 每种类型最多返回的条数
+	MaxLimit = 50
+	// MaxKeywordLength 关键词的最大长度
+	MaxKeywordLength = 50
+)
+
+var (
+	ErrEmptyKeyword   = errors.New("请输入要搜索的内容")
+	ErrKeywordTooLong = errors.New("搜索内容不能超过50个字")
+	ErrInvalidType    = errors.New("搜索类型不正确")
+)
+
+// Query 搜索条件
+type Query struct {
+	UserID  int64
+	Keyword string
+	// Types 为空时搜索全部类型
+	Types []string
+	// Limit 每种类型返回的条数
+	Limit int64
+}
+
+// Hit 后端检索命中的记录
+type Hit struct {
+	Type         string
+	ID           int64
+	ProjectID    int64
+	ProjectTitle string
+	Title        string
+	Content      string
+	CreateTime   time.Time
+	Score        float64
+}
+
+// Backend 全文检索后端，只返回属于Query.UserID的记录，每种类型最多Limit条
+type Backend interface {
+	Search(ctx context.Context, q *Query) ([]*Hit, error)
+}
+
+// Result 返回给孩子的搜索结果，Title和Snippet已转义并用<em>标记命中的词
+type Result struct {
+	Type         string
+	ID           int64
+	ProjectID    int64
+	ProjectTitle string
+	Title        string
+	Snippet      string
+	CreateTime   time.Time
+	Score        float64
+}
+
+// Group 同一类型的搜索结果
+type Group struct {
+	Type    string
+	Results []*Result
+}
+
+// Searcher 搜索入口
+type Searcher struct {
+	backend Backend
+}
+
+// NewSearcher 创建搜索入口
+func NewSearcher(backend Backend) *Searcher {
+	return &Searcher{
+		backend: backend,
+	}
+}
+
+// Search 校验搜索条件后检索，结果按类型分组；分组按最高相关度排序，组内按相关度排序
+func (s *Searcher) Search(ctx context.Context, q *Query) ([]*Group, error) {
+	if err := normalize(q); err != nil {
+		return nil, err
+	}
+
+	hits, err := s.backend.Search(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := splitTerms(q.Keyword)
+	groups := make(map[string]*Group, len(q.Types))
+	for _, hit := range hits {
+		group, ok := groups[hit.Type]
+		if !ok {
+			group = &Group{Type: hit.Type}
+			groups[hit.Type] = group
+		}
+		group.Results = append(group.Results, toResult(hit, terms))
+	}
+
+	result := make([]*Group, 0, len(groups))
+	for _, t := range q.Types {
+		group, ok := groups[t]
+		if !ok {
+			continue
+		}
+		sort.SliceStable(group.Results, func(i, j int) bool {
+			return group.Results[i].Score > group.Results[j].Score
+		})
+		if int64(len(group.Results)) > q.Limit {
+			group.Results = group.Results[:q.Limit]
+		}
+		result = append(result, group)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Results[0].Score > result[j].Results[0].Score
+	})
+	return result, nil
+}
+
+// normalize 校验并补全搜索条件
+func normalize(q *Query) error {
+	q.Keyword = strings.TrimSpace(q.Keyword)
+	if q.Keyword == "" {
+		return ErrEmptyKeyword
+	}
+	if utf8.RuneCountInString(q.Keyword) > MaxKeywordLength {
+		return ErrKeywordTooLong
+	}
+
+	if len(q.Types) == 0 {
+		q.Types = Types
+	}
+	seen := make(map[string]bool, len(q.Types))
+	types := make([]string, 0, len(q.Types))
+	for _, t := range q.Types {
+		if !validType(t) {
+			return ErrInvalidType
+		}
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+	q.Types = types
+
+	if q.Limit <= 0 {
+		q.Limit = DefaultLimit
+	}
+	q.Limit = min(q.Limit, MaxLimit)
+	return nil
+}
+
+func validType(t string) bool {
+	for _, v := range Types {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+func toResult(hit *Hit, terms []string) *Result {
+	// 正文为空时用标题生成摘要
+	content := hit.Content
+	if strings.TrimSpace(content) == "" {
+		content = hit.Title
+	}
+	return &Result{
+		Type:         hit.Type,
+		ID:           hit.ID,
+		ProjectID:    hit.ProjectID,
+		ProjectTitle: hit.ProjectTitle,
+		Title:        highlight(hit.Title, terms),
+		Snippet:      snippet(content, terms),
+		CreateTime:   hit.CreateTime,
+		Score:        hit.Score,
+	}
+}
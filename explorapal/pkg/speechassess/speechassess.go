@@ -0,0 +1,278 @@
+package speechassess
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// 单词评估状态
+const (
+	StatusCorrect = "correct" // 读对了
+	StatusClose   = "close"   // 接近（发音有细微差别）
+	StatusMissed  = "missed"  // 漏读或读错
+)
+
+// 语速参考区间（每秒词数，汉字按字计）
+const (
+	SlowPace = 1.0
+	FastPace = 4.5
+)
+
+// 常见的中英文停顿词/填充词
+var fillerWords = map[string]bool{
+	"嗯": true, "呃": true, "额": true, "啊": true, "哦": true,
+	"那个": true, "然后呢": true,
+	"um": true, "uh": true, "er": true, "erm": true, "hmm": true,
+}
+
+// Result 发音与流利度评估结果
+type Result struct {
+	TargetText     string         `json:"target_text"`      // 目标句
+	Accuracy       float64        `json:"accuracy"`         // 单词准确率(0-1)
+	PaceAssessed   bool           `json:"pace_assessed"`    // 是否评估了语速，音频时长未知时为false
+	WordsPerSecond float64        `json:"words_per_second"` // 语速(每秒词数)，仅在PaceAssessed时有意义
+	FillerCount    int            `json:"filler_count"`     // 停顿词数量
+	Fillers        map[string]int `json:"fillers"`          // 各停顿词出现次数
+	Words          []WordResult   `json:"words"`            // 逐词评估
+	Feedback       []string       `json:"feedback"`         // 给孩子的鼓励性反馈
+}
+
+// WordResult 单个词的评估结果
+type WordResult struct {
+	Word   string `json:"word"`            // 目标词
+	Heard  string `json:"heard,omitempty"` // 识别到的词
+	Status string `json:"status"`          // correct, close, missed
+}
+
+// Assess 将识别文本与目标句比对，计算逐词准确率、语速和停顿词
+// duration 为音频时长(秒)，未知时传0
+func Assess(transcript, target string, duration float64) *Result {
+	heard, fillers := splitFillers(tokenize(transcript))
+	expected := tokenize(target)
+
+	result := &Result{
+		TargetText: target,
+		Fillers:    fillers,
+		Words:      align(expected, heard),
+	}
+	for _, n := range fillers {
+		result.FillerCount += n
+	}
+
+	if len(expected) > 0 {
+		var score float64
+		for _, w := range result.Words {
+			switch w.Status {
+			case StatusCorrect:
+				score += 1
+			case StatusClose:
+				score += 0.5
+			}
+		}
+		result.Accuracy = score / float64(len(expected))
+	}
+
+	if duration > 0 {
+		result.PaceAssessed = true
+		result.WordsPerSecond = float64(len(heard)) / duration
+	}
+
+	result.Feedback = feedback(result)
+	return result
+}
+
+// tokenize 分词：汉字逐字切分，英文按单词切分并转为小写
+func tokenize(text string) []string {
+	var tokens []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, strings.ToLower(word.String()))
+			word.Reset()
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || (r == '\'' && word.Len() > 0):
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// splitFillers 去掉停顿词并统计出现次数（中文停顿词可能由多个字组成）
+func splitFillers(tokens []string) ([]string, map[string]int) {
+	fillers := map[string]int{}
+	var words []string
+	for i := 0; i < len(tokens); i++ {
+		matched := false
+		for size := 3; size >= 1; size-- {
+			if i+size > len(tokens) {
+				continue
+			}
+			candidate := strings.Join(tokens[i:i+size], "")
+			if size > 1 && !isHan(candidate) {
+				continue
+			}
+			if fillerWords[candidate] {
+				fillers[candidate]++
+				i += size - 1
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			words = append(words, tokens[i])
+		}
+	}
+	return words, fillers
+}
+
+// align 使用编辑距离对齐目标词与识别词
+func align(expected, heard []string) []WordResult {
+	n, m := len(expected), len(heard)
+	dp := make([][]int, n+1)
+	for i := range dp {
+		dp[i] = make([]int, m+1)
+		dp[i][0] = i
+	}
+	for j := 0; j <= m; j++ {
+		dp[0][j] = j
+	}
+	for i := 1; i <= n; i++ {
+		for j := 1; j <= m; j++ {
+			cost := 1
+			if similar(expected[i-1], heard[j-1]) {
+				cost = 0
+			}
+			dp[i][j] = min(dp[i-1][j-1]+cost, dp[i-1][j]+1, dp[i][j-1]+1)
+		}
+	}
+
+	results := make([]WordResult, n)
+	i, j := n, m
+	for i > 0 {
+		switch {
+		case j > 0 && similar(expected[i-1], heard[j-1]) && dp[i][j] == dp[i-1][j-1]:
+			status := StatusCorrect
+			if expected[i-1] != heard[j-1] {
+				status = StatusClose
+			}
+			results[i-1] = WordResult{Word: expected[i-1], Heard: heard[j-1], Status: status}
+			i, j = i-1, j-1
+		case j > 0 && dp[i][j] == dp[i-1][j-1]+1:
+			results[i-1] = WordResult{Word: expected[i-1], Heard: heard[j-1], Status: StatusMissed}
+			i, j = i-1, j-1
+		case dp[i][j] == dp[i-1][j]+1:
+			results[i-1] = WordResult{Word: expected[i-1], Status: StatusMissed}
+			i--
+		default:
+			j--
+		}
+	}
+
+	return results
+}
+
+// similar 判断两个词是否一致；较长的英文单词允许一个字母的差异（如学名的常见误读）
+func similar(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if isHan(a) || isHan(b) || len(a) < 5 || len(b) < 5 {
+		return false
+	}
+	return editDistance(a, b) <= 1
+}
+
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j-1]+cost, prev[j]+1, cur[j-1]+1)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func isHan(s string) bool {
+	for _, r := range s {
+		if !unicode.Is(unicode.Han, r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// feedback 生成鼓励性的反馈，先肯定再给出一个小建议
+func feedback(r *Result) []string {
+	var msgs []string
+
+	var missed []string
+	for _, w := range r.Words {
+		if w.Status != StatusCorrect {
+			missed = append(missed, w.Word)
+		}
+	}
+	switch {
+	case len(r.Words) == 0:
+		msgs = append(msgs, "谢谢你分享自己的想法，说得很认真！")
+	case r.Accuracy >= 0.9:
+		msgs = append(msgs, fmt.Sprintf("太棒了！你把「%s」说得非常准确！", r.TargetText))
+	case r.Accuracy >= 0.6:
+		msgs = append(msgs, fmt.Sprintf("说得很好！再练习一下「%s」就更完美了。", joinWords(missed)))
+	default:
+		msgs = append(msgs, fmt.Sprintf("勇敢开口就很了不起！我们一起慢慢再读一遍「%s」吧。", r.TargetText))
+	}
+
+	switch {
+	case !r.PaceAssessed:
+	case r.WordsPerSecond > FastPace:
+		msgs = append(msgs, "说得有点快哦，放慢一点，大家会听得更清楚。")
+	case r.WordsPerSecond < SlowPace:
+		msgs = append(msgs, "可以试着说得更连贯一些，你已经进步很多啦！")
+	default:
+		msgs = append(msgs, "语速刚刚好，听起来很舒服！")
+	}
+
+	switch {
+	case r.FillerCount == 0:
+		msgs = append(msgs, "表达很流畅，几乎没有停顿词！")
+	case r.FillerCount >= 3:
+		msgs = append(msgs, "想一想的时候可以安静停一下，不用说“嗯”“那个”，会更像小科学家哦。")
+	}
+
+	return msgs
+}
+
+// joinWords 拼接需要再练习的词，英文单词之间保留空格
+func joinWords(words []string) string {
+	var b strings.Builder
+	for i, w := range words {
+		if i > 0 && !isHan(w) && !isHan(words[i-1]) {
+			b.WriteString(" ")
+		}
+		b.WriteString(w)
+	}
+	return b.String()
+}
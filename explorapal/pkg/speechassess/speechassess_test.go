@@ -0,0 +1,112 @@
+package speechassess
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAssess(t *testing.T) {
+	tests := []struct {
+		name         string
+		transcript   string
+		target       string
+		duration     float64
+		accuracy     float64
+		paceAssessed bool
+		pace         float64
+		fillerCount  int
+		statuses     []string
+		feedback     int
+	}{
+		{
+			name:       "exact match",
+			transcript: "danaus plexippus", target: "Danaus plexippus", duration: 2,
+			accuracy: 1, paceAssessed: true, pace: 1,
+			statuses: []string{StatusCorrect, StatusCorrect},
+			feedback: 3,
+		},
+		{
+			name:       "one letter off is close",
+			transcript: "Danaus plexipus", target: "Danaus plexippus", duration: 2,
+			accuracy: 0.75, paceAssessed: true, pace: 1,
+			statuses: []string{StatusCorrect, StatusClose},
+			feedback: 3,
+		},
+		{
+			name:       "short words must match exactly",
+			transcript: "the cot", target: "the cat", duration: 0.2,
+			accuracy: 0.5, paceAssessed: true, pace: 10,
+			statuses: []string{StatusCorrect, StatusMissed},
+			feedback: 3,
+		},
+		{
+			name:       "missing word",
+			transcript: "monarch", target: "the monarch flies", duration: 1,
+			accuracy: 1.0 / 3, paceAssessed: true, pace: 1,
+			statuses: []string{StatusMissed, StatusCorrect, StatusMissed},
+			feedback: 3,
+		},
+		{
+			name:       "chinese fillers and unknown duration",
+			transcript: "嗯 蝴蝶 那个 会飞", target: "蝴蝶会飞",
+			accuracy: 1, fillerCount: 2,
+			statuses: []string{StatusCorrect, StatusCorrect, StatusCorrect, StatusCorrect},
+			feedback: 1,
+		},
+		{
+			name:       "english fillers",
+			transcript: "um the monarch uh um flies", target: "the monarch flies", duration: 3,
+			accuracy: 1, paceAssessed: true, pace: 1, fillerCount: 3,
+			statuses: []string{StatusCorrect, StatusCorrect, StatusCorrect},
+			feedback: 3,
+		},
+		{
+			name:       "no target",
+			transcript: "我觉得蝴蝶很漂亮", duration: 0,
+			statuses: []string{},
+			feedback: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Assess(tt.transcript, tt.target, tt.duration)
+			if math.Abs(got.Accuracy-tt.accuracy) > 1e-9 {
+				t.Errorf("Accuracy = %v, want %v", got.Accuracy, tt.accuracy)
+			}
+			if got.PaceAssessed != tt.paceAssessed || math.Abs(got.WordsPerSecond-tt.pace) > 1e-9 {
+				t.Errorf("pace = %v (assessed %v), want %v (assessed %v)", got.WordsPerSecond, got.PaceAssessed, tt.pace, tt.paceAssessed)
+			}
+			if got.FillerCount != tt.fillerCount {
+				t.Errorf("FillerCount = %d, want %d (%v)", got.FillerCount, tt.fillerCount, got.Fillers)
+			}
+			statuses := make([]string, 0, len(got.Words))
+			for _, w := range got.Words {
+				statuses = append(statuses, w.Status)
+			}
+			if !reflect.DeepEqual(statuses, tt.statuses) {
+				t.Errorf("statuses = %v, want %v", statuses, tt.statuses)
+			}
+			if len(got.Feedback) != tt.feedback {
+				t.Errorf("Feedback = %q, want %d messages", got.Feedback, tt.feedback)
+			}
+		})
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"蝴蝶会飞", []string{"蝴", "蝶", "会", "飞"}},
+		{"The Monarch, it's orange!", []string{"the", "monarch", "it's", "orange"}},
+		{"我看到Danaus plexippus了", []string{"我", "看", "到", "danaus", "plexippus", "了"}},
+		{"  ...  ", nil},
+	}
+	for _, tt := range tests {
+		if got := tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// 默认的隔离区前缀，未通过安全筛查的文件移动到这里等待人工查看
+const defaultQuarantinePrefix = "quarantine"
+
+// ErrInvalidKey 存储路径不合法
+var ErrInvalidKey = errors.New("存储路径不合法")
+
+// Config 文件存储配置
+type Config struct {
+	Root             string // 本地存储根目录
+	BaseURL          string // 对外访问地址，对应Root目录
+	QuarantinePrefix string // 隔离区前缀，隔离区内的文件不对外提供访问
+}
+
+// Storage 本地文件存储，对外访问由网关或CDN按BaseURL映射到Root目录
+type Storage struct {
+	root             string
+	baseURL          string
+	quarantinePrefix string
+}
+
+// NewStorage 创建文件存储
+func NewStorage(config *Config) *Storage {
+	prefix := strings.Trim(config.QuarantinePrefix, "/")
+	if prefix == "" {
+		prefix = defaultQuarantinePrefix
+	}
+	return &Storage{
+		root:             config.Root,
+		baseURL:          strings.TrimRight(config.BaseURL, "/"),
+		quarantinePrefix: prefix,
+	}
+}
+
+// Put 保存文件，返回对外访问地址
+func (s *Storage) Put(ctx context.Context, key string, data []byte) (string, error) {
+	if err := s.write(ctx, key, data); err != nil {
+		return "", err
+	}
+	return s.URL(key), nil
+}
+
+// PutQuarantine 直接把文件保存到隔离区，返回隔离区路径
+func (s *Storage) PutQuarantine(ctx context.Context, key string, data []byte) (string, error) {
+	quarantineKey := s.QuarantineKey(key)
+	if err := s.write(ctx, quarantineKey, data); err != nil {
+		return "", err
+	}
+	return quarantineKey, nil
+}
+
+// Quarantine 把已保存的文件移动到隔离区，文件不会被删除，返回隔离区路径
+func (s *Storage) Quarantine(ctx context.Context, key string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	src, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	quarantineKey := s.QuarantineKey(key)
+	dst, err := s.path(quarantineKey)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o750); err != nil {
+		return "", fmt.Errorf("创建隔离区目录失败: %w", err)
+	}
+	if err := os.Rename(src, dst); err != nil {
+		return "", fmt.Errorf("移动文件到隔离区失败: %w", err)
+	}
+	return quarantineKey, nil
+}
+
+// Restore 人工审核通过后把隔离区的文件移回原路径，返回对外访问地址
+func (s *Storage) Restore(ctx context.Context, quarantineKey string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	key := strings.TrimPrefix(quarantineKey, s.quarantinePrefix+"/")
+	if key == quarantineKey {
+		return "", ErrInvalidKey
+	}
+	src, err := s.path(quarantineKey)
+	if err != nil {
+		return "", err
+	}
+	dst, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o750); err != nil {
+		return "", fmt.Errorf("创建存储目录失败: %w", err)
+	}
+	if err := os.Rename(src, dst); err != nil {
+		// 上次已经恢复但记录没有写入成功（如事务回滚），重试时文件已经不在隔离区
+		if errors.Is(err, os.ErrNotExist) {
+			if _, statErr := os.Stat(dst); statErr == nil {
+				return s.URL(key), nil
+			}
+		}
+		return "", fmt.Errorf("从隔离区恢复文件失败: %w", err)
+	}
+	return s.URL(key), nil
+}
+
+// Delete 删除已保存的文件，文件不存在时不返回错误
+func (s *Storage) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("删除文件失败: %w", err)
+	}
+	return nil
+}
+
+// QuarantineKey 返回文件在隔离区的路径
+func (s *Storage) QuarantineKey(key string) string {
+	return path.Join(s.quarantinePrefix, key)
+}
+
+// URL 返回文件的对外访问地址
+func (s *Storage) URL(key string) string {
+	escaped := (&url.URL{Path: strings.TrimLeft(key, "/")}).EscapedPath()
+	return s.baseURL + "/" + escaped
+}
+
+func (s *Storage) write(ctx context.Context, key string, data []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		return fmt.Errorf("创建存储目录失败: %w", err)
+	}
+
+	// 先写临时文件再改名，避免对外暴露写了一半的文件
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return fmt.Errorf("写入文件失败: %w", err)
+	}
+	if err := os.Rename(tmp, p); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("保存文件失败: %w", err)
+	}
+	return nil
+}
+
+// path 把存储路径转换为本地路径，拒绝跳出根目录的路径
+func (s *Storage) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if clean == "/" || strings.Contains(key, "..") {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
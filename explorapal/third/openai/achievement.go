@@ -0,0 +1,178 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// GenerateDocumentary 根据孩子的探索记录生成纪录片脚本
+// style为narrative,scientific,adventure，length为short(1分钟),medium(3分钟),long(5分钟)
+func (c *Client) GenerateDocumentary(ctx context.Context, projectData, style, length string) (*DocumentaryScript, error) {
+	prompt := fmt.Sprintf(`请根据孩子的探索记录，写一个由孩子担任主角的小纪录片脚本。
+
+项目数据：%s
+纪录片风格：%s（narrative讲故事, scientific科学解说, adventure探险）
+时长：%s（short约1分钟, medium约3分钟, long约5分钟）
+
+要求：
+1. 以孩子的观察、提问和发现为主线，只依据提供的数据，不要编造孩子没有做过的事情
+2. 旁白使用孩子能听懂的语言，语气积极、充满好奇
+3. 场景描述要能用孩子拍的照片和简单的画面完成
+4. 确保所有内容适合儿童教育场景，避免任何不适宜内容
+
+请以JSON格式返回包含以下字段的结果：
+- title: 纪录片标题
+- duration: 预计总时长(秒)
+- style: 风格
+- scenes: 场景数组，每个场景包含scene_number、duration(秒)、description、visuals（数组）、narration、transitions
+- narration: 完整旁白脚本
+- music: 背景音乐建议
+- effects: 音效建议数组`, projectData, style, length)
+
+	content, err := c.complete(ctx, ModelAdvancedReasoning, prompt)
+	if err != nil {
+		return nil, fmt.Errorf("生成纪录片脚本失败: %w", err)
+	}
+
+	result := &DocumentaryScript{}
+	if err := json.Unmarshal([]byte(extractJSON(content)), result); err != nil {
+		return nil, fmt.Errorf("解析纪录片脚本失败: %w", err)
+	}
+	if result.Style == "" {
+		result.Style = style
+	}
+	return result, nil
+}
+
+// GeneratePoster 根据孩子的探索记录生成科学海报的设计
+// style为scientific,creative,educational，layout为standard,creative,minimal
+func (c *Client) GeneratePoster(ctx context.Context, projectData, style, layout string) (*PosterDesign, error) {
+	prompt := fmt.Sprintf(`请根据孩子的探索记录，设计一张展示孩子研究成果的科学海报。
+
+项目数据：%s
+海报风格：%s（scientific科学, creative创意, educational科普）
+布局：%s（standard标准, creative创意, minimal简洁）
+
+要求：
+1. 海报内容来自孩子的观察、提问和发现，只依据提供的数据，不要编造
+2. 文字简短，适合孩子阅读和讲解
+3. 配色明亮友好，字号适合打印张贴
+4. 确保所有内容适合儿童教育场景，避免任何不适宜内容
+
+请以JSON格式返回包含以下字段的结果：
+- title: 海报标题
+- style: 设计风格
+- layout: 布局
+- sections: 区域数组，每个区域包含type（title,abstract,findings,visual,conclusion）、x、y、width、height（0到1之间的小数）、content、style
+- color_scheme: 包含primary、secondary、accent（颜色值）和palette（颜色数组）
+- typography: 包含title_font、body_font、heading_font、title_size、body_size、heading_size
+- visual_elements: 视觉元素数组，每项包含type（diagram,illustration,chart,mindmap）、title、description、data、position`, projectData, style, layout)
+
+	content, err := c.complete(ctx, ModelAdvancedReasoning, prompt)
+	if err != nil {
+		return nil, fmt.Errorf("生成海报设计失败: %w", err)
+	}
+
+	result := &PosterDesign{}
+	if err := json.Unmarshal([]byte(extractJSON(content)), result); err != nil {
+		return nil, fmt.Errorf("解析海报设计失败: %w", err)
+	}
+	if result.Style == "" {
+		result.Style = style
+	}
+	if result.Layout == "" {
+		result.Layout = layout
+	}
+	return result, nil
+}
+
+// complete 发送单轮对话，返回模型的回复
+func (c *Client) complete(ctx context.Context, model, prompt string) (string, error) {
+	req := openai.ChatCompletionRequest{
+		Model: model,
+		Messages: []openai.ChatCompletionMessage{
+			{
+				Role:    openai.ChatMessageRoleUser,
+				Content: prompt,
+			},
+		},
+		MaxTokens:   c.config.MaxTokens,
+		Temperature: c.config.Temperature,
+	}
+
+	resp, err := c.client.CreateChatCompletion(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("Qwen API返回结果为空")
+	}
+	return resp.Choices[0].Message.Content, nil
+}
+
+type DocumentaryScript struct {
+	Title     string   `json:"title"`
+	Duration  int32    `json:"duration"`
+	Style     string   `json:"style"`
+	Scenes    []Scene  `json:"scenes"`
+	Narration string   `json:"narration"`
+	Music     string   `json:"music"`
+	Effects   []string `json:"effects"`
+}
+
+type Scene struct {
+	SceneNumber int32    `json:"scene_number"`
+	Duration    int32    `json:"duration"`
+	Description string   `json:"description"`
+	Visuals     []string `json:"visuals"`
+	Narration   string   `json:"narration"`
+	Transitions string   `json:"transitions,omitempty"`
+}
+
+type PosterDesign struct {
+	Title          string          `json:"title"`
+	Style          string          `json:"style"`
+	Layout         string          `json:"layout"`
+	Sections       []PosterSection `json:"sections"`
+	ColorScheme    ColorScheme     `json:"color_scheme"`
+	Typography     Typography      `json:"typography"`
+	VisualElements []VisualElement `json:"visual_elements"`
+}
+
+type PosterSection struct {
+	Type    string  `json:"type"`
+	X       float64 `json:"x"`
+	Y       float64 `json:"y"`
+	Width   float64 `json:"width"`
+	Height  float64 `json:"height"`
+	Content string  `json:"content"`
+	Style   string  `json:"style"`
+}
+
+type ColorScheme struct {
+	Primary   string   `json:"primary"`
+	Secondary string   `json:"secondary"`
+	Accent    string   `json:"accent"`
+	Palette   []string `json:"palette"`
+}
+
+type Typography struct {
+	TitleFont   string `json:"title_font"`
+	BodyFont    string `json:"body_font"`
+	HeadingFont string `json:"heading_font"`
+	TitleSize   int32  `json:"title_size"`
+	BodySize    int32  `json:"body_size"`
+	HeadingSize int32  `json:"heading_size"`
+}
+
+type VisualElement struct {
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Data        string `json:"data"`
+	Position    string `json:"position"`
+}
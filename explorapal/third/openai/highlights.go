@@ -0,0 +1,56 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// GenerateHighlights 根据孩子一段时间的探索统计和活动记录，为家长生成几条值得关注的亮点
+func (c *Client) GenerateHighlights(ctx context.Context, summaryData string, count int) ([]string, error) {
+	prompt := fmt.Sprintf(`下面是孩子最近一段时间在探索伙伴中的学习统计和活动记录，请站在老师的角度，为家长写出%d条值得关注的亮点。
+
+学习数据(JSON)：%s
+
+要求：
+1. 每条亮点一句话，具体说明孩子做了什么、体现了什么能力或兴趣
+2. 以鼓励和发现孩子的兴趣为主，可以给出一条家长可以一起做的小建议
+3. 只依据提供的数据，不要编造没有发生的活动
+4. 不要出现孩子的真实姓名、学校、住址等个人信息
+
+请以JSON格式返回：{"highlights": ["亮点1", "亮点2"]}`, count, summaryData)
+
+	req := openai.ChatCompletionRequest{
+		Model: ModelTextGeneration,
+		Messages: []openai.ChatCompletionMessage{
+			{
+				Role:    openai.ChatMessageRoleUser,
+				Content: prompt,
+			},
+		},
+		MaxTokens:   c.config.MaxTokens,
+		Temperature: c.config.Temperature,
+	}
+
+	resp, err := c.client.CreateChatCompletion(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("生成学习亮点失败: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("Qwen API返回结果为空")
+	}
+
+	var result struct {
+		Highlights []string `json:"highlights"`
+	}
+	if err := json.Unmarshal([]byte(extractJSON(resp.Choices[0].Message.Content)), &result); err != nil {
+		return nil, fmt.Errorf("解析学习亮点失败: %w", err)
+	}
+	if len(result.Highlights) > count {
+		result.Highlights = result.Highlights[:count]
+	}
+	return result.Highlights, nil
+}
@@ -2,7 +2,9 @@ package openai
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/sashabaranov/go-openai"
 )
@@ -38,6 +40,9 @@ const (
 	// 语音交互 - 使用Omni多模态模型
 	ModelVoiceInteraction = "qwen3-omni-flash" // 多模态语音处理
 
+	// 文本向量 - 用于相似内容推荐
+	ModelTextEmbedding = "text-embedding-v3" // 多语言文本向量，支持指定维数
+
 	// 备用模型
 	ModelImageAnalysisBackup = "qwen3-vl-235b-a22b-instruct" // 备用的视觉模型
 	ModelTextGenerationBackup = "qwen-turbo" // 备用的快速模型
@@ -119,7 +124,7 @@ func (c *Client) AnalyzeImage(ctx context.Context, imageURL, prompt string) (*Im
 		Messages: []openai.ChatCompletionMessage{
 			{
 				Role: openai.ChatMessageRoleUser,
-				Content: []openai.ChatMessagePart{
+				MultiContent: []openai.ChatMessagePart{
 					{
 						Type: openai.ChatMessagePartTypeText,
 						Text: prompt,
@@ -146,9 +151,11 @@ func (c *Client) AnalyzeImage(ctx context.Context, imageURL, prompt string) (*Im
 		return nil, fmt.Errorf("Qwen API返回结果为空")
 	}
 
-	result := &ImageAnalysisResult{
-		ObjectName: resp.Choices[0].Message.Content,
-		// 这里可以根据实际需求解析更多信息
+	// 提示词要求返回JSON时按字段解析，否则把回复整体作为对象名称
+	content := resp.Choices[0].Message.Content
+	result := &ImageAnalysisResult{}
+	if err := json.Unmarshal([]byte(extractJSON(content)), result); err != nil || result.ObjectName == "" {
+		result = &ImageAnalysisResult{ObjectName: content}
 	}
 
 	return result, nil
@@ -169,11 +176,13 @@ func (c *Client) GenerateQuestions(ctx context.Context, contextInfo string, cate
 4. 每个问题都要有明确的类型标注
 5. 确保所有内容适合儿童教育场景，避免任何不适宜内容
 
-请以JSON格式返回，包含以下字段：
+请以JSON格式返回，格式为{"questions": [...]}，每个问题包含以下字段：
 - content: 问题内容
 - type: 问题类型（observation观察, reasoning推理, experiment实验, comparison比较）
 - difficulty: 难度（basic基本, intermediate中级, advanced高级）
-- purpose: 问题目的说明`, contextInfo, category)
+- purpose: 问题目的说明
+- hints: 帮助孩子思考的提示数组
+- expected_thinking: 期望孩子的思考方向`, contextInfo, category)
 
 	req := openai.ChatCompletionRequest{
 		Model: ModelTextGeneration,
@@ -196,13 +205,78 @@ func (c *Client) GenerateQuestions(ctx context.Context, contextInfo string, cate
 		return nil, fmt.Errorf("Qwen API返回结果为空")
 	}
 
-	// 这里需要解析JSON响应并转换为Question结构体
-	// 为了简化，先返回空结果
-	return []Question{}, nil
+	var result struct {
+		Questions []Question `json:"questions"`
+	}
+	if err := json.Unmarshal([]byte(extractJSON(resp.Choices[0].Message.Content)), &result); err != nil {
+		return nil, fmt.Errorf("解析生成的问题失败: %w", err)
+	}
+	if len(result.Questions) == 0 {
+		return nil, fmt.Errorf("Qwen API没有生成问题")
+	}
+
+	return result.Questions, nil
+}
+
+// AnswerQuestion 回答孩子选择的问题，并给出后续问题、思考提示和动手活动
+func (c *Client) AnswerQuestion(ctx context.Context, question, contextInfo string) (*QuestionAnswer, error) {
+	prompt := fmt.Sprintf(`孩子在探索中选择了下面这个问题，请用孩子能听懂的方式回答：
+
+问题：%s
+
+上下文信息：%s
+
+要求：
+1. 回答准确、简短，适合孩子的年龄
+2. 用生活中的例子和类比帮助理解
+3. 鼓励孩子继续观察和动手验证，不要一次讲完所有知识
+4. 建议的活动必须安全，需要大人协助的要说明
+5. 确保所有内容适合儿童教育场景，避免任何不适宜内容
+
+请以JSON格式返回包含以下字段的结果：
+- answer: 回答内容
+- key_points: 关键要点数组
+- examples: 举例说明数组
+- analogies: 类比数组
+- visual_aids: 视觉辅助建议数组
+- follow_up_questions: 后续问题建议数组
+- thinking_prompts: 思考提示数组
+- activities: 建议活动数组，每个活动包含type（experiment,drawing,comparison,roleplay）、title、description、materials、steps、duration（分钟）、difficulty`, question, contextInfo)
+
+	req := openai.ChatCompletionRequest{
+		Model: ModelTextGeneration,
+		Messages: []openai.ChatCompletionMessage{
+			{
+				Role:    openai.ChatMessageRoleUser,
+				Content: prompt,
+			},
+		},
+		MaxTokens:   c.config.MaxTokens,
+		Temperature: c.config.Temperature,
+	}
+
+	resp, err := c.client.CreateChatCompletion(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("回答问题失败: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("Qwen API返回结果为空")
+	}
+
+	// 回复不是JSON时把回复整体作为回答
+	content := resp.Choices[0].Message.Content
+	result := &QuestionAnswer{}
+	if err := json.Unmarshal([]byte(extractJSON(content)), result); err != nil || result.Answer == "" {
+		result = &QuestionAnswer{Answer: content}
+	}
+
+	return result, nil
 }
 
 // PolishNote AI润色笔记
-func (c *Client) PolishNote(ctx context.Context, rawContent, contextInfo string) (*PolishedNote, error) {
+// language 为孩子使用的语言代码（如zh-CN、en-US），润色结果保持与孩子相同的语言
+func (c *Client) PolishNote(ctx context.Context, rawContent, contextInfo, language string) (*PolishedNote, error) {
 
 	prompt := fmt.Sprintf(`请帮孩子润色他的探索笔记，让它更清晰、有逻辑性。
 
@@ -210,12 +284,15 @@ func (c *Client) PolishNote(ctx context.Context, rawContent, contextInfo string)
 
 上下文信息：%s
 
+孩子使用的语言：%s
+
 要求：
 1. 保持孩子的原意和语言特色
 2. 让表达更清晰准确
 3. 添加适当的科学概念解释
 4. 指出可能的疑问和下一步探索方向
 5. 确保所有内容适合儿童教育场景，避免任何不适宜内容
+6. 使用与孩子相同的语言输出，不要翻译；如果孩子中英文混合表达，保留原有的混合方式
 
 请以JSON格式返回包含以下字段的结果：
 - title: 笔记标题
@@ -224,7 +301,7 @@ func (c *Client) PolishNote(ctx context.Context, rawContent, contextInfo string)
 - scientific_concepts: 科学概念数组
 - questions: 引发的疑问数组
 - connections: 相关知识连接数组
-- formatted_text: 格式化的文本内容`, rawContent, contextInfo)
+- formatted_text: 格式化的文本内容`, rawContent, contextInfo, language)
 
 	req := openai.ChatCompletionRequest{
 		Model: ModelTextGeneration,
@@ -256,6 +333,54 @@ func (c *Client) PolishNote(ctx context.Context, rawContent, contextInfo string)
 	return result, nil
 }
 
+// TranslateNote 生成润色笔记的平行译文
+// 译文只用于对照阅读，不改变孩子原文的意思
+func (c *Client) TranslateNote(ctx context.Context, note *PolishedNote, targetLanguage string) (*PolishedNote, error) {
+	noteData, err := json.Marshal(note)
+	if err != nil {
+		return nil, fmt.Errorf("序列化笔记失败: %w", err)
+	}
+
+	prompt := fmt.Sprintf(`请把下面这份孩子的探索笔记翻译成%s，作为原文的平行对照版本。
+
+笔记内容(JSON)：%s
+
+要求：
+1. 忠实于原文，不添加、不删减内容
+2. 使用适合儿童阅读的简单词汇
+3. 科学名词使用目标语言中的通用说法
+4. 确保所有内容适合儿童教育场景，避免任何不适宜内容
+
+请以与输入相同字段的JSON格式返回译文。`, targetLanguage, string(noteData))
+
+	req := openai.ChatCompletionRequest{
+		Model: ModelTextGeneration,
+		Messages: []openai.ChatCompletionMessage{
+			{
+				Role: openai.ChatMessageRoleUser,
+				Content: prompt,
+			},
+		},
+		MaxTokens: c.config.MaxTokens,
+		Temperature: c.config.Temperature,
+	}
+
+	resp, err := c.client.CreateChatCompletion(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("翻译笔记失败: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("Qwen API返回结果为空")
+	}
+
+	result := &PolishedNote{
+		FormattedText: resp.Choices[0].Message.Content,
+	}
+
+	return result, nil
+}
+
 // GenerateReport 生成研究报告
 func (c *Client) GenerateReport(ctx context.Context, projectData string) (*ResearchReport, error) {
 
@@ -279,8 +404,14 @@ func (c *Client) GenerateReport(ctx context.Context, projectData string) (*Resea
 - 确保所有内容适合儿童教育场景
 - 避免任何不适宜的敏感内容
 - 保持积极正面的教育导向
+- 只依据提供的数据，不要编造孩子没有做过的观察和实验
 
-请以JSON格式返回报告内容。`, projectData)
+请以JSON格式返回报告内容，包含以下字段：
+- title, abstract, introduction, methodology, discussion, conclusion, child_insights: 字符串
+- findings: 发现数组，每项包含title、description、evidence（数组）、significance
+- references: 参考资料数组，每项包含title、type（book,article,video,website）、url、credit
+- visuals: 视觉元素数组，每项包含type（chart,diagram,timeline,map）、title、description、data（JSON字符串）
+- next_steps: 下一步探索建议数组`, projectData)
 
 	req := openai.ChatCompletionRequest{
 		Model: ModelAdvancedReasoning,
@@ -303,15 +434,32 @@ func (c *Client) GenerateReport(ctx context.Context, projectData string) (*Resea
 		return nil, fmt.Errorf("Qwen API返回结果为空")
 	}
 
-	// 这里需要解析JSON响应并转换为ResearchReport结构体
-	// 为了简化，先返回基本结果
-	result := &ResearchReport{
-		Content: resp.Choices[0].Message.Content,
+	result := &ResearchReport{}
+	if err := json.Unmarshal([]byte(extractJSON(resp.Choices[0].Message.Content)), result); err != nil {
+		return nil, fmt.Errorf("解析研究报告失败: %w", err)
 	}
 
 	return result, nil
 }
 
+// CreateEmbedding 计算文本向量，dimensions为0时使用模型默认维数
+func (c *Client) CreateEmbedding(ctx context.Context, text string, dimensions int) ([]float32, error) {
+	resp, err := c.client.CreateEmbeddings(ctx, openai.EmbeddingRequest{
+		Input:      []string{text},
+		Model:      openai.EmbeddingModel(ModelTextEmbedding),
+		Dimensions: dimensions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("计算文本向量失败: %w", err)
+	}
+
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("Qwen API返回结果为空")
+	}
+
+	return resp.Data[0].Embedding, nil
+}
+
 // 数据结构定义
 
 type ImageAnalysisResult struct {
@@ -324,10 +472,33 @@ type ImageAnalysisResult struct {
 }
 
 type Question struct {
-	Content    string `json:"content"`
-	Type       string `json:"type"`
-	Difficulty string `json:"difficulty"`
-	Purpose    string `json:"purpose"`
+	Content          string   `json:"content"`
+	Type             string   `json:"type"`
+	Difficulty       string   `json:"difficulty"`
+	Purpose          string   `json:"purpose"`
+	Hints            []string `json:"hints"`
+	ExpectedThinking string   `json:"expected_thinking"`
+}
+
+type QuestionAnswer struct {
+	Answer            string     `json:"answer"`
+	KeyPoints         []string   `json:"key_points"`
+	Examples          []string   `json:"examples"`
+	Analogies         []string   `json:"analogies"`
+	VisualAids        []string   `json:"visual_aids"`
+	FollowUpQuestions []string   `json:"follow_up_questions"`
+	ThinkingPrompts   []string   `json:"thinking_prompts"`
+	Activities        []Activity `json:"activities"`
+}
+
+type Activity struct {
+	Type        string   `json:"type"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Materials   []string `json:"materials"`
+	Steps       []string `json:"steps"`
+	Duration    int32    `json:"duration"`
+	Difficulty  string   `json:"difficulty"`
 }
 
 type PolishedNote struct {
@@ -349,9 +520,9 @@ type ResearchReport struct {
 	Discussion    string     `json:"discussion"`
 	Conclusion    string     `json:"conclusion"`
 	References    []Reference `json:"references"`
+	Visuals       []ReportVisual `json:"visuals"`
 	ChildInsights string     `json:"child_insights"`
 	NextSteps     []string   `json:"next_steps"`
-	Content       string     `json:"content"` // 简化字段，用于存储完整内容
 }
 
 type Finding struct {
@@ -368,3 +539,19 @@ type Reference struct {
 	Credit  string `json:"credit"`
 }
 
+type ReportVisual struct {
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Data        string `json:"data"`
+}
+
+// extractJSON 去掉模型回复中包裹JSON的markdown代码块
+func extractJSON(content string) string {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start < 0 || end < start {
+		return content
+	}
+	return content[start : end+1]
+}
@@ -0,0 +1,195 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	defaultBatchWorkers = 8
+	defaultBatchSize    = 50
+)
+
+// 原生批量接口的探测状态
+const (
+	batchUnknown int32 = iota
+	batchSupported
+	batchUnsupported
+)
+
+// batchSupport 记录安全中心是否提供原生批量接口
+type batchSupport struct {
+	state atomic.Int32
+}
+
+// BatchResult 批量检查中单条内容的结果
+type BatchResult struct {
+	Result *ContentCheckResult
+	Err    error
+}
+
+// BatchCheckRequest 原生批量检查请求
+type BatchCheckRequest struct {
+	Items []*ContentCheckRequest `json:"items"`
+}
+
+// BatchCheckResponse 原生批量检查响应
+type BatchCheckResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		Results []ContentCheckResult `json:"results"`
+	} `json:"data"`
+	RequestID string `json:"request_id"`
+}
+
+// errBatchUnsupported 安全中心没有原生批量接口
+var errBatchUnsupported = errors.New("安全中心不支持批量检查")
+
+// CheckBatch 批量检查多条内容，结果与输入顺序一致
+// 每条内容先经过本地规则，其余内容优先调用原生批量接口，不支持时按配置的并发数逐条并发检查；
+// context取消后未完成的内容返回context的错误，不按失败策略处理
+func (c *SecurityClient) CheckBatch(ctx context.Context, reqs []*ContentCheckRequest) []BatchResult {
+	results := make([]BatchResult, len(reqs))
+
+	locals := make([]*localResult, len(reqs))
+	var remoteIdx []int
+	var remoteReqs []*ContentCheckRequest
+	for i, req := range reqs {
+		applyDefaults(req)
+		locals[i] = c.rules.Check(req)
+		if locals[i].blocked() {
+			results[i] = BatchResult{Result: locals[i].toResult(req.Content)}
+			continue
+		}
+		remoteIdx = append(remoteIdx, i)
+		remoteReqs = append(remoteReqs, locals[i].remoteRequest(req))
+	}
+
+	remoteResults := c.checkRemoteBatch(ctx, remoteReqs)
+	for j, i := range remoteIdx {
+		result, err := c.resolve(ctx, reqs[i], locals[i], remoteResults[j].Result, remoteResults[j].Err)
+		results[i] = BatchResult{Result: result, Err: err}
+	}
+
+	return results
+}
+
+// checkRemoteBatch 请求安全中心批量检查
+func (c *SecurityClient) checkRemoteBatch(ctx context.Context, reqs []*ContentCheckRequest) []BatchResult {
+	results := make([]BatchResult, len(reqs))
+	if len(reqs) == 0 {
+		return results
+	}
+
+	if len(reqs) > 1 && c.nativeBatch.state.Load() != batchUnsupported {
+		if err := c.checkNativeBatch(ctx, reqs, results); !errors.Is(err, errBatchUnsupported) {
+			return results
+		}
+	}
+
+	c.checkConcurrently(ctx, reqs, results)
+	return results
+}
+
+// checkNativeBatch 按批次大小分片调用原生批量接口，分片之间并发
+func (c *SecurityClient) checkNativeBatch(ctx context.Context, reqs []*ContentCheckRequest, results []BatchResult) error {
+	type chunk struct{ start, end int }
+	var chunks []chunk
+	for start := 0; start < len(reqs); start += c.batchSize {
+		chunks = append(chunks, chunk{start: start, end: min(start+c.batchSize, len(reqs))})
+	}
+
+	errs := make([]error, len(chunks))
+	c.runBounded(ctx, len(chunks), func(i int) {
+		ch := chunks[i]
+		chunkResults, err := c.postBatch(ctx, reqs[ch.start:ch.end])
+		errs[i] = err
+		for j := ch.start; j < ch.end; j++ {
+			if err != nil {
+				results[j] = BatchResult{Err: err}
+				continue
+			}
+			results[j] = BatchResult{Result: &chunkResults[j-ch.start]}
+		}
+	}, func(i int, err error) {
+		errs[i] = err
+		for j := chunks[i].start; j < chunks[i].end; j++ {
+			results[j] = BatchResult{Err: err}
+		}
+	})
+
+	// 任一分片确认不支持批量接口时整体改为逐条检查
+	for _, err := range errs {
+		if errors.Is(err, errBatchUnsupported) {
+			return errBatchUnsupported
+		}
+	}
+	return nil
+}
+
+// postBatch 调用原生批量接口
+func (c *SecurityClient) postBatch(ctx context.Context, reqs []*ContentCheckRequest) ([]ContentCheckResult, error) {
+	var batchResp BatchCheckResponse
+	err := c.post(ctx, "/api/v1/content/batch_check", &BatchCheckRequest{Items: reqs}, &batchResp)
+	if err != nil {
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && (statusErr.StatusCode == http.StatusNotFound || statusErr.StatusCode == http.StatusMethodNotAllowed) {
+			c.nativeBatch.state.Store(batchUnsupported)
+			return nil, errBatchUnsupported
+		}
+		return nil, err
+	}
+	c.nativeBatch.state.Store(batchSupported)
+
+	if batchResp.Code != 200 {
+		return nil, fmt.Errorf("批量安全检查失败: %s", batchResp.Message)
+	}
+	if len(batchResp.Data.Results) != len(reqs) {
+		return nil, fmt.Errorf("批量安全检查结果数量不符: 期望%d, 实际%d", len(reqs), len(batchResp.Data.Results))
+	}
+	return batchResp.Data.Results, nil
+}
+
+// checkConcurrently 逐条并发检查
+func (c *SecurityClient) checkConcurrently(ctx context.Context, reqs []*ContentCheckRequest, results []BatchResult) {
+	c.runBounded(ctx, len(reqs), func(i int) {
+		result, err := c.checkRemote(ctx, reqs[i])
+		results[i] = BatchResult{Result: result, Err: err}
+	}, func(i int, err error) {
+		results[i] = BatchResult{Err: err}
+	})
+}
+
+// runBounded 以batchWorkers为上限并发执行n个任务；context取消后尚未开始的任务调用cancelled
+func (c *SecurityClient) runBounded(ctx context.Context, n int, task func(i int), cancelled func(i int, err error)) {
+	sem := make(chan struct{}, c.batchWorkers)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			for j := i; j < n; j++ {
+				cancelled(j, ctx.Err())
+			}
+			wg.Wait()
+			return
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			task(i)
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,86 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestCheckBatchOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		center  *fakeCenter
+		items   int
+		singles int32
+		batches int32
+	}{
+		{"native batch in chunks", &fakeCenter{}, 7, 0, 3},
+		{"fallback to single checks", &fakeCenter{noBatch: true}, 7, 5, 0},
+		{"single item skips batch", &fakeCenter{}, 1, 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.center, Config{
+				BatchWorkers: 3,
+				BatchSize:    2,
+				LocalRules:   LocalRulesConfig{Keywords: map[string][]string{"violence": {"knife"}}},
+			})
+
+			// 每隔三条放一条本地规则直接拦截的内容，拦截的内容不发往安全中心
+			reqs := make([]*ContentCheckRequest, tt.items)
+			for i := range reqs {
+				content := fmt.Sprintf("item-%d", i)
+				if i%3 == 1 {
+					content = fmt.Sprintf("knife-%d", i)
+				}
+				reqs[i] = &ContentCheckRequest{Content: content, ContentType: ContentTypeText}
+			}
+
+			results := client.CheckBatch(context.Background(), reqs)
+			if len(results) != len(reqs) {
+				t.Fatalf("CheckBatch returned %d results, want %d", len(results), len(reqs))
+			}
+			for i, r := range results {
+				if r.Err != nil {
+					t.Fatalf("results[%d].Err = %v", i, r.Err)
+				}
+				if i%3 == 1 {
+					if r.Result.Passed || r.Result.Suggestion != "block" {
+						t.Errorf("results[%d] = %+v, want blocked locally", i, r.Result)
+					}
+					continue
+				}
+				if want := "checked:" + reqs[i].Content; r.Result.FilteredContent != want {
+					t.Errorf("results[%d].FilteredContent = %q, want %q", i, r.Result.FilteredContent, want)
+				}
+			}
+
+			if got := tt.center.singles.Load(); got != tt.singles {
+				t.Errorf("single checks = %d, want %d", got, tt.singles)
+			}
+			if got := tt.center.batches.Load(); got != tt.batches {
+				t.Errorf("batch calls = %d, want %d", got, tt.batches)
+			}
+		})
+	}
+}
+
+func TestBatchCheckContentErrors(t *testing.T) {
+	client := newTestClient(t, &fakeCenter{fail: true}, Config{})
+
+	results, err := client.BatchCheckContent(context.Background(), []string{"蝴蝶", "瓢虫"}, ContentTypeText, "1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range results {
+		if r.Passed || r.RiskLevel != "error" {
+			t.Errorf("results[%d] = %+v, want error risk level", i, r)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.BatchCheckContent(ctx, []string{"蝴蝶"}, ContentTypeText, "1", ""); err == nil {
+		t.Fatal("BatchCheckContent with cancelled context: want error")
+	}
+}
@@ -0,0 +1,211 @@
+package security
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldPolicy 字段检查策略，决定结构化数据中哪些字段需要检查、按什么内容类型检查
+type FieldPolicy struct {
+	Fields map[string]string // 需要检查的字段及其内容类型；为空时检查除跳过字段外的全部字符串
+	Skip   map[string]bool   // 不检查的字段
+}
+
+// 全量检查时跳过的字段后缀：ID、链接、编码和时间都不是展示给孩子的文字
+var skipSuffixes = []string{"_id", "_ids", "_url", "_code", "_time", "_at"}
+
+// InputPolicy 用户输入的检查策略：孩子输入的文字、上传的图片和音频地址
+var InputPolicy = &FieldPolicy{
+	Fields: map[string]string{
+		"title":               ContentTypeText,
+		"description":         ContentTypeText,
+		"content":             ContentTypeText,
+		"raw_content":         ContentTypeText,
+		"question":            ContentTypeText,
+		"answer":              ContentTypeText,
+		"user_response":       ContentTypeText,
+		"target_text":         ContentTypeText,
+		"observation_results": ContentTypeText,
+		"previous_answers":    ContentTypeText,
+		"keyword":             ContentTypeText,
+		"tags":                ContentTypeText,
+		"image_url":           ContentTypeImageURL,
+		"audio_url":           ContentTypeAudioURL,
+	},
+}
+
+// ProfilePolicy 用户资料的检查策略：只检查孩子可见的昵称和头像
+var ProfilePolicy = &FieldPolicy{
+	Fields: map[string]string{
+		"nickname": ContentTypeText,
+		"avatar":   ContentTypeImageURL,
+	},
+}
+
+// OutputPolicy 返回内容的检查策略：检查全部字符串，跳过枚举值、样式和编码类字段
+var OutputPolicy = &FieldPolicy{
+	Skip: map[string]bool{
+		"type": true, "status": true, "review_status": true, "msg": true, "url": true, "style": true, "category": true,
+		"language": true, "translation_language": true, "content_type": true, "difficulty": true,
+		"audio_format": true, "image_type": true, "image_data": true, "audio_data": true,
+		"layout": true, "position": true, "color": true, "color_scheme": true,
+		"primary": true, "secondary": true, "accent": true,
+		"title_font": true, "heading_font": true, "body_font": true,
+	},
+}
+
+// ContentType 返回字段的内容类型，不需要检查时返回false
+func (p *FieldPolicy) ContentType(field string) (string, bool) {
+	if p.Skip[field] {
+		return "", false
+	}
+	if len(p.Fields) > 0 {
+		contentType, ok := p.Fields[field]
+		return contentType, ok
+	}
+	for _, suffix := range skipSuffixes {
+		if strings.HasSuffix(field, suffix) {
+			return "", false
+		}
+	}
+	return ContentTypeText, true
+}
+
+// stringRef 结构化数据中一个待检查的字符串
+type stringRef struct {
+	field       string
+	contentType string
+	value       string
+	set         func(string)
+}
+
+// Flagged 已自动过滤但需要人工复核的字段：安全中心判定为中等及以上风险
+type Flagged struct {
+	Field    string    // 字段名
+	Original string    // 过滤前的内容
+	Decision *Decision // 检查结论，Content为过滤后的内容
+}
+
+// CheckJSON 按字段策略检查JSON文档中的字符串，过滤的内容直接替换
+// 返回替换后的文档；有内容被拦截时返回拦截结论
+func (g *Guard) CheckJSON(ctx context.Context, data []byte, policy *FieldPolicy, direction string) ([]byte, *Decision, error) {
+	out, blocked, _, err := g.checkJSON(ctx, data, policy, direction)
+	return out, blocked, err
+}
+
+// CheckValue 检查结构体中的字符串字段（按json标签匹配策略），过滤的内容直接写回v
+func (g *Guard) CheckValue(ctx context.Context, v interface{}, policy *FieldPolicy, direction string) (*Decision, error) {
+	blocked, _, err := g.CheckValueForReview(ctx, v, policy, direction)
+	return blocked, err
+}
+
+// CheckValueForReview 与CheckValue相同，同时返回已过滤但需要人工复核的字段
+func (g *Guard) CheckValueForReview(ctx context.Context, v interface{}, policy *FieldPolicy, direction string) (*Decision, []Flagged, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, nil, fmt.Errorf("序列化待检查内容失败: %w", err)
+	}
+
+	out, blocked, flagged, err := g.checkJSON(ctx, data, policy, direction)
+	if err != nil || blocked != nil {
+		return blocked, nil, err
+	}
+	if !bytes.Equal(out, data) {
+		if err := json.Unmarshal(out, v); err != nil {
+			return nil, nil, fmt.Errorf("写回过滤后的内容失败: %w", err)
+		}
+	}
+	return nil, flagged, nil
+}
+
+func (g *Guard) checkJSON(ctx context.Context, data []byte, policy *FieldPolicy, direction string) ([]byte, *Decision, []Flagged, error) {
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, nil, nil, fmt.Errorf("解析待检查内容失败: %w", err)
+	}
+
+	var refs []stringRef
+	collectJSON(doc, "", policy, &refs)
+
+	changed, flagged, blocked := g.checkRefs(ctx, refs, direction)
+	if blocked != nil {
+		return nil, blocked, nil, nil
+	}
+	if !changed {
+		return data, nil, nil, nil
+	}
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("序列化过滤后的内容失败: %w", err)
+	}
+	return out, nil, flagged, nil
+}
+
+// checkRefs 一次性批量检查全部字符串，过滤的内容直接替换
+// 返回是否有内容被过滤、需要人工复核的字段以及第一个拦截结论
+func (g *Guard) checkRefs(ctx context.Context, refs []stringRef, direction string) (bool, []Flagged, *Decision) {
+	targets := make([]CheckTarget, len(refs))
+	for i, ref := range refs {
+		targets[i] = CheckTarget{
+			Content:     ref.value,
+			ContentType: ref.contentType,
+			Direction:   direction,
+			Field:       ref.field,
+		}
+	}
+
+	changed := false
+	var flagged []Flagged
+	for i, decision := range g.CheckAll(ctx, targets) {
+		switch decision.Action {
+		case ActionBlock:
+			return changed, nil, decision
+		case ActionFilter:
+			refs[i].set(decision.Content)
+			changed = true
+			if RiskRank(decision.RiskLevel) >= RiskRank(RiskLevelMedium) {
+				flagged = append(flagged, Flagged{Field: refs[i].field, Original: refs[i].value, Decision: decision})
+			}
+		}
+	}
+	return changed, flagged, nil
+}
+
+// collectJSON 收集JSON文档中需要检查的字符串，数组元素沿用数组所在字段名
+func collectJSON(node interface{}, field string, policy *FieldPolicy, refs *[]stringRef) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			child := v[key]
+			if s, ok := child.(string); ok {
+				if contentType, ok := policy.ContentType(key); ok && strings.TrimSpace(s) != "" {
+					*refs = append(*refs, stringRef{field: key, contentType: contentType, value: s, set: func(s string) { v[key] = s }})
+				}
+				continue
+			}
+			collectJSON(child, key, policy, refs)
+		}
+	case []interface{}:
+		for i, child := range v {
+			if s, ok := child.(string); ok {
+				if contentType, ok := policy.ContentType(field); ok && strings.TrimSpace(s) != "" {
+					*refs = append(*refs, stringRef{field: field, contentType: contentType, value: s, set: func(s string) { v[i] = s }})
+				}
+				continue
+			}
+			collectJSON(child, field, policy, refs)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package security
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 检查方向
+const (
+	DirectionInput  = "input"  // 用户输入
+	DirectionOutput = "output" // 模型输出或返回给孩子的内容
+)
+
+// 内容类型
+const (
+	ContentTypeText     = "text"
+	ContentTypeImageURL = "image_url"
+	ContentTypeAudioURL = "audio_url"
+)
+
+// 风险等级
+const (
+	RiskLevelLow    = "low"
+	RiskLevelMedium = "medium"
+	RiskLevelHigh   = "high"
+	RiskLevelReject = "reject"
+	RiskLevelError  = "error" // 安全中心不可用
+)
+
+// 处理动作
+const (
+	ActionPass   = "pass"   // 放行
+	ActionFilter = "filter" // 使用过滤后的内容
+	ActionBlock  = "block"  // 拦截
+)
+
+// 拦截时展示给孩子的提示
+const (
+	inputBlockedMessage  = "这个内容不太适合在这里讨论哦，我们换个关于大自然和科学的问题继续探索吧！"
+	outputBlockedMessage = "小伙伴，这部分内容暂时不能展示，我们一起换个话题继续探索吧！"
+	unavailableMessage   = "安全小卫士正在休息，请稍后再试一下哦～"
+)
+
+// Decision 安全检查的处理结论
+type Decision struct {
+	Action    string              // 处理动作：pass, filter, block
+	RiskLevel string              // 风险等级
+	Content   string              // 放行或过滤后的内容，拦截时为空
+	Message   string              // 拦截时给孩子的提示
+	Result    *ContentCheckResult // 安全中心原始结果，检查失败时为空
+}
+
+// Blocked 是否被拦截
+func (d *Decision) Blocked() bool {
+	return d.Action == ActionBlock
+}
+
+// Err 拦截时返回可直接展示给孩子的错误
+func (d *Decision) Err() error {
+	if !d.Blocked() {
+		return nil
+	}
+	return &BlockedError{Message: d.Message, RiskLevel: d.RiskLevel}
+}
+
+// BlockedError 内容被拦截的错误，Error()即为给孩子的提示
+type BlockedError struct {
+	Message   string
+	RiskLevel string
+}
+
+func (e *BlockedError) Error() string {
+	return e.Message
+}
+
+// CheckTarget 待检查的内容
+type CheckTarget struct {
+	Content     string // 内容
+	ContentType string // 内容类型：text, image_url, audio_url
+	Direction   string // 检查方向：input, output
+	Field       string // 内容所在字段，用于审计
+}
+
+// CallInfo 调用方信息，由中间件或拦截器写入context
+type CallInfo struct {
+	UserID    int64  // 用户ID
+	SessionID string // 会话ID
+	Source    string // 来源：API路由或RPC方法
+}
+
+// AuditRecord 安全审计记录
+type AuditRecord struct {
+	CallInfo
+	CheckTarget
+	RiskLevel  string
+	Action     string
+	Suggestion string
+	Details    []RiskDetail
+	Latency    time.Duration
+}
+
+// Auditor 安全审计记录器，每次检查结论都会写入
+type Auditor interface {
+	Record(ctx context.Context, record *AuditRecord)
+}
+
+// Guard 内容安全守卫，按风险等级决定放行、过滤或拦截，并记录审计日志
+type Guard struct {
+	client  *SecurityClient
+	auditor Auditor
+}
+
+// NewGuard 创建内容安全守卫，auditor为空时不记录审计日志
+func NewGuard(client *SecurityClient, auditor Auditor) *Guard {
+	return &Guard{
+		client:  client,
+		auditor: auditor,
+	}
+}
+
+type callInfoKey struct{}
+
+type decisionCacheKey struct{}
+
+// decisionCache 单次请求内的检查结果缓存，避免同一内容在入库前和返回前重复检查
+type decisionCache struct {
+	mu        sync.Mutex
+	decisions map[string]*Decision
+}
+
+func (c *decisionCache) get(key string) *Decision {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.decisions[key]
+}
+
+func (c *decisionCache) put(key string, decision *Decision) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.decisions[key] = decision
+}
+
+// WithCallInfo 将调用方信息写入context
+func WithCallInfo(ctx context.Context, info CallInfo) context.Context {
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+// CallInfoFromContext 读取调用方信息
+func CallInfoFromContext(ctx context.Context) CallInfo {
+	info, _ := ctx.Value(callInfoKey{}).(CallInfo)
+	return info
+}
+
+// WithDecisionCache 为一次请求开启检查结果缓存
+func WithDecisionCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, decisionCacheKey{}, &decisionCache{decisions: map[string]*Decision{}})
+}
+
+// Check 检查单条内容
+func (g *Guard) Check(ctx context.Context, target CheckTarget) *Decision {
+	return g.CheckAll(ctx, []CheckTarget{target})[0]
+}
+
+// CheckAll 批量检查多条内容，结论与输入顺序一致
+// 请求内同一方向已检查过的内容直接使用缓存结论，其余内容合并为一次批量检查
+func (g *Guard) CheckAll(ctx context.Context, targets []CheckTarget) []*Decision {
+	decisions := make([]*Decision, len(targets))
+	cache, _ := ctx.Value(decisionCacheKey{}).(*decisionCache)
+
+	keys := make([]string, len(targets))
+	first := map[string]int{}
+	var pending []int
+	for i, target := range targets {
+		if target.Content == "" {
+			decisions[i] = &Decision{Action: ActionPass, RiskLevel: RiskLevelLow}
+			continue
+		}
+		// 输入和输出的拦截提示不同，同一内容按方向分别缓存
+		keys[i] = target.Direction + ":" + target.ContentType + ":" + ContentHash(target.Content)
+		if decision := cache.get(keys[i]); decision != nil {
+			decisions[i] = decision
+			continue
+		}
+		// 同一批次中重复的内容只检查一次
+		if _, ok := first[keys[i]]; ok {
+			continue
+		}
+		first[keys[i]] = i
+		pending = append(pending, i)
+	}
+
+	if len(pending) > 0 {
+		info := CallInfoFromContext(ctx)
+		reqs := make([]*ContentCheckRequest, len(pending))
+		for j, i := range pending {
+			reqs[j] = &ContentCheckRequest{
+				Content:     targets[i].Content,
+				ContentType: targets[i].ContentType,
+				UserID:      formatUserID(info.UserID),
+				SessionID:   info.SessionID,
+			}
+		}
+
+		start := time.Now()
+		results := g.client.CheckBatch(ctx, reqs)
+		latency := time.Since(start)
+
+		for j, i := range pending {
+			var decision *Decision
+			if results[j].Err != nil {
+				// 安全中心不可用时拦截，宁可暂停服务也不把未经检查的内容交给孩子
+				decision = &Decision{Action: ActionBlock, RiskLevel: RiskLevelError, Message: unavailableMessage}
+			} else {
+				decision = decide(targets[i], results[j].Result)
+				cache.put(keys[i], decision)
+			}
+			g.audit(ctx, info, targets[i], decision, results[j].Err, latency)
+			decisions[i] = decision
+		}
+	}
+
+	for i := range decisions {
+		if decisions[i] == nil {
+			decisions[i] = decisions[first[keys[i]]]
+		}
+	}
+	return decisions
+}
+
+// decide 按风险等级给出处理结论
+func decide(target CheckTarget, result *ContentCheckResult) *Decision {
+	decision := &Decision{RiskLevel: result.RiskLevel, Result: result}
+	if decision.RiskLevel == "" {
+		decision.RiskLevel = RiskLevelLow
+	}
+
+	switch {
+	case result.Passed:
+		decision.Action = ActionPass
+		decision.Content = target.Content
+	case result.RiskLevel == RiskLevelHigh || result.RiskLevel == RiskLevelReject:
+		decision.Action = ActionBlock
+	case result.FilteredContent != "" && target.ContentType == ContentTypeText:
+		// 低、中风险的文本使用安全中心过滤后的版本；图片和音频无法部分过滤
+		decision.Action = ActionFilter
+		decision.Content = result.FilteredContent
+	default:
+		decision.Action = ActionBlock
+	}
+
+	if decision.Action == ActionBlock {
+		decision.Message = outputBlockedMessage
+		if target.Direction == DirectionInput {
+			decision.Message = inputBlockedMessage
+		}
+	}
+
+	return decision
+}
+
+// audit 写入审计记录
+func (g *Guard) audit(ctx context.Context, info CallInfo, target CheckTarget, decision *Decision, checkErr error, latency time.Duration) {
+	if g.auditor == nil {
+		return
+	}
+
+	record := &AuditRecord{
+		CallInfo:    info,
+		CheckTarget: target,
+		RiskLevel:   decision.RiskLevel,
+		Action:      decision.Action,
+		Latency:     latency,
+	}
+	if checkErr != nil {
+		record.Suggestion = checkErr.Error()
+	} else if decision.Result != nil {
+		record.Suggestion = decision.Result.Suggestion
+		record.Details = decision.Result.Details
+	}
+
+	g.auditor.Record(ctx, record)
+}
+
+// ContentHash 计算内容摘要，审计日志中用于关联同一内容
+func ContentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func formatUserID(userID int64) string {
+	if userID == 0 {
+		return ""
+	}
+	return strconv.FormatInt(userID, 10)
+}
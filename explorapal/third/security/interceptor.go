@@ -0,0 +1,88 @@
+package security
+
+import (
+	"context"
+
+	"explorapal/pkg/auth"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SessionHeader 传递会话ID的请求头/metadata键
+const SessionHeader = "x-session-id"
+
+// UnaryServerInterceptor 检查RPC请求中的用户输入和响应中的返回内容
+// 输入被拦截时不会调用业务逻辑；输出被拦截时丢弃响应并返回给孩子的提示
+func UnaryServerInterceptor(guard *Guard, input, output *FieldPolicy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// 用户身份由认证拦截器从令牌中解析，需在本拦截器之前注册
+		callInfo := CallInfo{Source: info.FullMethod, UserID: auth.UserID(ctx)}
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(SessionHeader); len(values) > 0 {
+				callInfo.SessionID = values[0]
+			}
+		}
+
+		ctx = WithDecisionCache(WithCallInfo(ctx, callInfo))
+
+		if msg, ok := req.(proto.Message); ok {
+			var refs []stringRef
+			collectProto(msg.ProtoReflect(), input, &refs)
+			if _, _, blocked := guard.checkRefs(ctx, refs, DirectionInput); blocked != nil {
+				return nil, status.Error(codes.InvalidArgument, blocked.Message)
+			}
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		if msg, ok := resp.(proto.Message); ok {
+			var refs []stringRef
+			collectProto(msg.ProtoReflect(), output, &refs)
+			if _, _, blocked := guard.checkRefs(ctx, refs, DirectionOutput); blocked != nil {
+				return nil, status.Error(codes.PermissionDenied, blocked.Message)
+			}
+		}
+
+		return resp, nil
+	}
+}
+
+// collectProto 收集protobuf消息中需要检查的字符串字段
+func collectProto(m protoreflect.Message, policy *FieldPolicy, refs *[]stringRef) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		field := string(fd.Name())
+		switch {
+		case fd.IsMap():
+			// map字段多为结构化元数据，不做检查
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				switch fd.Kind() {
+				case protoreflect.StringKind:
+					if contentType, ok := policy.ContentType(field); ok && list.Get(i).String() != "" {
+						*refs = append(*refs, stringRef{field: field, contentType: contentType, value: list.Get(i).String(),
+							set: func(s string) { list.Set(i, protoreflect.ValueOfString(s)) }})
+					}
+				case protoreflect.MessageKind:
+					collectProto(list.Get(i).Message(), policy, refs)
+				}
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			if contentType, ok := policy.ContentType(field); ok && v.String() != "" {
+				*refs = append(*refs, stringRef{field: field, contentType: contentType, value: v.String(),
+					set: func(s string) { m.Set(fd, protoreflect.ValueOfString(s)) }})
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			collectProto(v.Message(), policy, refs)
+		}
+		return true
+	})
+}
@@ -0,0 +1,316 @@
+package security
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// 本地规则命中的风险类型
+const (
+	RiskTypeKeyword           = "local_keyword"      // 命中关键词词典
+	RiskTypePattern           = "local_pattern"      // 命中正则规则
+	RiskTypePIIPhone          = "pii_phone"          // 手机号
+	RiskTypePIIIDCard         = "pii_id_card"        // 身份证号
+	RiskTypePIIEmail          = "pii_email"          // 邮箱
+	RiskTypePIIAddress        = "pii_address"        // 详细住址
+	RiskTypeURLNotAllowed     = "url_not_allowed"    // 链接不在白名单内
+	RiskTypeRemoteUnavailable = "remote_unavailable" // 安全中心不可用，仅按本地规则判断
+)
+
+// 安全中心不可用时的处理方式
+const (
+	FailureActionBlock = "block" // 拦截（默认）
+	FailureActionLocal = "local" // 仅按本地规则判断
+)
+
+// PII打码后的替换文本
+const piiMask = "***"
+
+// LocalRulesConfig 本地规则配置
+type LocalRulesConfig struct {
+	Keywords     map[string][]string `json:"keywords,optional"`     // 风险类型 → 关键词，命中即拦截
+	Patterns     map[string][]string `json:"patterns,optional"`     // 风险类型 → 正则表达式，命中即拦截
+	URLAllowList []string            `json:"urlAllowList,optional"` // 图片、音频链接允许的域名，支持子域名；为空时不限制
+	DisablePII   bool                `json:"disablePII,optional"`   // 关闭个人信息检测
+}
+
+// FailurePolicy 安全中心不可用时按内容类型和场景的处理策略
+type FailurePolicy struct {
+	ContentType string `json:"contentType"`    // 内容类型，*表示全部
+	Scene       string `json:"scene,optional"` // 业务场景，为空或*表示全部
+	Action      string `json:"action"`         // 处理方式：block, local
+}
+
+// piiRule 个人信息检测规则
+type piiRule struct {
+	riskType    string
+	description string
+	pattern     *regexp.Regexp
+}
+
+// 个人信息检测规则，孩子无意中说出的个人信息打码后再交给安全中心和模型
+var piiRules = []piiRule{
+	{RiskTypePIIIDCard, "包含身份证号", regexp.MustCompile(`\b[1-9]\d{5}(?:19|20)\d{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12]\d|3[01])\d{3}[\dXx]\b`)},
+	{RiskTypePIIPhone, "包含手机号", regexp.MustCompile(`(?:\+86[- ]?|\b)1[3-9]\d{9}\b`)},
+	{RiskTypePIIEmail, "包含邮箱地址", regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)},
+	{RiskTypePIIAddress, "包含详细住址", regexp.MustCompile(`\p{Han}{2,6}(?:路|街|大道|巷|胡同)\d+号(?:院|楼)?(?:\d+(?:号楼|栋|幢|单元|室))*|\p{Han}{2,6}(?:小区|花园|公寓)\d+(?:号楼|栋|幢)(?:\d+(?:单元|室))*`)},
+}
+
+// patternRule 配置的正则规则
+type patternRule struct {
+	riskType string
+	pattern  *regexp.Regexp
+}
+
+// keywordRule 配置的关键词词典
+type keywordRule struct {
+	riskType string
+	words    []string
+}
+
+// LocalRules 本地规则检查器，作为安全中心之前的第一道过滤
+type LocalRules struct {
+	keywords     []keywordRule
+	patterns     []patternRule
+	urlAllowList []string
+	detectPII    bool
+}
+
+// localResult 本地规则检查结果
+type localResult struct {
+	riskLevel string       // 命中的最高风险等级
+	details   []RiskDetail // 命中的规则
+	content   string       // 个人信息打码后的内容
+}
+
+// blocked 本地规则是否已经可以直接拦截
+func (r *localResult) blocked() bool {
+	return RiskRank(r.riskLevel) >= RiskRank(RiskLevelHigh)
+}
+
+// NewLocalRules 根据配置创建本地规则检查器
+func NewLocalRules(config LocalRulesConfig) (*LocalRules, error) {
+	rules := &LocalRules{
+		detectPII: !config.DisablePII,
+	}
+
+	for _, riskType := range sortedKeys(config.Keywords) {
+		rule := keywordRule{riskType: riskType}
+		for _, word := range config.Keywords[riskType] {
+			if word = strings.TrimSpace(word); word != "" {
+				rule.words = append(rule.words, strings.ToLower(word))
+			}
+		}
+		rules.keywords = append(rules.keywords, rule)
+	}
+
+	for _, riskType := range sortedKeys(config.Patterns) {
+		for _, expr := range config.Patterns[riskType] {
+			pattern, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, fmt.Errorf("本地规则正则表达式无效 %s: %w", expr, err)
+			}
+			rules.patterns = append(rules.patterns, patternRule{riskType: riskType, pattern: pattern})
+		}
+	}
+
+	for _, host := range config.URLAllowList {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			rules.urlAllowList = append(rules.urlAllowList, strings.TrimPrefix(host, "."))
+		}
+	}
+
+	return rules, nil
+}
+
+// Check 按本地规则检查内容
+func (r *LocalRules) Check(req *ContentCheckRequest) *localResult {
+	result := &localResult{riskLevel: RiskLevelLow, content: req.Content}
+
+	switch req.ContentType {
+	case ContentTypeImageURL, ContentTypeAudioURL:
+		if !r.urlAllowed(req.Content) {
+			result.add(RiskLevelHigh, RiskDetail{
+				Type:        RiskTypeURLNotAllowed,
+				Description: "链接不在允许的域名范围内",
+				Confidence:  1,
+				Suggestion:  "block",
+			})
+		}
+		return result
+	}
+
+	lower := strings.ToLower(req.Content)
+	for _, rule := range r.keywords {
+		for _, word := range rule.words {
+			if strings.Contains(lower, word) {
+				result.add(RiskLevelHigh, RiskDetail{
+					Type:        RiskTypeKeyword,
+					Description: fmt.Sprintf("命中%s关键词", rule.riskType),
+					Confidence:  1,
+					Suggestion:  "block",
+				})
+				break
+			}
+		}
+	}
+
+	for _, rule := range r.patterns {
+		if rule.pattern.MatchString(req.Content) {
+			result.add(RiskLevelHigh, RiskDetail{
+				Type:        RiskTypePattern,
+				Description: fmt.Sprintf("命中%s规则", rule.riskType),
+				Confidence:  1,
+				Suggestion:  "block",
+			})
+		}
+	}
+
+	if r.detectPII {
+		for _, rule := range piiRules {
+			if rule.pattern.MatchString(result.content) {
+				result.content = rule.pattern.ReplaceAllString(result.content, piiMask)
+				result.add(RiskLevelMedium, RiskDetail{
+					Type:        rule.riskType,
+					Description: rule.description,
+					Confidence:  1,
+					Suggestion:  "mask",
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+// urlAllowed 检查链接域名是否在白名单内
+func (r *LocalRules) urlAllowed(rawURL string) bool {
+	if len(r.urlAllowList) == 0 {
+		return true
+	}
+
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range r.urlAllowList {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *localResult) add(riskLevel string, detail RiskDetail) {
+	if RiskRank(riskLevel) > RiskRank(r.riskLevel) {
+		r.riskLevel = riskLevel
+	}
+	r.details = append(r.details, detail)
+}
+
+// toResult 转换为检查结果，用于本地规则直接拦截或安全中心不可用时的降级判断
+func (r *localResult) toResult(original string) *ContentCheckResult {
+	result := &ContentCheckResult{
+		Passed:    len(r.details) == 0,
+		RiskLevel: r.riskLevel,
+		Details:   r.details,
+	}
+	if r.content != original {
+		result.FilteredContent = r.content
+	}
+	switch {
+	case result.Passed:
+		result.Suggestion = "pass"
+	case r.blocked():
+		result.Suggestion = "block"
+	default:
+		result.Suggestion = "review"
+	}
+	return result
+}
+
+// remoteRequest 生成发往安全中心的请求，个人信息已打码
+func (r *localResult) remoteRequest(req *ContentCheckRequest) *ContentCheckRequest {
+	if r.content == req.Content {
+		return req
+	}
+	masked := *req
+	masked.Content = r.content
+	return &masked
+}
+
+// merge 将本地规则结果合并到安全中心结果中，风险等级取较高者
+func (r *localResult) merge(original string, remote *ContentCheckResult) *ContentCheckResult {
+	if len(r.details) == 0 {
+		return remote
+	}
+
+	merged := *remote
+	merged.Details = append(append([]RiskDetail{}, remote.Details...), r.details...)
+	if RiskRank(r.riskLevel) > RiskRank(merged.RiskLevel) {
+		merged.RiskLevel = r.riskLevel
+	}
+	// 本地打码的内容即使安全中心放行也要使用打码后的版本
+	if r.content != original {
+		merged.Passed = false
+		if merged.FilteredContent == "" {
+			merged.FilteredContent = r.content
+		}
+	}
+	return &merged
+}
+
+// failureAction 查找安全中心不可用时的处理方式，优先匹配具体的内容类型和场景
+func failureAction(policies []FailurePolicy, contentType, scene string) string {
+	best, bestScore := FailureActionBlock, -1
+	for _, p := range policies {
+		score := 0
+		switch p.ContentType {
+		case contentType:
+			score += 2
+		case "*", "":
+		default:
+			continue
+		}
+		switch p.Scene {
+		case scene:
+			score++
+		case "*", "":
+		default:
+			continue
+		}
+		if score > bestScore {
+			best, bestScore = p.Action, score
+		}
+	}
+	return best
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// RiskRank 风险等级排序，数值越大风险越高
+func RiskRank(level string) int {
+	switch level {
+	case RiskLevelLow:
+		return 1
+	case RiskLevelMedium:
+		return 2
+	case RiskLevelHigh:
+		return 3
+	case RiskLevelReject:
+		return 4
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,162 @@
+package security
+
+import (
+	"testing"
+)
+
+func TestLocalRulesCheck(t *testing.T) {
+	rules, err := NewLocalRules(LocalRulesConfig{
+		Keywords:     map[string][]string{"violence": {" Knife ", ""}},
+		Patterns:     map[string][]string{"gambling": {`赌\S*场`}},
+		URLAllowList: []string{".example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		contentType string
+		content     string
+		riskLevel   string
+		riskTypes   []string
+		masked      string
+	}{
+		{"clean text", ContentTypeText, "蝴蝶的翅膀上有鳞片", RiskLevelLow, nil, "蝴蝶的翅膀上有鳞片"},
+		{"keyword ignores case", ContentTypeText, "I found a KNIFE", RiskLevelHigh, []string{RiskTypeKeyword}, "I found a KNIFE"},
+		{"pattern", ContentTypeText, "我们去赌博场玩", RiskLevelHigh, []string{RiskTypePattern}, "我们去赌博场玩"},
+		{"phone", ContentTypeText, "我妈妈的电话是13812345678", RiskLevelMedium, []string{RiskTypePIIPhone}, "我妈妈的电话是***"},
+		{"phone with country code", ContentTypeText, "call +86 13812345678 now", RiskLevelMedium, []string{RiskTypePIIPhone}, "call *** now"},
+		{"id card is not a phone", ContentTypeText, "身份证110101201001011234", RiskLevelMedium, []string{RiskTypePIIIDCard}, "身份证***"},
+		{"email", ContentTypeText, "my email is kid.2015@example.com", RiskLevelMedium, []string{RiskTypePIIEmail}, "my email is ***"},
+		{"address", ContentTypeText, "我住在光明路8号3单元", RiskLevelMedium, []string{RiskTypePIIAddress}, "***"},
+		{"keyword and pii", ContentTypeText, "knife 13812345678", RiskLevelHigh, []string{RiskTypeKeyword, RiskTypePIIPhone}, "knife ***"},
+		{"short number is not a phone", ContentTypeText, "我数到了1381234", RiskLevelLow, nil, "我数到了1381234"},
+		{"allowed image host", ContentTypeImageURL, "https://img.example.com/a.jpg", RiskLevelLow, nil, "https://img.example.com/a.jpg"},
+		{"allowed root host", ContentTypeAudioURL, "http://EXAMPLE.com/a.mp3", RiskLevelLow, nil, "http://EXAMPLE.com/a.mp3"},
+		{"host suffix is not a subdomain", ContentTypeImageURL, "https://badexample.com/a.jpg", RiskLevelHigh, []string{RiskTypeURLNotAllowed}, "https://badexample.com/a.jpg"},
+		{"other host", ContentTypeImageURL, "https://evil.com/example.com.jpg", RiskLevelHigh, []string{RiskTypeURLNotAllowed}, "https://evil.com/example.com.jpg"},
+		{"other scheme", ContentTypeImageURL, "ftp://example.com/a.jpg", RiskLevelHigh, []string{RiskTypeURLNotAllowed}, "ftp://example.com/a.jpg"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rules.Check(&ContentCheckRequest{Content: tt.content, ContentType: tt.contentType})
+			if got.riskLevel != tt.riskLevel {
+				t.Errorf("riskLevel = %s, want %s", got.riskLevel, tt.riskLevel)
+			}
+			if len(got.details) != len(tt.riskTypes) {
+				t.Fatalf("details = %+v, want types %v", got.details, tt.riskTypes)
+			}
+			for i, riskType := range tt.riskTypes {
+				if got.details[i].Type != riskType {
+					t.Errorf("details[%d].Type = %s, want %s", i, got.details[i].Type, riskType)
+				}
+			}
+			if got.content != tt.masked {
+				t.Errorf("content = %q, want %q", got.content, tt.masked)
+			}
+			if got.blocked() != (tt.riskLevel == RiskLevelHigh) {
+				t.Errorf("blocked = %v for risk level %s", got.blocked(), got.riskLevel)
+			}
+		})
+	}
+}
+
+func TestLocalRulesDisablePII(t *testing.T) {
+	rules, err := NewLocalRules(LocalRulesConfig{DisablePII: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := rules.Check(&ContentCheckRequest{Content: "电话13812345678", ContentType: ContentTypeText})
+	if got.riskLevel != RiskLevelLow || len(got.details) != 0 || got.content != "电话13812345678" {
+		t.Fatalf("Check with PII disabled = %+v", got)
+	}
+}
+
+func TestNewLocalRulesInvalidPattern(t *testing.T) {
+	if _, err := NewLocalRules(LocalRulesConfig{Patterns: map[string][]string{"bad": {"("}}}); err == nil {
+		t.Fatal("NewLocalRules with invalid pattern: want error")
+	}
+}
+
+func TestLocalResultMerge(t *testing.T) {
+	rules, err := NewLocalRules(LocalRulesConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const content = "我的电话是13812345678"
+	local := rules.Check(&ContentCheckRequest{Content: content, ContentType: ContentTypeText})
+
+	tests := []struct {
+		name      string
+		remote    *ContentCheckResult
+		riskLevel string
+		filtered  string
+		details   int
+	}{
+		{
+			name:      "remote passed keeps masked content",
+			remote:    &ContentCheckResult{Passed: true, RiskLevel: RiskLevelLow},
+			riskLevel: RiskLevelMedium,
+			filtered:  "我的电话是***",
+			details:   1,
+		},
+		{
+			name: "remote filtered content wins",
+			remote: &ContentCheckResult{RiskLevel: RiskLevelHigh, FilteredContent: "我的***是***",
+				Details: []RiskDetail{{Type: "sensitive"}}},
+			riskLevel: RiskLevelHigh,
+			filtered:  "我的***是***",
+			details:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remoteDetails := len(tt.remote.Details)
+			got := local.merge(content, tt.remote)
+			if got.Passed || got.RiskLevel != tt.riskLevel || got.FilteredContent != tt.filtered || len(got.Details) != tt.details {
+				t.Fatalf("merge = %+v, want risk %s filtered %q with %d details", got, tt.riskLevel, tt.filtered, tt.details)
+			}
+			if len(tt.remote.Details) != remoteDetails {
+				t.Fatalf("merge modified the remote details: %+v", tt.remote.Details)
+			}
+		})
+	}
+
+	clean := rules.Check(&ContentCheckRequest{Content: "蝴蝶", ContentType: ContentTypeText})
+	remote := &ContentCheckResult{Passed: true, RiskLevel: RiskLevelLow}
+	if got := clean.merge("蝴蝶", remote); got != remote {
+		t.Fatalf("merge without local findings = %+v, want remote result", got)
+	}
+}
+
+func TestFailureAction(t *testing.T) {
+	policies := []FailurePolicy{
+		{ContentType: "*", Action: FailureActionLocal},
+		{ContentType: ContentTypeText, Scene: "children_education", Action: FailureActionBlock},
+		{ContentType: ContentTypeImageURL, Action: FailureActionBlock},
+		{ContentType: "*", Scene: "teacher_review", Action: FailureActionBlock},
+		{ContentType: "*", Scene: "parent_dashboard", Action: FailureActionLocal},
+	}
+	tests := []struct {
+		name        string
+		policies    []FailurePolicy
+		contentType string
+		scene       string
+		want        string
+	}{
+		{"no policies blocks", nil, ContentTypeText, "children_education", FailureActionBlock},
+		{"wildcard", policies, ContentTypeAudioURL, "children_education", FailureActionLocal},
+		{"type and scene beat wildcard", policies, ContentTypeText, "children_education", FailureActionBlock},
+		{"type beats scene", policies, ContentTypeImageURL, "parent_dashboard", FailureActionBlock},
+		{"scene beats wildcard", policies, ContentTypeAudioURL, "teacher_review", FailureActionBlock},
+		{"other scene falls back to wildcard", policies, ContentTypeText, "classroom", FailureActionLocal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := failureAction(tt.policies, tt.contentType, tt.scene); got != tt.want {
+				t.Fatalf("failureAction(%s, %s) = %s, want %s", tt.contentType, tt.scene, got, tt.want)
+			}
+		})
+	}
+}
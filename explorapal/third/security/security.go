@@ -1,6 +1,7 @@
 package security
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -11,27 +12,56 @@ import (
 
 // SecurityClient 集团安全中心过滤服务客户端
 type SecurityClient struct {
-	baseURL    string
-	apiKey     string
-	httpClient *http.Client
+	baseURL      string
+	apiKey       string
+	httpClient   *http.Client
+	batchWorkers int
+	batchSize    int
+
+	// 本地规则与安全中心不可用时的处理策略
+	rules           *LocalRules
+	failurePolicies []FailurePolicy
+
+	// 安全中心是否提供原生批量接口，首次调用时探测
+	nativeBatch batchSupport
 }
 
 // Config 安全服务配置
 type Config struct {
-	BaseURL    string `json:"baseURL"`    // 安全中心服务地址
-	APIKey     string `json:"apiKey"`     // 安全中心API密钥
-	Timeout    int    `json:"timeout"`    // 超时时间(秒)
+	BaseURL         string           `json:"baseURL"`         // 安全中心服务地址
+	APIKey          string           `json:"apiKey"`          // 安全中心API密钥
+	Timeout         int              `json:"timeout"`         // 超时时间(秒)
+	BatchWorkers    int              `json:"batchWorkers"`    // 批量检查的并发数，默认8
+	BatchSize       int              `json:"batchSize"`       // 原生批量接口单次最多条数，默认50
+	LocalRules      LocalRulesConfig `json:"localRules"`      // 本地规则配置
+	FailurePolicies []FailurePolicy  `json:"failurePolicies"` // 安全中心不可用时的处理策略，未匹配时拦截
 }
 
-// NewSecurityClient 创建安全过滤客户端
+// NewSecurityClient 创建安全过滤客户端，本地规则配置错误时panic
 func NewSecurityClient(config *Config) *SecurityClient {
-	return &SecurityClient{
+	rules, err := NewLocalRules(config.LocalRules)
+	if err != nil {
+		panic(err)
+	}
+
+	client := &SecurityClient{
 		baseURL: strings.TrimSuffix(config.BaseURL, "/"),
 		apiKey:  config.APIKey,
 		httpClient: &http.Client{
 			Timeout: time.Duration(config.Timeout) * time.Second,
 		},
+		batchWorkers:    config.BatchWorkers,
+		batchSize:       config.BatchSize,
+		rules:           rules,
+		failurePolicies: config.FailurePolicies,
+	}
+	if client.batchWorkers <= 0 {
+		client.batchWorkers = defaultBatchWorkers
 	}
+	if client.batchSize <= 0 {
+		client.batchSize = defaultBatchSize
+	}
+	return client
 }
 
 // ContentCheckRequest 内容检查请求
@@ -46,31 +76,82 @@ type ContentCheckRequest struct {
 
 // ContentCheckResponse 内容检查响应
 type ContentCheckResponse struct {
-	Code      int                    `json:"code"`
-	Message   string                 `json:"message"`
-	Data      ContentCheckResult     `json:"data"`
-	RequestID string                 `json:"request_id"`
+	Code      int                `json:"code"`
+	Message   string             `json:"message"`
+	Data      ContentCheckResult `json:"data"`
+	RequestID string             `json:"request_id"`
 }
 
 // ContentCheckResult 检查结果
 type ContentCheckResult struct {
-	Passed     bool                   `json:"passed"`      // 是否通过检查
-	RiskLevel  string                 `json:"risk_level"`  // 风险等级：low, medium, high, reject
-	Suggestion string                 `json:"suggestion"`  // 处理建议
-	Details    []RiskDetail           `json:"details"`     // 风险详情
-	FilteredContent string            `json:"filtered_content,omitempty"` // 过滤后的内容
+	Passed          bool         `json:"passed"`                     // 是否通过检查
+	RiskLevel       string       `json:"risk_level"`                 // 风险等级：low, medium, high, reject
+	Suggestion      string       `json:"suggestion"`                 // 处理建议
+	Details         []RiskDetail `json:"details"`                    // 风险详情
+	FilteredContent string       `json:"filtered_content,omitempty"` // 过滤后的内容
 }
 
 // RiskDetail 风险详情
 type RiskDetail struct {
-	Type        string  `json:"type"`         // 风险类型
-	Description string  `json:"description"`  // 风险描述
-	Confidence  float64 `json:"confidence"`   // 置信度
-	Suggestion  string  `json:"suggestion"`   // 处理建议
+	Type        string  `json:"type"`        // 风险类型
+	Description string  `json:"description"` // 风险描述
+	Confidence  float64 `json:"confidence"`  // 置信度
+	Suggestion  string  `json:"suggestion"`  // 处理建议
 }
 
 // CheckContent 检查内容合规性
+// 先按本地规则检查：命中高风险直接拦截，个人信息打码后再交给安全中心；本地规则结果合并到Details中
 func (c *SecurityClient) CheckContent(ctx context.Context, req *ContentCheckRequest) (*ContentCheckResult, error) {
+	applyDefaults(req)
+
+	local := c.rules.Check(req)
+	if local.blocked() {
+		return local.toResult(req.Content), nil
+	}
+
+	remote, err := c.checkRemote(ctx, local.remoteRequest(req))
+	return c.resolve(ctx, req, local, remote, err)
+}
+
+// checkRemote 请求安全中心检查单条内容
+func (c *SecurityClient) checkRemote(ctx context.Context, req *ContentCheckRequest) (*ContentCheckResult, error) {
+	var checkResp ContentCheckResponse
+	if err := c.post(ctx, "/api/v1/content/check", req, &checkResp); err != nil {
+		return nil, err
+	}
+
+	if checkResp.Code != 200 {
+		return nil, fmt.Errorf("安全检查失败: %s", checkResp.Message)
+	}
+
+	return &checkResp.Data, nil
+}
+
+// resolve 合并本地规则与安全中心的结果；安全中心不可用时按内容类型和场景的策略处理
+// 调用方的context取消或超时不属于安全中心不可用，直接返回context的错误
+func (c *SecurityClient) resolve(ctx context.Context, req *ContentCheckRequest, local *localResult, remote *ContentCheckResult, err error) (*ContentCheckResult, error) {
+	if err == nil {
+		return local.merge(req.Content, remote), nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+
+	if failureAction(c.failurePolicies, req.ContentType, req.Scene) != FailureActionLocal {
+		return nil, err
+	}
+
+	result := local.toResult(req.Content)
+	result.Details = append(result.Details, RiskDetail{
+		Type:        RiskTypeRemoteUnavailable,
+		Description: "安全中心不可用，仅按本地规则判断",
+		Suggestion:  err.Error(),
+	})
+	return result, nil
+}
+
+// applyDefaults 设置默认的检查类型和场景
+func applyDefaults(req *ContentCheckRequest) {
 	// 设置默认检查类型（面向儿童教育场景的重点风险）
 	if len(req.CheckTypes) == 0 {
 		req.CheckTypes = []string{"politics", "violence", "minors", "sensitive", "pornography"}
@@ -80,18 +161,18 @@ func (c *SecurityClient) CheckContent(ctx context.Context, req *ContentCheckRequ
 	if req.Scene == "" {
 		req.Scene = "children_education"
 	}
+}
 
-	// 构建请求
-	requestBody, err := json.Marshal(req)
+// post 向安全中心发送请求并解析响应
+func (c *SecurityClient) post(ctx context.Context, path string, body, out interface{}) error {
+	requestBody, err := json.Marshal(body)
 	if err != nil {
-		return nil, fmt.Errorf("序列化请求失败: %w", err)
+		return fmt.Errorf("序列化请求失败: %w", err)
 	}
 
-	// 发送HTTP请求
-	httpReq, err := http.NewRequestWithContext(ctx, "POST",
-		c.baseURL+"/api/v1/content/check", strings.NewReader(string(requestBody)))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+path, bytes.NewReader(requestBody))
 	if err != nil {
-		return nil, fmt.Errorf("创建请求失败: %w", err)
+		return fmt.Errorf("创建请求失败: %w", err)
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
@@ -100,25 +181,27 @@ func (c *SecurityClient) CheckContent(ctx context.Context, req *ContentCheckRequ
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("请求安全中心失败: %w", err)
+		return fmt.Errorf("请求安全中心失败: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("安全中心响应异常: %d", resp.StatusCode)
+		return &StatusError{StatusCode: resp.StatusCode}
 	}
 
-	// 解析响应
-	var checkResp ContentCheckResponse
-	if err := json.NewDecoder(resp.Body).Decode(&checkResp); err != nil {
-		return nil, fmt.Errorf("解析响应失败: %w", err)
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("解析响应失败: %w", err)
 	}
+	return nil
+}
 
-	if checkResp.Code != 200 {
-		return nil, fmt.Errorf("安全检查失败: %s", checkResp.Message)
-	}
+// StatusError 安全中心返回的非200状态码
+type StatusError struct {
+	StatusCode int
+}
 
-	return &checkResp.Data, nil
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("安全中心响应异常: %d", e.StatusCode)
 }
 
 // FilterContent 过滤内容（如果内容不合规，返回过滤后的版本）
@@ -146,30 +229,35 @@ func (c *SecurityClient) FilterContent(ctx context.Context, content, contentType
 	return content, nil
 }
 
-// BatchCheckContent 批量检查内容
+// BatchCheckContent 批量检查内容，结果与输入顺序一致
+// 单个检查失败时对应位置的风险等级为error；context取消时返回错误
 func (c *SecurityClient) BatchCheckContent(ctx context.Context, contents []string, contentType, userID, sessionID string) ([]ContentCheckResult, error) {
-	var results []ContentCheckResult
-
-	for _, content := range contents {
-		req := &ContentCheckRequest{
+	reqs := make([]*ContentCheckRequest, len(contents))
+	for i, content := range contents {
+		reqs[i] = &ContentCheckRequest{
 			Content:     content,
 			ContentType: contentType,
 			UserID:      userID,
 			SessionID:   sessionID,
 		}
+	}
 
-		result, err := c.CheckContent(ctx, req)
-		if err != nil {
-			// 单个检查失败时，记录错误但继续检查其他内容
-			results = append(results, ContentCheckResult{
-				Passed:    false,
-				RiskLevel: "error",
-				Suggestion: fmt.Sprintf("检查失败: %v", err),
-			})
+	items := c.CheckBatch(ctx, reqs)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]ContentCheckResult, len(items))
+	for i, item := range items {
+		if item.Err != nil {
+			results[i] = ContentCheckResult{
+				Passed:     false,
+				RiskLevel:  "error",
+				Suggestion: fmt.Sprintf("检查失败: %v", item.Err),
+			}
 			continue
 		}
-
-		results = append(results, *result)
+		results[i] = *item.Result
 	}
 
 	return results, nil
@@ -0,0 +1,147 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeCenter 模拟安全中心，检查结果的FilteredContent为"checked:"加收到的内容
+type fakeCenter struct {
+	fail    bool // 所有请求返回500
+	noBatch bool // 批量接口返回404
+
+	singles atomic.Int32
+	batches atomic.Int32
+}
+
+func (f *fakeCenter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if f.fail {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/api/v1/content/check":
+		f.singles.Add(1)
+		var req ContentCheckRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		// 打乱完成顺序
+		time.Sleep(time.Duration(rand.Intn(3)) * time.Millisecond)
+		json.NewEncoder(w).Encode(ContentCheckResponse{Code: 200, Data: checked(req.Content)})
+	case "/api/v1/content/batch_check":
+		if f.noBatch {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		f.batches.Add(1)
+		var req BatchCheckRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		var resp BatchCheckResponse
+		resp.Code = 200
+		for _, item := range req.Items {
+			resp.Data.Results = append(resp.Data.Results, checked(item.Content))
+		}
+		json.NewEncoder(w).Encode(resp)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func checked(content string) ContentCheckResult {
+	return ContentCheckResult{Passed: true, RiskLevel: RiskLevelLow, Suggestion: "pass", FilteredContent: "checked:" + content}
+}
+
+func newTestClient(t *testing.T, center *fakeCenter, config Config) *SecurityClient {
+	t.Helper()
+	srv := httptest.NewServer(center)
+	t.Cleanup(srv.Close)
+	config.BaseURL = srv.URL
+	return NewSecurityClient(&config)
+}
+
+func TestCheckContentFailurePolicy(t *testing.T) {
+	config := Config{
+		LocalRules: LocalRulesConfig{Keywords: map[string][]string{"violence": {"knife"}}},
+		FailurePolicies: []FailurePolicy{
+			{ContentType: ContentTypeText, Scene: "children_education", Action: FailureActionLocal},
+		},
+	}
+	tests := []struct {
+		name        string
+		fail        bool
+		contentType string
+		content     string
+		wantErr     bool
+		passed      bool
+		lastDetail  string
+		remoteCalls int32
+	}{
+		{"remote passes", false, ContentTypeText, "蝴蝶", false, true, "", 1},
+		{"local block skips remote", false, ContentTypeText, "a knife", false, false, RiskTypeKeyword, 0},
+		{"unavailable with local policy", true, ContentTypeText, "蝴蝶", false, true, RiskTypeRemoteUnavailable, 0},
+		{"unavailable with local policy keeps pii", true, ContentTypeText, "电话13812345678", false, false, RiskTypeRemoteUnavailable, 0},
+		{"unavailable without policy", true, ContentTypeImageURL, "https://example.com/a.jpg", true, false, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			center := &fakeCenter{fail: tt.fail}
+			client := newTestClient(t, center, config)
+
+			got, err := client.CheckContent(context.Background(), &ContentCheckRequest{Content: tt.content, ContentType: tt.contentType})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("CheckContent = %+v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Passed != tt.passed {
+				t.Errorf("Passed = %v, want %v (%+v)", got.Passed, tt.passed, got)
+			}
+			if tt.lastDetail != "" && (len(got.Details) == 0 || got.Details[len(got.Details)-1].Type != tt.lastDetail) {
+				t.Errorf("Details = %+v, want last detail %s", got.Details, tt.lastDetail)
+			}
+			if calls := center.singles.Load(); calls != tt.remoteCalls {
+				t.Errorf("remote calls = %d, want %d", calls, tt.remoteCalls)
+			}
+		})
+	}
+}
+
+func TestCheckContentCancelled(t *testing.T) {
+	client := newTestClient(t, &fakeCenter{}, Config{
+		LocalRules:      LocalRulesConfig{URLAllowList: []string{"example.com"}},
+		FailurePolicies: []FailurePolicy{{ContentType: "*", Action: FailureActionLocal}},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// 调用方取消时不能按失败策略放行
+	if got, err := client.CheckContent(ctx, &ContentCheckRequest{Content: "蝴蝶", ContentType: ContentTypeText}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("CheckContent = %+v, %v, want context.Canceled", got, err)
+	}
+
+	reqs := []*ContentCheckRequest{
+		{Content: "蝴蝶", ContentType: ContentTypeText},
+		{Content: "https://evil.com/a.jpg", ContentType: ContentTypeImageURL},
+		{Content: "瓢虫", ContentType: ContentTypeText},
+	}
+	results := client.CheckBatch(ctx, reqs)
+	for i, want := range []bool{true, false, true} {
+		if gotErr := errors.Is(results[i].Err, context.Canceled); gotErr != want {
+			t.Errorf("CheckBatch[%d] = %+v, want cancelled %v", i, results[i], want)
+		}
+	}
+	if results[1].Result == nil || results[1].Result.Passed {
+		t.Errorf("locally blocked item = %+v, want blocked result", results[1])
+	}
+}
@@ -0,0 +1,208 @@
+package speech
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// AudioDuration 计算音频时长(秒)，支持解析WAV文件头、MP3帧和M4A的mvhd box，无法识别的格式或数据返回0
+func AudioDuration(audioData []byte, format string) float64 {
+	switch strings.ToLower(format) {
+	case "wav":
+		return wavDuration(audioData)
+	case "mp3":
+		return mp3Duration(audioData)
+	case "m4a":
+		return m4aDuration(audioData)
+	default:
+		return 0
+	}
+}
+
+func wavDuration(audioData []byte) float64 {
+	if len(audioData) < 12 || string(audioData[0:4]) != "RIFF" || string(audioData[8:12]) != "WAVE" {
+		return 0
+	}
+
+	var byteRate uint32
+	offset := 12
+	for offset+8 <= len(audioData) {
+		chunkID := string(audioData[offset : offset+4])
+		chunkSize := binary.LittleEndian.Uint32(audioData[offset+4 : offset+8])
+		body := offset + 8
+
+		switch chunkID {
+		case "fmt ":
+			if body+12 > len(audioData) {
+				return 0
+			}
+			byteRate = binary.LittleEndian.Uint32(audioData[body+8 : body+12])
+		case "data":
+			if byteRate == 0 {
+				return 0
+			}
+			// 流式录音的data长度可能未写入，按实际数据长度计算
+			size := int(chunkSize)
+			if size == 0 || body+size > len(audioData) {
+				size = len(audioData) - body
+			}
+			return float64(size) / float64(byteRate)
+		}
+
+		// 块按偶数字节对齐
+		offset = body + int(chunkSize) + int(chunkSize%2)
+	}
+
+	return 0
+}
+
+// MP3帧头中的比特率(kbps)，按MPEG版本和层查表
+var (
+	mp3BitratesV1L1 = [16]int{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448, 0}
+	mp3BitratesV1L2 = [16]int{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384, 0}
+	mp3BitratesV1L3 = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+	mp3BitratesV2L1 = [16]int{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256, 0}
+	mp3BitratesV2L3 = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+)
+
+// MPEG-1的采样率，MPEG-2减半，MPEG-2.5再减半
+var mp3SampleRates = [3]int{44100, 48000, 32000}
+
+// mp3Duration 逐帧累加采样数计算时长，固定和可变比特率都适用；跳过ID3v2标签和帧之间的无效数据
+func mp3Duration(audioData []byte) float64 {
+	offset := 0
+	if len(audioData) >= 10 && string(audioData[0:3]) == "ID3" {
+		size := int(audioData[6]&0x7f)<<21 | int(audioData[7]&0x7f)<<14 | int(audioData[8]&0x7f)<<7 | int(audioData[9]&0x7f)
+		offset = 10 + size
+		// 带页脚的标签
+		if audioData[5]&0x10 != 0 {
+			offset += 10
+		}
+	}
+
+	var seconds float64
+	for offset+4 <= len(audioData) {
+		frameLen, samples, sampleRate := mp3Frame(audioData[offset : offset+4])
+		if frameLen == 0 {
+			offset++
+			continue
+		}
+		seconds += float64(samples) / float64(sampleRate)
+		offset += frameLen
+	}
+	return seconds
+}
+
+// mp3Frame 解析帧头，返回帧长度、采样数和采样率；不是有效的帧头时帧长度为0
+func mp3Frame(header []byte) (frameLen, samples, sampleRate int) {
+	if header[0] != 0xff || header[1]&0xe0 != 0xe0 {
+		return 0, 0, 0
+	}
+	version := (header[1] >> 3) & 0x03 // 0为MPEG-2.5，2为MPEG-2，3为MPEG-1
+	layer := (header[1] >> 1) & 0x03   // 1为Layer III，2为Layer II，3为Layer I
+	bitrateIndex := header[2] >> 4
+	sampleRateIndex := (header[2] >> 2) & 0x03
+	padding := int((header[2] >> 1) & 0x01)
+	if version == 1 || layer == 0 || sampleRateIndex == 3 {
+		return 0, 0, 0
+	}
+
+	var bitrates *[16]int
+	switch {
+	case version == 3 && layer == 3:
+		bitrates = &mp3BitratesV1L1
+	case version == 3 && layer == 2:
+		bitrates = &mp3BitratesV1L2
+	case version == 3:
+		bitrates = &mp3BitratesV1L3
+	case layer == 3:
+		bitrates = &mp3BitratesV2L1
+	default:
+		bitrates = &mp3BitratesV2L3
+	}
+	bitrate := bitrates[bitrateIndex] * 1000
+	if bitrate == 0 {
+		return 0, 0, 0
+	}
+
+	sampleRate = mp3SampleRates[sampleRateIndex]
+	switch version {
+	case 2:
+		sampleRate /= 2
+	case 0:
+		sampleRate /= 4
+	}
+
+	switch {
+	case layer == 3:
+		samples = 384
+		frameLen = (12*bitrate/sampleRate + padding) * 4
+	case layer == 2 || version == 3:
+		samples = 1152
+		frameLen = 144*bitrate/sampleRate + padding
+	default:
+		samples = 576
+		frameLen = 72*bitrate/sampleRate + padding
+	}
+	return frameLen, samples, sampleRate
+}
+
+// m4aDuration 读取moov中mvhd box的时间刻度和时长
+func m4aDuration(audioData []byte) float64 {
+	moov := findBox(audioData, "moov")
+	if moov == nil {
+		return 0
+	}
+	mvhd := findBox(moov, "mvhd")
+	if len(mvhd) < 4 {
+		return 0
+	}
+
+	var timescale uint32
+	var duration uint64
+	// version为1时创建和修改时间、时长都是64位
+	if mvhd[0] == 1 {
+		if len(mvhd) < 32 {
+			return 0
+		}
+		timescale = binary.BigEndian.Uint32(mvhd[20:24])
+		duration = binary.BigEndian.Uint64(mvhd[24:32])
+	} else {
+		if len(mvhd) < 20 {
+			return 0
+		}
+		timescale = binary.BigEndian.Uint32(mvhd[12:16])
+		duration = uint64(binary.BigEndian.Uint32(mvhd[16:20]))
+	}
+	if timescale == 0 {
+		return 0
+	}
+	return float64(duration) / float64(timescale)
+}
+
+// findBox 在同一层的box中查找指定类型，返回box的内容
+func findBox(data []byte, boxType string) []byte {
+	for offset := 0; offset+8 <= len(data); {
+		size := uint64(binary.BigEndian.Uint32(data[offset : offset+4]))
+		header := uint64(8)
+		switch size {
+		case 0:
+			// 延续到数据末尾
+			size = uint64(len(data) - offset)
+		case 1:
+			if offset+16 > len(data) {
+				return nil
+			}
+			size = binary.BigEndian.Uint64(data[offset+8 : offset+16])
+			header = 16
+		}
+		if size < header || size > uint64(len(data)-offset) {
+			return nil
+		}
+		if string(data[offset+4:offset+8]) == boxType {
+			return data[offset+int(header) : offset+int(size)]
+		}
+		offset += int(size)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package speech
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestAudioDuration(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   []byte
+		format string
+		want   float64
+	}{
+		{"wav", wavFile(16000*2, 32000), "wav", 1},
+		{"wav upper case format", wavFile(16000, 32000), "WAV", 0.5},
+		{"wav streaming data size", wavFile(0, 32000), "wav", 0},
+		{"wav bad header", []byte("RIFF0000AVI "), "wav", 0},
+		{"mp3 cbr", mp3File(0, 100), "mp3", 100 * 1152.0 / 44100},
+		{"mp3 id3 tag", mp3File(300, 38), "mp3", 38 * 1152.0 / 44100},
+		{"mp3 no frames", []byte("not an mp3 file"), "mp3", 0},
+		{"m4a mvhd v0", m4aFile(0, 1000, 2500), "m4a", 2.5},
+		{"m4a mvhd v1", m4aFile(1, 44100, 44100*61), "m4a", 61},
+		{"m4a no moov", box("ftyp", []byte("M4A ")), "m4a", 0},
+		{"unknown format", wavFile(32000, 32000), "ogg", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AudioDuration(tt.data, tt.format)
+			if math.Abs(got-tt.want) > 1e-6 {
+				t.Fatalf("AudioDuration = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// wavFile 16kHz单声道16位的WAV，dataSize为0时模拟未写入长度的流式录音
+func wavFile(dataSize uint32, byteRate uint32) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	binary.Write(&buf, binary.LittleEndian, uint32(36+dataSize))
+	buf.WriteString("WAVEfmt ")
+	binary.Write(&buf, binary.LittleEndian, uint32(16))
+	binary.Write(&buf, binary.LittleEndian, uint16(1))
+	binary.Write(&buf, binary.LittleEndian, uint16(1))
+	binary.Write(&buf, binary.LittleEndian, uint32(16000))
+	binary.Write(&buf, binary.LittleEndian, byteRate)
+	binary.Write(&buf, binary.LittleEndian, uint16(2))
+	binary.Write(&buf, binary.LittleEndian, uint16(16))
+	buf.WriteString("data")
+	binary.Write(&buf, binary.LittleEndian, dataSize)
+	buf.Write(make([]byte, dataSize))
+	return buf.Bytes()
+}
+
+// mp3File MPEG-1 Layer III、128kbps、44.1kHz的帧，tagSize大于0时在前面加上ID3v2标签
+func mp3File(tagSize, frames int) []byte {
+	var buf bytes.Buffer
+	if tagSize > 0 {
+		buf.Write([]byte{'I', 'D', '3', 4, 0, 0,
+			byte(tagSize >> 21 & 0x7f), byte(tagSize >> 14 & 0x7f), byte(tagSize >> 7 & 0x7f), byte(tagSize & 0x7f)})
+		buf.Write(make([]byte, tagSize))
+	}
+	frame := make([]byte, 144*128000/44100)
+	copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+	for i := 0; i < frames; i++ {
+		buf.Write(frame)
+	}
+	// ID3v1标签不是有效的帧
+	buf.WriteString("TAG")
+	buf.Write(make([]byte, 125))
+	return buf.Bytes()
+}
+
+func m4aFile(version byte, timescale uint32, duration uint64) []byte {
+	var mvhd bytes.Buffer
+	mvhd.Write([]byte{version, 0, 0, 0})
+	if version == 1 {
+		mvhd.Write(make([]byte, 16))
+		binary.Write(&mvhd, binary.BigEndian, timescale)
+		binary.Write(&mvhd, binary.BigEndian, duration)
+	} else {
+		mvhd.Write(make([]byte, 8))
+		binary.Write(&mvhd, binary.BigEndian, timescale)
+		binary.Write(&mvhd, binary.BigEndian, uint32(duration))
+	}
+	mvhd.Write(make([]byte, 80))
+
+	moov := append(box("mvhd", mvhd.Bytes()), box("trak", make([]byte, 16))...)
+	file := append(box("ftyp", []byte("M4A \x00\x00\x00\x00")), box("mdat", make([]byte, 64))...)
+	return append(file, box("moov", moov)...)
+}
+
+func box(boxType string, body []byte) []byte {
+	data := make([]byte, 8, 8+len(body))
+	binary.BigEndian.PutUint32(data, uint32(8+len(body)))
+	copy(data[4:], boxType)
+	return append(data, body...)
+}